@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"time"
+)
+
+// defaultAPIAddress keeps the API reachable from this machine only
+// API'yi yalnızca bu makineden erişilebilir tutar
+const defaultAPIAddress = "127.0.0.1:8765"
+
+// minAPIKeyLength is the shortest API key accepted
+// Kabul edilen en kısa API anahtarıdır
+const minAPIKeyLength = 16
+
+// apiShutdownTimeout limits how long open API requests may finish on stop
+// Durdurmada açık API isteklerinin bitmesi için tanınan süreyi sınırlar
+const apiShutdownTimeout = 5 * time.Second
+
+// startAPI serves the HTTP API and the gRPC service if the API is enabled in the preferences
+// Tercihlerde API etkinse HTTP API'sini ve gRPC hizmetini sunar
+func (a *App) startAPI() error {
+	preferences := a.currentPreferences()
+	if !preferences.APIEnabled {
+		return nil
+	}
+
+	listener, err := net.Listen("tcp", preferences.APIAddress)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %v", preferences.APIAddress, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/jobs", a.handleAPIJobs)
+	mux.HandleFunc("/api/jobs/", a.handleAPIJob)
+	mux.HandleFunc("/api/history", a.handleAPIHistory)
+	mux.HandleFunc("/api/folders", a.handleAPIFolders)
+	mux.HandleFunc("/api/watch", a.handleAPIWatch)
+	mux.HandleFunc("/api/arr", a.handleArrWebhook)
+
+	// Watch streams never end on their own, their context is cancelled on shutdown
+	// İzleme akışları kendiliğinden bitmez, bağlamları kapanışta iptal edilir
+	streamCtx, stopStreams := context.WithCancel(context.Background())
+	server := &http.Server{
+		Handler:           a.requireAPIKey(mux),
+		ReadHeaderTimeout: 10 * time.Second,
+		BaseContext:       func(net.Listener) context.Context { return streamCtx },
+	}
+	server.RegisterOnShutdown(stopStreams)
+
+	a.apiMu.Lock()
+	a.api = server
+	a.apiMu.Unlock()
+
+	go func() {
+		if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
+			log.Printf("API server error: %v", err)
+		}
+	}()
+	log.Printf("API listening on %s", listener.Addr())
+	return a.startGRPC(preferences)
+}
+
+// stopAPI stops the HTTP API and the gRPC service if they are running
+// Çalışıyorlarsa HTTP API'sini ve gRPC hizmetini durdurur
+func (a *App) stopAPI() {
+	a.stopGRPC()
+	a.apiMu.Lock()
+	server := a.api
+	a.api = nil
+	a.apiMu.Unlock()
+	if server == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), apiShutdownTimeout)
+	defer cancel()
+	if err := server.Shutdown(ctx); err != nil {
+		log.Printf("Error stopping API: %v", err)
+	}
+}
+
+// requireAPIKey rejects requests without the configured key
+// The key is read from X-Api-Key, the basic auth password or the apikey query
+// Anahtar X-Api-Key, temel kimlik doğrulama parolası veya apikey sorgusundan okunur
+func (a *App) requireAPIKey(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get("X-Api-Key")
+		if key == "" {
+			_, key, _ = r.BasicAuth()
+		}
+		if key == "" {
+			key = r.URL.Query().Get("apikey")
+		}
+		if subtle.ConstantTimeCompare([]byte(key), []byte(a.currentPreferences().APIKey)) != 1 {
+			log.Printf("Rejected API request from %s to %s", r.RemoteAddr, r.URL.Path)
+			writeAPIError(w, http.StatusUnauthorized, "invalid API key")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// handleAPIJobs lists the jobs known to the backend on GET and queues a file on POST
+// GET ile arka ucun bildiği işleri listeler, POST ile bir dosyayı kuyruğa ekler
+func (a *App) handleAPIJobs(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		writeAPIJSON(w, http.StatusOK, a.GetJobs())
+	case http.MethodPost:
+		a.handleAPIEnqueue(w, r)
+	default:
+		writeAPIError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+// writeAPIJSON writes a JSON response
+// Bir JSON yanıtı yazar
+func writeAPIJSON(w http.ResponseWriter, status int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(value); err != nil {
+		log.Printf("Error writing API response: %v", err)
+	}
+}
+
+// writeAPIError writes a JSON error response
+// Bir JSON hata yanıtı yazar
+func writeAPIError(w http.ResponseWriter, status int, message string) {
+	writeAPIJSON(w, status, map[string]string{"error": message})
+}
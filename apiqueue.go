@@ -0,0 +1,221 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"AV1-video-converter/internal/events"
+)
+
+// maxEnqueuePayloadSize limits the size of an enqueue request
+// Bir kuyruğa ekleme isteğinin boyutunu sınırlar
+const maxEnqueuePayloadSize = 64 * 1024
+
+// watchBufferSize is the number of events a slow watch stream may fall behind before missing some
+// Yavaş bir izleme akışının olay kaçırmaya başlamadan önce geride kalabileceği olay sayısı
+const watchBufferSize = 256
+
+// enqueueRequest struct
+// Represents a file queued through the API
+// API üzerinden kuyruğa eklenen bir dosyayı temsil eder
+type enqueueRequest struct {
+	InputPath    string `json:"inputPath"`    // Local source file / Yerel kaynak dosya
+	OutputFolder string `json:"outputFolder"` // Empty for next to the source / Kaynağın yanı için boş
+	Profile      string `json:"profile"`      // Platform profile or archive-<mode>, empty for the current settings / Platform profili veya archive-<mode>, geçerli ayarlar için boş
+}
+
+// notesRequest struct
+// Represents the notes and tags set through the API
+// API üzerinden ayarlanan notları ve etiketleri temsil eder
+type notesRequest struct {
+	Notes string   `json:"notes"` // Free text / Serbest metin
+	Tags  []string `json:"tags"`  // Labels / Etiketler
+}
+
+// errInvalidEnqueue marks an enqueue request that is wrong in itself, not the file it names
+// Adlandırdığı dosya değil, kendisi hatalı olan bir kuyruğa ekleme isteğini işaretler
+var errInvalidEnqueue = errors.New("invalid enqueue request")
+
+// handleAPIEnqueue queues a file with the current settings or a profile
+// The job is announced like a watch folder job, so the frontend converts it in turn
+// İş bir izleme klasörü işi gibi duyurulur, böylece ön yüz sırası gelince dönüştürür
+func (a *App) handleAPIEnqueue(w http.ResponseWriter, r *http.Request) {
+	var request enqueueRequest
+	if err := json.NewDecoder(io.LimitReader(r.Body, maxEnqueuePayloadSize)).Decode(&request); err != nil {
+		writeAPIError(w, http.StatusBadRequest, fmt.Sprintf("invalid payload: %v", err))
+		return
+	}
+	job, err := a.enqueue(request)
+	if errors.Is(err, errInvalidEnqueue) {
+		writeAPIError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err != nil {
+		writeAPIError(w, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+	writeAPIJSON(w, http.StatusAccepted, job)
+}
+
+// enqueue probes the file of an API request and queues it, the HTTP API and the gRPC service share it
+// Bir API isteğinin dosyasını inceler ve kuyruğa ekler, HTTP API'si ve gRPC hizmeti bunu paylaşır
+func (a *App) enqueue(request enqueueRequest) (*Job, error) {
+	if request.InputPath == "" {
+		return nil, fmt.Errorf("%w: inputPath is required", errInvalidEnqueue)
+	}
+	settings, err := watchProfileSettings(a.GetSettings(), request.Profile)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidEnqueue, err)
+	}
+	info, err := a.getVideoInfo(request.InputPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to probe %s: %v", request.InputPath, err)
+	}
+	job, err := a.queueFile(request.InputPath, request.OutputFolder, info, settings)
+	if err != nil {
+		log.Printf("Error queueing %s from the API: %v", request.InputPath, err)
+		return nil, err
+	}
+	return job, nil
+}
+
+// handleAPIJob returns a job on GET /api/jobs/{id} and cancels it on POST /api/jobs/{id}/cancel
+// POST /api/jobs/{id}/notes sets its notes and tags, also for jobs only left in the history
+// POST /api/jobs/{id}/notes notlarını ve etiketlerini ayarlar, yalnızca geçmişte kalan işler için de
+func (a *App) handleAPIJob(w http.ResponseWriter, r *http.Request) {
+	jobID, action, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/api/jobs/"), "/")
+	if action == "notes" {
+		a.handleAPIJobNotes(w, r, jobID)
+		return
+	}
+	job, ok := a.getJob(jobID)
+	if !ok {
+		writeAPIError(w, http.StatusNotFound, fmt.Sprintf("unknown job: %s", jobID))
+		return
+	}
+
+	switch {
+	case action == "" && r.Method == http.MethodGet:
+		writeAPIJSON(w, http.StatusOK, job)
+	case action == "cancel" && r.Method == http.MethodPost:
+		if err := a.CancelConversion(jobID); err != nil {
+			writeAPIError(w, http.StatusConflict, err.Error())
+			return
+		}
+		writeAPIJSON(w, http.StatusAccepted, map[string]string{"status": "cancelling"})
+	case action == "" || action == "cancel":
+		writeAPIError(w, http.StatusMethodNotAllowed, "method not allowed")
+	default:
+		writeAPIError(w, http.StatusNotFound, "not found")
+	}
+}
+
+// handleAPIJobNotes sets the notes and tags of a job
+// Bir işin notlarını ve etiketlerini ayarlar
+func (a *App) handleAPIJobNotes(w http.ResponseWriter, r *http.Request, jobID string) {
+	if r.Method != http.MethodPost {
+		writeAPIError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	var request notesRequest
+	if err := json.NewDecoder(io.LimitReader(r.Body, maxEnqueuePayloadSize)).Decode(&request); err != nil {
+		writeAPIError(w, http.StatusBadRequest, fmt.Sprintf("invalid payload: %v", err))
+		return
+	}
+	if err := a.SetJobNotes(jobID, request.Notes, request.Tags); err != nil {
+		status := http.StatusBadRequest
+		if strings.HasPrefix(err.Error(), "unknown job") {
+			status = http.StatusNotFound
+		}
+		writeAPIError(w, status, err.Error())
+		return
+	}
+	writeAPIJSON(w, http.StatusOK, map[string]string{"status": "saved"})
+}
+
+// handleAPIHistory returns the conversion history, ?q=, ?tag= and ?status= narrow it down
+// Dönüştürme geçmişini döndürür, ?q=, ?tag= ve ?status= onu daraltır
+func (a *App) handleAPIHistory(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeAPIError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	query := r.URL.Query()
+	history, err := a.SearchHistory(HistoryQuery{
+		Text:   query.Get("q"),
+		Tag:    query.Get("tag"),
+		Status: query.Get("status"),
+	})
+	if err != nil {
+		writeAPIError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeAPIJSON(w, http.StatusOK, history)
+}
+
+// handleAPIFolders returns the watch folders, so a remote desktop app can show what this instance picks up
+// Uzak bir masaüstü uygulaması bu örneğin neyi aldığını gösterebilsin diye izleme klasörlerini döndürür
+func (a *App) handleAPIFolders(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeAPIError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	folders := a.currentPreferences().WatchFolders
+	if folders == nil {
+		folders = []WatchFolder{}
+	}
+	writeAPIJSON(w, http.StatusOK, folders)
+}
+
+// handleAPIWatch streams the timeline events as newline delimited JSON envelopes
+// With ?job=<id> only that job is followed and the stream ends once it completes or fails
+// ?job=<id> ile yalnızca o iş izlenir ve iş bitince veya başarısız olunca akış sona erer
+func (a *App) handleAPIWatch(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeAPIError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeAPIError(w, http.StatusInternalServerError, "streaming is not supported")
+		return
+	}
+	jobID := r.URL.Query().Get("job")
+	if jobID != "" {
+		if _, ok := a.getJob(jobID); !ok {
+			writeAPIError(w, http.StatusNotFound, fmt.Sprintf("unknown job: %s", jobID))
+			return
+		}
+	}
+
+	envelopes, unsubscribe := a.watchers.Subscribe(watchBufferSize)
+	defer unsubscribe()
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	encoder := json.NewEncoder(w)
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case envelope := <-envelopes:
+			if jobID != "" && envelope.JobID != jobID {
+				continue
+			}
+			if err := encoder.Encode(envelope); err != nil {
+				return
+			}
+			flusher.Flush()
+			if jobID != "" && (envelope.Type == events.JobCompleted || envelope.Type == events.JobFailed) {
+				return
+			}
+		}
+	}
+}
@@ -1,53 +1,160 @@
 package main
 
 import (
-	"bufio"
-	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
+	"math"
+	"net/http"
+	"net/url"
 	"os"
 	"os/exec"
 	"path/filepath"
-	"regexp"
+	"runtime/debug"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
-	"github.com/wailsapp/wails/v2/pkg/runtime"
+	"AV1-video-converter/internal/events"
+	"AV1-video-converter/internal/probe"
+	"AV1-video-converter/internal/progress"
+	"AV1-video-converter/internal/queue"
+	"AV1-video-converter/internal/runner"
+	"AV1-video-converter/internal/timecode"
+
+	"google.golang.org/grpc"
 )
 
 // VideoInfo struct
 // Represents information about a video file
 // Bir video dosyası hakkında bilgileri temsil eder
 type VideoInfo struct {
-	FullPath   string `json:"fullPath"`   // Full path of the video file / Video dosyasının tam yolu
-	Duration   string `json:"duration"`   // Duration of the video / Videonun süresi
-	FrameCount int    `json:"frameCount"` // Total number of frames / Toplam kare sayısı
-	Codec      string `json:"codec"`      // Video codec / Video kodeki
-	Size       string `json:"size"`       // File size / Dosya boyutu
+	FullPath        string   `json:"fullPath"`        // Full path of the video file / Video dosyasının tam yolu
+	Duration        string   `json:"duration"`        // Duration of the video / Videonun süresi
+	DurationSeconds float64  `json:"durationSeconds"` // Duration in seconds / Saniye cinsinden süre
+	DurationSource  string   `json:"durationSource"`  // Where the duration came from, packets means estimated / Sürenin kaynağı, packets tahmini demektir
+	DropFrame       bool     `json:"dropFrame"`       // Duration is a drop-frame timecode (29.97/59.94) / Süre drop-frame bir zaman kodudur (29.97/59.94)
+	FrameCount      int      `json:"frameCount"`      // Total number of frames / Toplam kare sayısı
+	Codec           string   `json:"codec"`           // Video codec / Video kodeki
+	Size            string   `json:"size"`            // File size / Dosya boyutu
+	Width           int      `json:"width"`           // Video width in pixels / Piksel cinsinden video genişliği
+	Height          int      `json:"height"`          // Video height in pixels / Piksel cinsinden video yüksekliği
+	FrameRate       float64  `json:"frameRate"`       // Frames per second / Saniyedeki kare sayısı
+	BitDepth        int      `json:"bitDepth"`        // Bits per color component / Renk bileşeni başına bit
+	ColorTransfer   string   `json:"colorTransfer"`   // Transfer characteristics / Aktarım özellikleri
+	ColorPrimaries  string   `json:"colorPrimaries"`  // Color primaries / Renk primerleri
+	ColorSpace      string   `json:"colorSpace"`      // Matrix coefficients / Matris katsayıları
+	ColorRange      string   `json:"colorRange"`      // tv or pc range / tv veya pc aralığı
+	HDR             bool     `json:"hdr"`             // PQ or HLG transfer / PQ veya HLG aktarımı
+	DolbyVision     int      `json:"dolbyVision"`     // Dolby Vision profile, 0 for none / Dolby Vision profili, yoksa 0
+	HDR10Plus       bool     `json:"hdr10Plus"`       // HDR10+ dynamic metadata / HDR10+ dinamik meta verisi
+	Bitrate         int      `json:"bitrate"`         // Overall bitrate in kbps / Kbps cinsinden toplam bit hızı
+	AudioTracks     []string `json:"audioTracks"`     // Audio track summaries / Ses parçası özetleri
+	SubtitleCount   int      `json:"subtitleCount"`   // Number of subtitle tracks / Altyazı parçası sayısı
+	AudioOnly       bool     `json:"audioOnly"`       // No video stream, Codec is the audio codec / Video akışı yok, Codec ses kodekidir
 }
 
 // App struct
 // Represents the main application structure
 // Ana uygulama yapısını temsil eder
 type App struct {
-	ctx             context.Context // Application context / Uygulama bağlamı
-	appDir          string          // Application directory / Uygulama dizini
-	ffmpegPath      string          // Path to FFmpeg executable / FFmpeg yürütülebilir dosyasının yolu
-	ffprobePath     string          // Path to FFprobe executable / FFprobe yürütülebilir dosyasının yolu
-	logFile         *os.File        // Log file / Log dosyası
-	configPath      string          // Path to config file / Yapılandırma dosyasının yolu
-	lastDestination string          // Last used destination folder / Son kullanılan hedef klasör
+	ctx                   context.Context                               // Application context / Uygulama bağlamı
+	appDir                string                                        // Application directory / Uygulama dizini
+	ffmpegPath            string                                        // Path to FFmpeg executable / FFmpeg yürütülebilir dosyasının yolu
+	ffprobePath           string                                        // Path to FFprobe executable / FFprobe yürütülebilir dosyasının yolu
+	logFile               *os.File                                      // Log file / Log dosyası
+	configPath            string                                        // Path to config file / Yapılandırma dosyasının yolu
+	lastDestination       string                                        // Last used destination folder / Son kullanılan hedef klasör
+	settings              ConversionSettings                            // Current conversion settings / Geçerli dönüştürme ayarları
+	ffmpegVersion         string                                        // Version of the FFmpeg in use / Kullanılan FFmpeg sürümü
+	historyPath           string                                        // Path to history file / Geçmiş dosyasının yolu
+	historyMu             sync.Mutex                                    // Guards the history file / Geçmiş dosyasını korur
+	media                 *mediaServer                                  // Serves files to the preview player / Önizleme oynatıcısına dosya sunar
+	probeCache            *probeCache                                   // Cached FFprobe results / Önbelleğe alınmış FFprobe sonuçları
+	preferences           AppPreferences                                // Application preferences / Uygulama tercihleri
+	configMu              sync.RWMutex                                  // Guards settings, preferences and calibration, read them through GetSettings, currentPreferences and GetCalibration / settings, preferences ve calibration kilidi
+	appCtx                context.Context                               // Cancelled on shutdown / Kapanışta iptal edilir
+	appCancel             context.CancelCauseFunc                       // Cancels appCtx / appCtx'i iptal eder
+	shutdownOnce          sync.Once                                     // Runs shutdown once for the window and a signal / Kapanışı pencere ve sinyal için bir kez çalıştırır
+	jobs                  *queue.Registry[Job]                          // Conversion jobs / Dönüştürme işleri
+	ffmpegInfo            *FFmpegInfo                                   // Cached FFmpeg capabilities / Önbelleğe alınmış FFmpeg yetenekleri
+	ffmpegInfoMu          sync.Mutex                                    // Guards ffmpegInfo / ffmpegInfo kilidi
+	upload                UploadConfig                                  // Bucket upload configuration / Depo yükleme yapılandırması
+	remotes               []RemoteConnection                            // SFTP and FTP destinations / SFTP ve FTP hedefleri
+	workflows             []Workflow                                    // Saved batch workflows / Kayıtlı toplu iş akışları
+	rclonePath            string                                        // rclone binary, empty if not installed / rclone ikili dosyası, kurulu değilse boş
+	scanCancel            context.CancelFunc                            // Stops the running library scan / Çalışan kütüphane taramasını durdurur
+	scanMu                sync.Mutex                                    // Guards scanCancel / scanCancel kilidi
+	api                   *http.Server                                  // Local HTTP API, nil when disabled / Yerel HTTP API, kapalıyken nil
+	grpc                  *grpc.Server                                  // gRPC service, nil when disabled / gRPC hizmeti, kapalıyken nil
+	apiMu                 sync.Mutex                                    // Guards api and grpc / api ve grpc kilidi
+	remoteConverter       RemoteConverter                               // Headless instance controlled from here / Buradan yönetilen başsız örnek
+	remoteConverterCancel context.CancelFunc                            // Stops following its events / Olaylarını izlemeyi durdurur
+	remoteConverterMu     sync.Mutex                                    // Guards the remote converter / Uzak dönüştürücü kilidi
+	uploadMu              sync.Mutex                                    // Guards the bucket upload config / Depo yükleme yapılandırması kilidi
+	remotesMu             sync.Mutex                                    // Guards remotes, read them through currentRemotes / remotes kilidi
+	workflowsMu           sync.Mutex                                    // Guards workflows, read them through currentWorkflows / workflows kilidi
+	lastWake              time.Time                                     // When the system last woke from sleep / Sistemin uykudan son uyandığı zaman
+	sleepMu               sync.Mutex                                    // Guards lastWake / lastWake kilidi
+	secretKeyValue        []byte                                        // Loaded secret key / Yüklenen gizli anahtar
+	secretStorage         string                                        // Where the secret key lives, keychain or file / Gizli anahtarın bulunduğu yer, keychain veya file
+	sealedSecrets         map[string]string                             // Stored ciphertext of credentials that couldn't be decrypted / Şifresi çözülemeyen kimlik bilgilerinin saklanan şifreli metni
+	secretMu              sync.Mutex                                    // Guards the secret key and sealedSecrets / Gizli anahtar ve sealedSecrets kilidi
+	telemetrySentAt       time.Time                                     // Last usage report / Son kullanım raporu
+	events                events.Sink                                   // Reports events to the frontend / Olayları ön yüze bildirir
+	watchers              *events.Broadcaster                           // Passes timeline events to API watch streams / Zaman çizelgesi olaylarını API izleme akışlarına iletir
+	runner                runner.Runner                                 // Runs FFprobe and other tools / FFprobe ve diğer araçları çalıştırır
+	prober                probe.Prober                                  // Reads media information / Medya bilgilerini okur
+	newFFmpeg             func(logWriter io.Writer) runner.FFmpegRunner // Creates FFmpeg processes, replaced by a fake in tests / FFmpeg işlemleri oluşturur, testlerde sahtesiyle değiştirilir
+	checksumMu            sync.Mutex                                    // Guards the batch checksum lists / Toplu sağlama toplamı listelerini korur
+	watchCancel           context.CancelFunc                            // Stops the watch folder poller / İzleme klasörü yoklayıcısını durdurur
+	watchMu               sync.Mutex                                    // Guards watchCancel / watchCancel kilidi
+	slots                 jobGate                                       // Parallel job limit and calibration lock / Paralel iş sınırı ve kalibrasyon kilidi
+	memory                memoryGate                                    // Holds jobs back while they would exceed the memory budget / Bellek bütçesini aşacak işleri bekletir
+	deadline              *QueueDeadline                                // Time the queue must finish by, nil for none / Kuyruğun bitmesi gereken zaman, yoksa nil
+	deadlineMu            sync.Mutex                                    // Guards deadline / deadline kilidi
+	hardwareEncoder       *string                                       // Hardware encoder of the balanced mode, nil until detected / Dengeli modun donanım kodlayıcısı, algılanana kadar nil
+	hardwareMu            sync.Mutex                                    // Guards hardwareEncoder / hardwareEncoder kilidi
+	calibration           *Calibration                                  // Preset and concurrency measured on this machine, nil before the first run / Bu makinede ölçülen ön ayar ve eşzamanlılık, ilk çalıştırmadan önce nil
+}
+
+// appConfig struct
+// Represents the contents of the config file
+// Yapılandırma dosyasının içeriğini temsil eder
+type appConfig struct {
+	LastDestination string             `json:"lastDestination"` // Last used destination folder / Son kullanılan hedef klasör
+	Settings        ConversionSettings `json:"settings"`        // Conversion settings / Dönüştürme ayarları
+	Preferences     AppPreferences     `json:"preferences"`     // Application preferences / Uygulama tercihleri
+	Upload          UploadConfig       `json:"upload"`          // Bucket upload configuration / Depo yükleme yapılandırması
+	Remotes         []RemoteConnection `json:"remotes"`         // SFTP and FTP destinations / SFTP ve FTP hedefleri
+	RemoteConverter RemoteConverter    `json:"remoteConverter"` // Headless instance controlled from here / Buradan yönetilen başsız örnek
+	Workflows       []Workflow         `json:"workflows"`       // Saved batch workflows / Kayıtlı toplu iş akışları
+	TelemetrySentAt time.Time          `json:"telemetrySentAt"` // Last usage report / Son kullanım raporu
+	Calibration     *Calibration       `json:"calibration"`     // Preset and concurrency measured on this machine / Bu makinede ölçülen ön ayar ve eşzamanlılık
 }
 
 // NewApp creates a new App application struct
 // Creates and returns a new instance of the App struct
 // App yapısının yeni bir örneğini oluşturur ve döndürür
 func NewApp() *App {
-	return &App{}
+	a := &App{
+		settings:    defaultSettings(),
+		preferences: defaultPreferences(),
+		media:       newMediaServer(),
+		jobs:        queue.New[Job](),
+		events:      events.Discard,
+		watchers:    events.NewBroadcaster(),
+		runner:      runner.Exec{WaitDelay: 5 * time.Second},
+	}
+	a.newFFmpeg = func(logWriter io.Writer) runner.FFmpegRunner {
+		return &runner.FFmpeg{Path: a.ffmpegPath, Log: logWriter, WaitDelay: 10 * time.Second}
+	}
+	return a
 }
 
 // startup is called when the app starts
@@ -57,19 +164,25 @@ func (a *App) startup(ctx context.Context) {
 	// Save the context
 	// Bağlamı kaydet
 	a.ctx = ctx
+	a.appCtx, a.appCancel = context.WithCancelCause(ctx)
+	a.events = events.Tee(frontendSink(ctx), a.watchers)
+
+	// Keep data next to the executable unless the server build was given a data folder
+	// Sunucu yapısına bir veri klasörü verilmediyse verileri yürütülebilir dosyanın yanında tut
+	if a.appDir == "" {
+		// Get the executable path
+		// Yürütülebilir dosya yolunu al
+		executablePath, err := os.Executable()
+		if err != nil {
+			log.Fatal("Error getting executable path:", err)
+		}
+		a.appDir = filepath.Dir(executablePath)
 
-	// Get the executable path
-	// Yürütülebilir dosya yolunu al
-	executablePath, err := os.Executable()
-	if err != nil {
-		log.Fatal("Error getting executable path:", err)
-	}
-	a.appDir = filepath.Dir(executablePath)
-
-	// Special case for MacOS application
-	// MacOS uygulaması için özel durum
-	if strings.HasSuffix(a.appDir, "MacOS") {
-		a.appDir = filepath.Dir(filepath.Dir(a.appDir))
+		// Special case for MacOS application
+		// MacOS uygulaması için özel durum
+		if strings.HasSuffix(a.appDir, "MacOS") {
+			a.appDir = filepath.Dir(filepath.Dir(a.appDir))
+		}
 	}
 
 	// Log current working directory and executable path
@@ -84,10 +197,6 @@ func (a *App) startup(ctx context.Context) {
 		log.Fatal("Error creating logs directory:", err)
 	}
 
-	// Perform cleanup operations
-	// Temizleme işlemlerini gerçekleştir
-	a.cleanupLogs(logsDir)
-
 	// Clear and reopen app.log file
 	// app.log dosyasını temizle ve yeniden aç
 	appLogPath := filepath.Join(logsDir, "app.log")
@@ -95,10 +204,11 @@ func (a *App) startup(ctx context.Context) {
 		log.Printf("Error truncating app.log: %v", err)
 	}
 
-	a.logFile, err = os.OpenFile(appLogPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	logFile, err := os.OpenFile(appLogPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
 	if err != nil {
 		log.Fatal("Error opening log file:", err)
 	}
+	a.logFile = logFile
 	log.SetOutput(a.logFile)
 
 	// Find FFmpeg and FFprobe
@@ -110,11 +220,62 @@ func (a *App) startup(ctx context.Context) {
 	}
 	log.Printf("Using FFmpeg: %s", a.ffmpegPath)
 	log.Printf("Using FFprobe: %s", a.ffprobePath)
+	a.prober = probe.FFprobe{Path: a.ffprobePath, Runner: a.runner}
+	a.ffmpegVersion = detectFFmpegVersion(a.ffmpegPath)
+	log.Printf("FFmpeg version: %s", a.ffmpegVersion)
+
+	// rclone is optional, it only enables rclone destinations
+	// rclone isteğe bağlıdır, yalnızca rclone hedeflerini etkinleştirir
+	a.rclonePath = a.findExecutable("rclone")
 
 	// Load config
 	// Yapılandırmayı yükle
 	a.configPath = filepath.Join(a.appDir, "config.json")
 	a.loadConfig()
+	a.historyPath = filepath.Join(a.appDir, "history.json")
+
+	// Apply the log retention rules
+	// Log saklama kurallarını uygula
+	a.cleanupLogs()
+
+	// Load cached probe results
+	// Önbelleğe alınmış inceleme sonuçlarını yükle
+	a.probeCache = newProbeCache(filepath.Join(a.appDir, "probe_cache.json"))
+
+	// Serve the HTTP API if enabled
+	// Etkinse HTTP API'sini sun
+	if err := a.startAPI(); err != nil {
+		log.Printf("Error starting API: %v", err)
+	}
+
+	// Start watching the configured folders
+	// Yapılandırılmış klasörleri izlemeye başla
+	a.startWatchFolders()
+
+	// Follow the events of the remote converter if one is connected
+	// Bağlı bir uzak dönüştürücü varsa olaylarını izle
+	a.startRemoteConverterEvents()
+
+	// Finish the bucket uploads the last run left unfinished
+	// Son çalıştırmanın bitiremediği depo yüklemelerini tamamla
+	go func() {
+		defer a.recoverCrash("resumeUploads")
+		a.resumeUploads()
+	}()
+
+	// Resume the queue when the system wakes from sleep
+	// Sistem uykudan uyandığında kuyruğa devam et
+	go func() {
+		defer a.recoverCrash("watchSleep")
+		a.watchSleep(a.appCtx)
+	}()
+
+	// Send the usage statistics if opted in and due
+	// İzin verildiyse ve zamanı geldiyse kullanım istatistiklerini gönder
+	go func() {
+		defer a.recoverCrash("sendTelemetryIfDue")
+		a.sendTelemetryIfDue()
+	}()
 }
 
 // findExecutable locates the specified executable in various paths
@@ -152,38 +313,9 @@ func (a *App) findExecutable(name string) string {
 	return ""
 }
 
-// cleanupLogs removes old log files
-// Deletes log files older than 24 hours, except for app.log
-// 24 saatten eski Log dosyalarını siler, app.log hariç
-func (a *App) cleanupLogs(logsDir string) {
-	// Read all files in the logs directory
-	// Log dizinindeki tüm dosyaları oku
-	files, err := ioutil.ReadDir(logsDir)
-	if err != nil {
-		log.Printf("Error reading logs directory: %v", err)
-		return
-	}
-
-	now := time.Now()
-	for _, file := range files {
-		if file.Name() == "app.log" {
-			continue // Skip app.log / app.log'u atla
-		}
-
-		filePath := filepath.Join(logsDir, file.Name())
-		if now.Sub(file.ModTime()) > 24*time.Hour {
-			if err := os.Remove(filePath); err != nil {
-				log.Printf("Error removing old log file %s: %v", filePath, err)
-			} else {
-				log.Printf("Removed old log file: %s", filePath)
-			}
-		}
-	}
-}
-
 // loadConfig reads the configuration file
-// Loads the last used destination folder from the config file
-// Yapılandırma dosyasından son kullanılan hedef klasörü yükler
+// Loads the last used destination folder and settings from the config file
+// Yapılandırma dosyasından son kullanılan hedef klasörü ve ayarları yükler
 func (a *App) loadConfig() {
 	// Read the config file
 	// Yapılandırma dosyasını oku
@@ -195,8 +327,9 @@ func (a *App) loadConfig() {
 
 	// Unmarshal the JSON data
 	// JSON verisini çöz
-	var config struct {
-		LastDestination string `json:"lastDestination"`
+	config := appConfig{
+		Settings:    defaultSettings(),
+		Preferences: defaultPreferences(),
 	}
 	if err := json.Unmarshal(data, &config); err != nil {
 		log.Printf("Error unmarshalling config: %v", err)
@@ -206,19 +339,116 @@ func (a *App) loadConfig() {
 	// Set the last destination
 	// Son hedefi ayarla
 	a.lastDestination = config.LastDestination
+	a.telemetrySentAt = config.TelemetrySentAt
+	a.configMu.Lock()
+	a.calibration = config.Calibration
+	a.configMu.Unlock()
+
+	// Use the saved preferences only if they are still valid
+	// Kaydedilen tercihleri yalnızca hâlâ geçerliyse kullan
+	// A value that can't be decrypted is kept encrypted for the next save, the feature stays off until then
+	// Şifresi çözülemeyen bir değer sonraki kayıt için şifreli tutulur, özellik o zamana kadar kapalı kalır
+	if config.Preferences.APIKey, err = a.openSecret("api", config.Preferences.APIKey); err != nil {
+		log.Printf("Error decrypting API key: %v", err)
+		config.Preferences.APIKey, config.Preferences.APIEnabled = "", false
+	}
+	if err := validatePreferences(config.Preferences); err != nil {
+		log.Printf("Ignoring invalid saved preferences: %v", err)
+	} else {
+		a.configMu.Lock()
+		a.preferences = config.Preferences
+		a.configMu.Unlock()
+	}
+
+	// Use the saved upload configuration only if it is still valid
+	// Kaydedilen yükleme yapılandırmasını yalnızca hâlâ geçerliyse kullan
+	if config.Upload.SecretAccessKey, err = a.openSecret("upload", config.Upload.SecretAccessKey); err != nil {
+		log.Printf("Error decrypting bucket secret key: %v", err)
+		config.Upload.SecretAccessKey, config.Upload.Enabled = "", false
+	}
+	if err := validateUploadConfig(config.Upload); err != nil {
+		log.Printf("Ignoring invalid saved upload config: %v", err)
+	} else {
+		a.updateUpload(func(upload *UploadConfig) { *upload = config.Upload })
+	}
+
+	// Decrypt the passwords of the remote destinations
+	// Uzak hedeflerin parolalarını çöz
+	var remotes []RemoteConnection
+	for _, remote := range config.Remotes {
+		if err := validateRemoteConnection(remote); err != nil {
+			log.Printf("Ignoring invalid saved connection %s: %v", remote.Name, err)
+			continue
+		}
+		password, err := a.openSecret(credentialRemotePrefix+remote.Name, remote.Password)
+		if err != nil {
+			log.Printf("Error decrypting password of %s: %v", remote.Name, err)
+		}
+		remote.Password = password
+		remotes = append(remotes, remote)
+	}
+	a.remotesMu.Lock()
+	a.remotes = remotes
+	a.remotesMu.Unlock()
+
+	// Decrypt the key of the remote converter
+	// Uzak dönüştürücünün anahtarını çöz
+	if config.RemoteConverter.APIKey, err = a.openSecret("remoteConverter", config.RemoteConverter.APIKey); err != nil {
+		log.Printf("Error decrypting remote converter key: %v", err)
+	}
+	a.remoteConverter = config.RemoteConverter
+
+	// Use the saved workflows that are still valid
+	// Kaydedilen iş akışlarından hâlâ geçerli olanları kullan
+	var workflows []Workflow
+	for _, workflow := range config.Workflows {
+		if err := validateWorkflow(workflow); err != nil {
+			log.Printf("Ignoring invalid saved workflow %s: %v", workflow.Name, err)
+			continue
+		}
+		workflows = append(workflows, workflow)
+	}
+	a.workflowsMu.Lock()
+	a.workflows = workflows
+	a.workflowsMu.Unlock()
+
+	// Use the saved settings only if they are still valid
+	// Kaydedilen ayarları yalnızca hâlâ geçerliyse kullan
+	if errs := validateSettings(config.Settings); len(errs) > 0 {
+		log.Printf("Ignoring invalid saved settings: %v", errs)
+		return
+	}
+	a.configMu.Lock()
+	a.settings = config.Settings
+	a.configMu.Unlock()
 }
 
 // saveConfig writes the current configuration to file
-// Saves the current destination folder to the config file
-// Mevcut hedef klasörü yapılandırma dosyasına kaydeder
+// Saves the current destination folder and settings to the config file
+// Mevcut hedef klasörü ve ayarları yapılandırma dosyasına kaydeder
 func (a *App) saveConfig() {
 	// Prepare the config data
 	// Yapılandırma verisini hazırla
-	config := struct {
-		LastDestination string `json:"lastDestination"`
-	}{
+	config := appConfig{
 		LastDestination: a.lastDestination,
+		Settings:        a.GetSettings(),
+		Preferences:     a.currentPreferences(),
+		Upload:          a.currentUpload(),
+		Workflows:       a.currentWorkflows(),
+		TelemetrySentAt: a.telemetrySentAt,
+		Calibration:     a.GetCalibration(),
+	}
+
+	// Passwords never reach the file in plain text
+	// Parolalar dosyaya asla düz metin olarak ulaşmaz
+	config.Preferences.APIKey = a.sealSecret("api", config.Preferences.APIKey)
+	config.Upload.SecretAccessKey = a.sealSecret("upload", config.Upload.SecretAccessKey)
+	for _, remote := range a.currentRemotes() {
+		remote.Password = a.sealSecret(credentialRemotePrefix+remote.Name, remote.Password)
+		config.Remotes = append(config.Remotes, remote)
 	}
+	config.RemoteConverter = a.currentRemoteConverter()
+	config.RemoteConverter.APIKey = a.sealSecret("remoteConverter", config.RemoteConverter.APIKey)
 
 	// Marshal the config to JSON
 	// Yapılandırmayı JSON'a dönüştür
@@ -239,6 +469,33 @@ func (a *App) saveConfig() {
 // Performs cleanup operations when the application is closing
 // Uygulama kapanırken temizleme işlemlerini gerçekleştirir
 func (a *App) shutdown(ctx context.Context) {
+	a.shutdownOnce.Do(a.cleanUp)
+}
+
+// cleanUp cancels the jobs, waits for them to clean up and releases the application resources
+// FFmpeg processes still alive after shutdownGrace are killed
+// İşleri iptal eder, temizlenmelerini bekler ve uygulama kaynaklarını serbest bırakır
+func (a *App) cleanUp() {
+	// Stop running jobs and probes, kill what hasn't stopped in time
+	// Çalışan işleri ve incelemeleri durdur, zamanında durmayanları sonlandır
+	if a.appCancel != nil {
+		a.appCancel(errShuttingDown)
+	}
+	if !a.waitForJobs(shutdownGrace) {
+		log.Printf("Jobs didn't stop within %s, killing FFmpeg", shutdownGrace)
+	}
+	runner.KillAll()
+
+	// Stop watching folders and accepting API requests
+	// Klasörleri izlemeyi ve API isteklerini kabul etmeyi durdur
+	a.stopWatchFolders()
+	a.stopAPI()
+	a.stopRemoteConverterEvents()
+
+	// Persist the probe cache
+	// İnceleme önbelleğini kaydet
+	a.probeCache.save()
+
 	// Close the log file if it's open
 	// Log dosyası açıksa kapat
 	if a.logFile != nil {
@@ -252,34 +509,18 @@ func (a *App) shutdown(ctx context.Context) {
 func (a *App) SelectVideoFiles() ([]VideoInfo, error) {
 	// Open file dialog for selecting video files
 	// Video dosyaları seçmek için dosya iletişim kutusunu aç
-	files, err := runtime.OpenMultipleFilesDialog(a.ctx, runtime.OpenDialogOptions{
-		Title: "Select Video Files",
-		Filters: []runtime.FileFilter{
-			{DisplayName: "Video Files", Pattern: "*.mp4;*.avi;*.mov;*.mkv"},
-		},
-	})
+	files, err := a.openFilesDialog("Select Video Files",
+		fileFilter{name: "Video Files", pattern: videoFilePattern(a.videoExtensions())},
+		fileFilter{name: "Audio Files", pattern: videoFilePattern(a.audioExtensions())},
+	)
 	if err != nil {
 		log.Printf("Error selecting files: %v", err)
 		return nil, err
 	}
 
-	// Process selected files
-	// Seçilen dosyaları işle
-	var videoInfos []VideoInfo
-	for _, file := range files {
-		log.Printf("Processing file: %s", file)
-		if _, err := os.Stat(file); os.IsNotExist(err) {
-			log.Printf("File does not exist: %s", file)
-			continue
-		}
-		info, err := a.getVideoInfo(file)
-		if err != nil {
-			log.Printf("Error getting info for %s: %v", file, err)
-			continue
-		}
-		videoInfos = append(videoInfos, info)
-		log.Printf("Successfully processed file: %s", file)
-	}
+	// Process selected files concurrently
+	// Seçilen dosyaları eşzamanlı işle
+	videoInfos := a.probeFiles(files)
 
 	// Return the video information to the frontend
 	// Video bilgilerini Frontend'e gönder
@@ -287,38 +528,26 @@ func (a *App) SelectVideoFiles() ([]VideoInfo, error) {
 }
 
 // getVideoInfo retrieves detailed information about a video file
-// Uses FFprobe to get video metadata such as duration, frame count, codec, and size
-// FFprobe kullanarak video meta verilerini (süre, kare sayısı, kodek, boyut) alır
+// Uses FFprobe to get video metadata such as duration, frame count, codec, size, resolution and color
+// FFprobe kullanarak video meta verilerini (süre, kare sayısı, kodek, boyut, çözünürlük, renk) alır
 func (a *App) getVideoInfo(filePath string) (VideoInfo, error) {
-	cmd := exec.Command(a.ffprobePath, "-v", "quiet", "-print_format", "json", "-show_format", "-show_streams", filePath)
+	return a.probeVideoInfo(a.baseContext(), filePath)
+}
 
-	var stdout, stderr bytes.Buffer
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
+// probeVideoInfo is getVideoInfo with the FFprobe processes bound to ctx
+// FFprobe işlemleri ctx'e bağlı getVideoInfo'dur
+func (a *App) probeVideoInfo(ctx context.Context, filePath string) (VideoInfo, error) {
+	// Use the cached result if the file hasn't changed
+	// Dosya değişmediyse önbellekteki sonucu kullan
+	stat, statErr := a.statWithTimeout(filePath)
+	if statErr == nil {
+		if info, ok := a.probeCache.get(filePath, stat); ok {
+			return info, nil
+		}
+	}
 
-	err := cmd.Run()
+	result, err := a.probeFile(ctx, filePath)
 	if err != nil {
-		log.Printf("Error running FFprobe command: %v", err)
-		log.Printf("FFprobe command: %v", cmd.Args)
-		log.Printf("FFprobe stderr: %s", stderr.String())
-		return VideoInfo{}, fmt.Errorf("FFprobe error: %v, stderr: %s", err, stderr.String())
-	}
-
-	var result struct {
-		Streams []struct {
-			CodecName    string `json:"codec_name"`
-			NbFrames     string `json:"nb_frames"`
-			AvgFrameRate string `json:"avg_frame_rate"`
-		} `json:"streams"`
-		Format struct {
-			Duration string `json:"duration"`
-			Size     string `json:"size"`
-		} `json:"format"`
-	}
-
-	if err := json.Unmarshal(stdout.Bytes(), &result); err != nil {
-		log.Printf("Error unmarshalling JSON: %v", err)
-		log.Printf("FFprobe output: %s", stdout.String())
 		return VideoInfo{}, err
 	}
 
@@ -326,27 +555,98 @@ func (a *App) getVideoInfo(filePath string) (VideoInfo, error) {
 		return VideoInfo{}, fmt.Errorf("no streams found in the video file")
 	}
 
-	durationInSeconds, _ := strconv.ParseFloat(result.Format.Duration, 64)
-	frameRate, _ := strconv.ParseFloat(result.Streams[0].AvgFrameRate, 64)
+	// Find the main video stream, skipping cover art
+	// Kapak görsellerini atlayarak ana video akışını bul
+	videoIndex := -1
+	for i, stream := range result.Streams {
+		if stream.CodecType == "video" && stream.Disposition.AttachedPic == 0 {
+			videoIndex = i
+			break
+		}
+	}
+	// Music files have no picture, their first audio stream stands in for it
+	// Müzik dosyalarında görüntü yoktur, ilk ses akışı onun yerini tutar
+	audioOnly := videoIndex < 0
+	if audioOnly {
+		for i, stream := range result.Streams {
+			if stream.CodecType == "audio" {
+				videoIndex = i
+				break
+			}
+		}
+	}
+	if videoIndex < 0 {
+		return VideoInfo{}, fmt.Errorf("no video or audio stream found in the file")
+	}
+	video := result.Streams[videoIndex]
 
-	hours := int(durationInSeconds) / 3600
-	minutes := (int(durationInSeconds) % 3600) / 60
-	seconds := int(durationInSeconds) % 60
-	frames := int((durationInSeconds - float64(int(durationInSeconds))) * frameRate)
+	durationInSeconds, durationSource := a.resolveDuration(ctx, filePath, result, video)
+	frameRate := video.FrameRate()
 
-	timecode := fmt.Sprintf("%02d:%02d:%02d:%02d", hours, minutes, seconds, frames)
+	duration := timecode.Format(durationInSeconds, frameRate)
+	switch durationSource {
+	case durationUnknown:
+		duration = "unknown"
+	case durationFromPackets:
+		duration = "~" + duration
+	}
 
-	frameCount, _ := strconv.Atoi(result.Streams[0].NbFrames)
+	// Containers like Matroska don't store the frame count, estimate it
+	// Matroska gibi kapsayıcılar kare sayısını saklamaz, tahmin et
+	frameCount, _ := strconv.Atoi(video.NbFrames)
+	if frameCount == 0 && frameRate > 0 {
+		frameCount = int(durationInSeconds * frameRate)
+	}
 	sizeInBytes, _ := strconv.ParseFloat(result.Format.Size, 64)
 	sizeInMB := sizeInBytes / 1024 / 1024
+	bitrate, _ := strconv.Atoi(result.Format.BitRate)
+
+	// Summarise the audio and subtitle tracks
+	// Ses ve altyazı parçalarını özetle
+	var audioTracks []string
+	subtitleCount := 0
+	for _, stream := range result.Streams {
+		switch stream.CodecType {
+		case "audio":
+			language := stream.Tags.Language
+			if language == "" {
+				language = "und"
+			}
+			audioTracks = append(audioTracks, fmt.Sprintf("%s %s %dch", language, stream.CodecName, stream.Channels))
+		case "subtitle":
+			subtitleCount++
+		}
+	}
 
-	return VideoInfo{
-		FullPath:   filePath,
-		Duration:   timecode,
-		FrameCount: frameCount,
-		Codec:      result.Streams[0].CodecName,
-		Size:       fmt.Sprintf("%.2f MB", sizeInMB),
-	}, nil
+	info := VideoInfo{
+		FullPath:        filePath,
+		Duration:        duration,
+		DropFrame:       timecode.For(frameRate).DropFrame,
+		DurationSeconds: durationInSeconds,
+		DurationSource:  durationSource,
+		FrameCount:      frameCount,
+		Codec:           video.CodecName,
+		Size:            fmt.Sprintf("%.2f MB", sizeInMB),
+		Width:           video.Width,
+		Height:          video.Height,
+		FrameRate:       frameRate,
+		BitDepth:        video.BitDepth(),
+		ColorTransfer:   video.ColorTransfer,
+		ColorPrimaries:  video.ColorPrimaries,
+		ColorSpace:      video.ColorSpace,
+		ColorRange:      video.ColorRange,
+		HDR:             video.ColorTransfer == "smpte2084" || video.ColorTransfer == "arib-std-b67",
+		DolbyVision:     video.DolbyVisionProfile(),
+		HDR10Plus:       video.ColorTransfer == "smpte2084" && a.detectHDR10Plus(filePath),
+		Bitrate:         bitrate / 1000,
+		AudioTracks:     audioTracks,
+		SubtitleCount:   subtitleCount,
+		AudioOnly:       audioOnly,
+	}
+	if statErr == nil {
+		a.probeCache.put(filePath, stat, info)
+	}
+	return info, nil
 }
 
 // SelectDestinationFolder opens a directory dialog and returns the selected folder
@@ -355,9 +655,7 @@ func (a *App) getVideoInfo(filePath string) (VideoInfo, error) {
 func (a *App) SelectDestinationFolder() (string, error) {
 	// Open directory dialog
 	// Dizin seçim penceresini aç
-	folder, err := runtime.OpenDirectoryDialog(a.ctx, runtime.OpenDialogOptions{
-		Title: "Select Destination Folder",
-	})
+	folder, err := a.openDirectoryDialog("Select Destination Folder")
 	if err != nil {
 		log.Printf("Error selecting destination folder: %v", err)
 		return "", err
@@ -402,182 +700,723 @@ func (a *App) GetLastDestination() string {
 	return a.lastDestination
 }
 
-// ConvertVideo converts the input video to SVTAV1 format
+// ConvertVideo converts the input video to AV1 format
 // Performs the video conversion using FFmpeg and emits progress events
 // FFmpeg kullanarak video dönüşümünü gerçekleştirir ve ilerleme olayları yayar
-func (a *App) ConvertVideo(inputPath, outputFolder string, totalFrames int) error {
-	// Prepare output file name
-	// Çıktı dosya adını hazırla
-	outputFileName := filepath.Base(inputPath)
+func (a *App) ConvertVideo(jobID string) (err error) {
+	// Claim the job in one step so two callers can't both start it, CancelConversion works from here on
+	// İki çağıran onu birlikte başlatamasın diye işi tek adımda sahiplen, CancelConversion buradan itibaren çalışır
+	ctx, cancel, err := a.newJobContext(jobID)
+	if err != nil {
+		return err
+	}
+	defer a.finishJobContext(jobID, cancel)
+
+	// Whatever happens, mark the job and let the queue move on
+	// Ne olursa olsun işi işaretle ve kuyruğun ilerlemesine izin ver
+	defer func() {
+		if r := recover(); r != nil {
+			a.writeCrashReport("ConvertVideo", r, debug.Stack())
+			err = fmt.Errorf("internal error: %v", r)
+		}
+		a.finishJob(jobID, err)
+	}()
+
+	// Wait in the queue for destination space, a running calibration and a parallel job slot
+	// Kuyrukta hedef alanını, çalışan bir kalibrasyonu ve paralel bir iş yerini bekle
+	job, _ := a.getJob(jobID)
+	admitted, err := a.admitJob(ctx, job)
+	if err != nil {
+		return err
+	}
+	defer admitted()
+
+	// The job runs from here, its timeout starts now
+	// İş buradan itibaren çalışır, zaman aşımı şimdi başlar
+	a.updateJob(jobID, func(current *Job) {
+		current.Status, current.Error, current.Mismatches = "running", "", nil
+		job = *current
+	})
+	ctx, cancelTimeout := a.withJobTimeout(ctx)
+	defer cancelTimeout()
+
+	// Pick software or hardware in the balanced mode, then speed the job up if the queue would miss its deadline
+	// Dengeli modda yazılım veya donanımı seç, ardından kuyruk son tarihini kaçıracaksa işi hızlandır
+	job = a.applyEncoderMode(job)
+	job = a.applyDeadline(job)
+
+	a.emitJobEvent(events.JobStarted, jobID, JobStartedEvent{InputPath: job.InputPath})
+	a.emitQueueUpdated()
+	a.addJobEvent(jobID, "encode", "conversion started")
+
+	// Wait until the job fits the memory budget next to the running ones
+	// İş, çalışanların yanında bellek bütçesine sığana kadar bekle
+	release, err := a.reserveMemory(ctx, job)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	started := time.Now()
+	err = a.convert(ctx, job)
+
+	// Retry stalled attempts as long as the job itself is still alive
+	// Attempts broken by a system sleep start again without using up a retry
+	// Sistem uykusunun bozduğu denemeler bir yeniden deneme hakkı harcamadan yeniden başlar
+	// A failed hardware encode of the balanced mode runs again with SVT-AV1
+	// Dengeli modun başarısız donanım kodlaması SVT-AV1 ile yeniden çalışır
+	stalls := 0
+	for attempt := 1; err != nil && ctx.Err() == nil; attempt++ {
+		reason := "sleep"
+		retries := a.currentPreferences().StallRetries
+		switch {
+		case a.interruptedBySleep(started, err):
+			log.Printf("Restarting job %s after system sleep: %v", jobID, err)
+		case errors.Is(err, errStalled) && stalls < retries:
+			stalls++
+			reason = "stalled"
+			log.Printf("Retrying stalled job %s (attempt %d of %d)", jobID, stalls, retries)
+		case onHardware(job):
+			job = a.fallBackToSoftware(job, err)
+			reason = "hardware"
+		default:
+			return err
+		}
+		a.emitJobEvent(events.JobRetry, jobID, JobRetryEvent{Attempt: attempt, Reason: reason})
+		started = time.Now()
+		err = a.convert(ctx, job)
+	}
+	return err
+}
+
+// finishJob records the outcome of a job and advances the queue
+// Failures are reported before "queue.updated" so the UI never stalls
+// Arayüzün hiç takılmaması için hatalar "queue.updated" öncesinde bildirilir
+func (a *App) finishJob(jobID string, err error) {
+	a.updateJob(jobID, func(job *Job) {
+		if err != nil {
+			job.Status, job.Error = "failed", err.Error()
+		} else {
+			job.Status = "completed"
+		}
+	})
+	if err != nil {
+		a.addJobEvent(jobID, "failed", err.Error())
+		a.emitJobEvent(events.JobFailed, jobID, JobFailedEvent{Error: err.Error()})
+	} else {
+		a.addJobEvent(jobID, "completed", "conversion completed")
+	}
+
+	// Tell the workflow that queued the job about it
+	// İşi kuyruğa ekleyen iş akışını bilgilendir
+	if job, ok := a.getJob(jobID); ok && job.Workflow != "" {
+		go func() {
+			defer a.recoverCrash("notifyWorkflow")
+			a.notifyWorkflow(job)
+		}()
+	}
+
+	// Keep the log folder within the retention limits
+	// Log klasörünü saklama sınırları içinde tut
+	a.cleanupLogs()
+
+	// Report the new queue state so the next video is processed
+	// Sıradaki videonun işlenmesi için yeni kuyruk durumunu bildir
+	a.emitQueueUpdated()
+}
+
+// convert performs a single conversion bound to the job context
+// Cancelling the context kills FFmpeg and stops the progress monitor
+// Bağlamın iptal edilmesi FFmpeg'i sonlandırır ve ilerleme izleyicisini durdurur
+func (a *App) convert(ctx context.Context, job Job) error {
+	inputPath, outputFolder, totalFrames := job.InputPath, job.OutputFolder, job.TotalFrames
+
+	// A stalled attempt is cancelled on its own so the job can retry it
+	// Takılan bir deneme, işin yeniden deneyebilmesi için ayrıca iptal edilir
+	ctx, stopAttempt := context.WithCancelCause(ctx)
+	defer stopAttempt(nil)
+	a.updateJob(job.ID, func(job *Job) { job.restart = stopAttempt })
+	onStall := func() {
+		if a.currentPreferences().StallRecovery {
+			stopAttempt(errStalled)
+		}
+	}
+
+	// Clip exports only encode their range
+	// Klip dışa aktarımları yalnızca aralıklarını kodlar
+	if job.Clip != nil {
+		return a.exportClip(ctx, job, onStall)
+	}
+
+	// Replacing the original encodes next to it
+	// Orijinali değiştirmek yanına kodlar
+	replacing := job.Settings.ReplaceOriginal
+	if replacing && job.AudioOnly {
+		return fmt.Errorf("audio files can't replace the original, pick an output folder")
+	}
+	if replacing {
+		if isURLInput(inputPath) || len(job.Renditions) > 0 {
+			return fmt.Errorf("only single output jobs of local files can replace the original")
+		}
+		outputFolder = filepath.Dir(inputPath)
+		job.OutputFolder = outputFolder
+	}
+
+	// Prepare output file names, one per rendition
+	// Çıktı dosya adlarını hazırla, her sürüm için bir tane
+	outputFileName := inputBaseName(inputPath)
 	outputFileName = strings.TrimSuffix(outputFileName, filepath.Ext(outputFileName))
 	outputFileName = sanitizeFileName(outputFileName)
-	outputPath := filepath.Join(outputFolder, outputFileName+"_av1.mp4")
+	outputs := jobOutputs(job, outputFileName)
+
+	// Validate the settings before spawning FFmpeg
+	// FFmpeg'i başlatmadan önce ayarları doğrula
+	for _, output := range outputs {
+		if errs := validateSettings(output.Settings); len(errs) > 0 {
+			log.Printf("Invalid settings for %s: %v", output.Path, errs)
+			return errs
+		}
+	}
+	// Never overwrite an existing file or the output of another running job
+	// Var olan bir dosyanın veya çalışan başka bir işin çıktısının üzerine asla yazma
+	for i, path := range a.claimOutputPaths(job.ID, outputPaths(outputs)) {
+		outputs[i].Path = path
+	}
 
-	// Create output directory if it doesn't exist
-	// Çıktı dizini yoksa oluştur
-	if err := os.MkdirAll(outputFolder, os.ModePerm); err != nil {
+	// Encode to the local staging folder first if the destination is slow or remote
+	// Hedef yavaş veya uzaksa önce yerel hazırlık klasörüne kodla
+	remote := isRemoteDestination(outputFolder)
+	if a.currentPreferences().StageOutputs || remote {
+		stagingDir, err := a.stagingDir(job.ID)
+		if err != nil {
+			log.Printf("Failed to create staging folder: %v", err)
+			return fmt.Errorf("failed to create staging folder: %v", err)
+		}
+		defer os.Remove(stagingDir)
+		if err := a.checkWorkingSpace(stagingDir, fileSize(inputPath)); err != nil {
+			return err
+		}
+		for i := range outputs {
+			outputs[i].Destination = outputs[i].Path
+			outputs[i].Path = filepath.Join(stagingDir, filepath.Base(outputs[i].Path))
+		}
+	}
+
+	// Create output directory if it doesn't exist, remote ones are created on transfer
+	// Çıktı dizini yoksa oluştur, uzak olanlar aktarımda oluşturulur
+	if remote && !isRcloneDestination(outputFolder) {
+		if _, err := url.Parse(outputFolder); err != nil {
+			return fmt.Errorf("invalid destination %s: %v", outputFolder, err)
+		}
+	} else if err := os.MkdirAll(outputFolder, os.ModePerm); err != nil {
 		log.Printf("Failed to create output directory: %v", err)
 		return fmt.Errorf("failed to create output directory: %v", err)
 	}
 
 	// Prepare log file for FFmpeg output
 	// FFmpeg çıktısı için log dosyasını hazırla
-	logFileName := outputFileName + "_ffmpeg.log"
-	logFilePath := filepath.Join(a.appDir, "logs", logFileName)
+	logFilePath, err := a.jobLogPath(job.ID, outputFileName+"_ffmpeg.log")
+	if err != nil {
+		log.Printf("Failed to create log folder: %v", err)
+		return fmt.Errorf("failed to create log folder: %v", err)
+	}
 	logFile, err := os.Create(logFilePath)
 	if err != nil {
 		log.Printf("Failed to create log file: %v", err)
 		return fmt.Errorf("failed to create log file: %v", err)
 	}
 	defer logFile.Close()
+	a.writeSettingsSnapshot(job.ID, job.Settings)
+
+	// Download URL inputs first if requested, encoding from a flaky stream is fragile
+	// İstenirse URL girişlerini önce indir, kararsız bir akıştan kodlamak kırılgandır
+	if isURLInput(inputPath) && a.currentPreferences().DownloadURLInputs {
+		downloadDir, err := a.makeWorkDir("av1-download-")
+		if err != nil {
+			return fmt.Errorf("failed to create download folder: %v", err)
+		}
+		defer os.RemoveAll(downloadDir)
+		a.addJobEvent(job.ID, "download", "downloading "+inputPath)
+		endPhase := a.startPhase(job.ID, "download")
+		inputPath, err = a.downloadInput(ctx, job.ID, inputPath, downloadDir)
+		endPhase()
+		if err != nil {
+			if ctx.Err() != nil {
+				return fmt.Errorf("conversion cancelled: %w", context.Cause(ctx))
+			}
+			log.Printf("Error downloading %s: %v", job.InputPath, err)
+			return fmt.Errorf("source unreachable: %v", err)
+		}
+	}
+
+	// Probe the source for the size target and the history
+	// Boyut hedefi ve geçmiş için kaynağı incele
+	plan := conversionPlan{
+		InputPath: inputPath,
+		Outputs:   outputs,
+		AudioOnly: job.AudioOnly,
+	}
+	if !job.AudioOnly {
+		plan.AudioOffset = job.Settings.AudioOffset
+	}
+	endPhase := a.startPhase(job.ID, "probe")
+	if plan.Video, err = a.getVideoInfo(inputPath); err != nil {
+		log.Printf("Error probing %s: %v", inputPath, err)
+		return fmt.Errorf("failed to probe input: %v", err)
+	}
+	if plan.Video.AudioOnly && !plan.AudioOnly {
+		return fmt.Errorf("%s has no video stream, add its extension to the audio extensions to convert it as audio", inputPath)
+	}
+
+	// Probe the streams to apply the language rules
+	// Dil kurallarını uygulamak için akışları incele
+	if plan.Streams, err = a.probeStreams(inputPath); err != nil {
+		return fmt.Errorf("failed to probe input streams: %v", err)
+	}
+	endPhase()
+
+	// Look for black, frozen and corrupt segments if requested, a failed check doesn't stop the job
+	// İstenirse siyah, donmuş ve bozuk bölümleri ara, başarısız bir kontrol işi durdurmaz
+	if job.Settings.AnalyzeSource {
+		if _, err := a.analyzeJobSource(ctx, job.ID, inputPath); err != nil {
+			if ctx.Err() != nil {
+				return fmt.Errorf("conversion cancelled: %w", context.Cause(ctx))
+			}
+			log.Printf("Error analysing the source of job %s: %v", job.ID, err)
+			a.addJobEvent(job.ID, "analysis", err.Error())
+		}
+	}
+
+	// Dolby Vision and HDR10+ metadata can't be carried into AV1 here, say so
+	// Dolby Vision ve HDR10+ meta verisi burada AV1'e taşınamaz, bunu bildir
+	if err := a.handleDynamicHDR(ctx, job.ID, plan, remote); err != nil {
+		return err
+	}
+
+	// Find black and static ranges worth a higher CRF if requested
+	// İstenirse daha yüksek CRF'e değecek siyah ve durağan aralıkları bul
+	if first := &plan.Outputs[0].Settings; len(plan.Outputs) == 1 && !plan.AudioOnly && first.AutoRelaxZones && len(first.Zones) == 0 {
+		endPhase := a.startPhase(job.ID, "analysis")
+		zones, err := a.detectRelaxZones(ctx, inputPath, *first, plan.Video.DurationSeconds)
+		endPhase()
+		if err != nil {
+			if ctx.Err() != nil {
+				return fmt.Errorf("conversion cancelled: %w", context.Cause(ctx))
+			}
+			log.Printf("Error detecting relaxed zones for %s, encoding without them: %v", inputPath, err)
+		}
+		first.Zones = zones
+	}
 
-	// Prepare FFmpeg command
-	// FFmpeg komutunu hazırla
-	cmd := exec.Command(a.ffmpegPath,
-		"-i", inputPath,
-		"-c:v", "libsvtav1",
-		"-crf", "30",
-		"-preset", "6",
-		"-svtav1-params", "tune=0",
-		"-c:a", "copy", "-y",
-		outputPath)
-
-	cmd.Stdout = logFile
-	cmd.Stderr = logFile
-
-	// Start FFmpeg process
-	// FFmpeg işlemini başlat
-	if err := cmd.Start(); err != nil {
+	// Describe the conversion for the output metadata and history
+	// Çıktı meta verisi ve geçmiş için dönüştürmeyi tanımla
+	record := a.newConversionRecord(inputPath, job.Settings)
+	entries := make([]HistoryEntry, len(plan.Outputs))
+	for i := range plan.Outputs {
+		output := &plan.Outputs[i]
+		output.Record = record
+		output.Record.Encoder, output.Record.CRF, output.Record.Preset = output.Settings.Encoder, output.Settings.CRF, output.Settings.Preset
+		entries[i] = HistoryEntry{
+			JobID:      job.ID,
+			InputPath:  job.InputPath,
+			OutputPath: output.finalPath(),
+			Settings:   output.Settings,
+			Record:     output.Record,
+			Duration:   plan.Video.DurationSeconds,
+			StartedAt:  time.Now(),
+			LogPath:    logFilePath,
+		}
+	}
+
+	// Report a failure, removing partial outputs and recording it in the history
+	// Bir hatayı bildir, yarım kalan çıktıları sil ve geçmişe kaydet
+	fail := func(err error) error {
+		if ctx.Err() != nil {
+			err = fmt.Errorf("conversion cancelled: %w", context.Cause(ctx))
+		} else {
+			err = ffmpegExitError(err, logFilePath)
+		}
+		log.Printf("FFmpeg error: %v", err)
+		for i, entry := range entries {
+			a.discardPartialOutput(plan.Outputs[i].Path)
+			entry.Status, entry.Error, entry.FinishedAt = "failed", err.Error(), time.Now()
+			a.addHistoryEntry(entry)
+		}
+		return fmt.Errorf("FFmpeg error: %w", err)
+	}
+
+	// Decimation drops one frame out of five, progress follows the first output
+	// Decimate filtresi her beş kareden birini atar, ilerleme ilk çıktıyı izler
+	if plan.Outputs[0].Settings.InverseTelecine {
+		totalFrames = totalFrames * 4 / 5
+	}
+
+	// Encode quality zones segment by segment, the final pass only muxes
+	// Kalite bölgelerini parça parça kodla, son geçiş yalnızca birleştirir
+	// Energy is measured over every encoding pass of the job
+	// Enerji işin her kodlama geçişi boyunca ölçülür
+	var meter *energyMeter
+	zoned := len(plan.Outputs[0].Settings.Zones) > 0 && !plan.AudioOnly
+	if zoned {
+		if len(plan.Outputs) > 1 {
+			return fmt.Errorf("quality zones can't be combined with renditions")
+		}
+		workDir, err := a.makeWorkDir("av1-zones-")
+		if err != nil {
+			return fmt.Errorf("failed to create zone folder: %v", err)
+		}
+		defer os.RemoveAll(workDir)
+		if err := a.checkWorkingSpace(workDir, fileSize(inputPath)); err != nil {
+			return fail(err)
+		}
+		meter = a.startEnergyMeter(ctx)
+		endPhase := a.startPhase(job.ID, "encode")
+		plan.ZoneVideo, err = a.encodeZones(ctx, job.ID, plan, workDir, logFile, totalFrames, onStall)
+		endPhase()
+		if err != nil {
+			return fail(err)
+		}
+	}
+
+	// Start FFmpeg process, it writes its output to the log file
+	// FFmpeg işlemini başlat, çıktısını log dosyasına yazar
+	finalPhase := "encode"
+	if zoned {
+		finalPhase = "mux"
+	}
+	if !zoned {
+		if err := a.waitForCooling(ctx, job.ID); err != nil {
+			return err
+		}
+	}
+	if meter == nil {
+		meter = a.startEnergyMeter(ctx)
+	}
+	endPhase = a.startPhase(job.ID, finalPhase)
+	writing := make([]string, 0, len(plan.Outputs))
+	for _, output := range plan.Outputs {
+		writing = append(writing, output.Path)
+	}
+	a.updateJob(job.ID, func(job *Job) { job.writing = writing })
+	ffmpeg := a.newFFmpeg(logFile)
+	if err := ffmpeg.Start(ctx, buildFFmpegArgs(plan)); err != nil {
+		a.updateJob(job.ID, func(job *Job) { job.writing = nil })
+		endPhase()
 		log.Printf("Failed to start FFmpeg: %v", err)
 		return fmt.Errorf("failed to start FFmpeg: %v", err)
 	}
 
-	// Monitor progress in a separate goroutine
-	// İlerlemeyi ayrı bir goroutine'de izle
-	done := make(chan bool)
+	// Monitor progress in a separate goroutine tied to the job
+	// Audio-only jobs and the zone mux pass encode no video, they follow the output time and size
+	// Yalnızca ses işleri ve bölge birleştirme geçişi video kodlamaz, çıktı zamanını ve boyutunu izlerler
+	target := progress.Target{Frames: totalFrames, Seconds: plan.Video.DurationSeconds}
+	switch {
+	case plan.AudioOnly:
+		target = progress.Target{Seconds: plan.Video.DurationSeconds}
+	case zoned:
+		target = progress.Target{Seconds: plan.Video.DurationSeconds, Bytes: concatListSize(plan.ZoneVideo)}
+	}
+	progressCtx, stopProgress := context.WithCancel(ctx)
+	monitorDone := make(chan struct{})
 	go func() {
-		a.monitorProgress(logFilePath, totalFrames, done)
+		defer close(monitorDone)
+		defer a.recoverCrash("monitorProgress")
+		a.monitorProgress(progressCtx, job.ID, finalPhase, ffmpeg, target, onStall)
 	}()
 
-	// Wait for FFmpeg to finish
-	// FFmpeg'in bitmesini bekle
-	if err := cmd.Wait(); err != nil {
-		close(done)
-		log.Printf("FFmpeg error: %v", err)
-		runtime.EventsEmit(a.ctx, "conversion:error", err.Error())
-		return fmt.Errorf("FFmpeg error: %v", err)
+	// Wait for FFmpeg to finish, then for the monitor to stop
+	// FFmpeg'in bitmesini, ardından izleyicinin durmasını bekle
+	err = ffmpeg.Wait()
+	stopProgress()
+	<-monitorDone
+	a.updateJob(job.ID, func(job *Job) { job.writing = nil })
+	endPhase()
+	a.addCPUTime(job.ID, ffmpeg.CPUTime())
+	if job, ok := a.getJob(job.ID); ok {
+		if energy := meter.stop(job.CPUSeconds, a.currentPreferences().CPUWatts); energy != nil {
+			log.Printf("Job %s used about %.2f Wh (%s)", job.ID, energy.WattHours, energy.Method)
+			a.updateJob(job.ID, func(job *Job) { job.Energy = energy })
+		}
+	}
+
+	if err != nil {
+		return fail(err)
+	}
+
+	// Conversion finished, send 100% progress
+	// Dönüşüm bitti, %100 bilgisini gönder
+	a.emitProgress(job.ID, "encode", 100, "")
+
+	// Flag outputs whose duration, frames or audio don't match the source
+	// Süresi, kareleri veya sesi kaynakla eşleşmeyen çıktıları işaretle
+	a.verifyOutputIntegrity(ctx, job.ID, plan)
+
+	// Check that archival outputs really hold the source picture
+	// Arşiv çıktılarının gerçekten kaynak görüntüyü tuttuğunu kontrol et
+	for i, output := range plan.Outputs {
+		if output.Settings.Archival == "" || plan.AudioOnly {
+			continue
+		}
+		endPhase := a.startPhase(job.ID, "verification")
+		score, err := a.verifyArchival(ctx, plan, output)
+		endPhase()
+		entries[i].VMAF = score
+		a.recordVerification(job.ID, "archival", output.Path, err)
+		if err != nil {
+			log.Printf("Archival verification failed: %v", err)
+			entries[i].Status, entries[i].Error, entries[i].FinishedAt = "failed", err.Error(), time.Now()
+			a.addHistoryEntry(entries[i])
+			return err
+		}
+		a.addJobEvent(job.ID, "verification", "archival output verified")
 	}
 
-	close(done)
-	time.Sleep(time.Second) // Short wait for progress bar to reach 100% / İlerleme çubuğunun %100'e ulaşması için kısa bir bekleme
-	runtime.EventsEmit(a.ctx, "conversion:complete", outputPath)
-	log.Printf("Conversion completed: %s", outputPath)
+	// Embed the cover art before the outputs move, a missing cover never fails the job
+	// Çıktılar taşınmadan önce kapak görselini ekle, eksik bir kapak işi asla başarısız kılmaz
+	for _, output := range plan.Outputs {
+		if output.Settings.CoverArt == "" || plan.AudioOnly {
+			continue
+		}
+		if err := a.embedCoverArt(ctx, plan, output); err != nil {
+			log.Printf("Error embedding cover art into %s: %v", output.Path, err)
+			a.addJobEvent(job.ID, "cover", err.Error())
+		}
+	}
 
-	// Emit event to process next video
-	// Sıradaki videoyu işlemek için olay yayınla
-	runtime.EventsEmit(a.ctx, "conversion:next")
+	// Tag Matroska tracks with their statistics so media servers show the right bitrate
+	// Medya sunucularının doğru bit hızını göstermesi için Matroska parçalarını istatistikleriyle etiketle
+	for _, output := range plan.Outputs {
+		if output.Settings.Container != "mkv" || plan.AudioOnly {
+			continue
+		}
+		if err := a.writeMatroskaStatistics(ctx, output.Path); err != nil {
+			log.Printf("Error writing statistics tags to %s: %v", output.Path, err)
+		}
+	}
 
+	// Copy staged outputs to their destination as a second phase
+	// Hazırlanan çıktıları ikinci bir aşama olarak hedeflerine kopyala
+	for _, output := range plan.Outputs {
+		if output.Destination == "" {
+			continue
+		}
+		// A cancel while waiting for space fails like a copy, the encoded file stays in the staging folder
+		// Alan beklerken iptal bir kopyalama gibi başarısız olur, kodlanan dosya hazırlama klasöründe kalır
+		transfer := a.copyToDestination
+		var err error
+		if remote {
+			transfer = a.transferToRemote
+		} else {
+			err = a.waitForDestinationSpace(ctx, job.ID, filepath.Dir(output.Destination))
+		}
+		if err == nil {
+			a.addJobEvent(job.ID, "transfer", "transferring to "+output.Destination)
+			endPhase := a.startPhase(job.ID, "transfer")
+			err = transfer(ctx, job.ID, output.Path, output.Destination)
+			endPhase()
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				err = fmt.Errorf("conversion cancelled: %w", context.Cause(ctx))
+			}
+			err = fmt.Errorf("copy to %s failed, the encoded file is kept at %s: %w", output.Destination, output.Path, err)
+			log.Printf("Copy error: %v", err)
+			for _, entry := range entries {
+				entry.Status, entry.Error, entry.FinishedAt = "failed", err.Error(), time.Now()
+				a.addHistoryEntry(entry)
+			}
+			return err
+		}
+	}
+
+	// Hash the source before a replacement removes it
+	// Bir değiştirme onu kaldırmadan önce kaynağın özetini al
+	var sourceChecksum *FileChecksum
+	if plan.Outputs[0].Settings.ChecksumManifest == "json" && !isURLInput(inputPath) {
+		if sum, err := checksumFile(inputPath); err != nil {
+			log.Printf("Error hashing %s: %v", inputPath, err)
+		} else {
+			sourceChecksum = &sum
+		}
+	}
+
+	for i, output := range plan.Outputs {
+		output.Path = output.finalPath()
+
+		// Copy the source timestamps and permissions if requested
+		// İstenirse kaynak zaman damgalarını ve izinlerini kopyala
+		if output.Settings.PreserveTimestamps && !remote && !isURLInput(job.InputPath) {
+			if err := preserveFileAttributes(inputPath, output.Path); err != nil {
+				log.Printf("Error preserving file attributes on %s: %v", output.Path, err)
+			}
+		}
+
+		// Swap the verified output into the place of the source, its sidecars already match
+		// Doğrulanan çıktıyı kaynağın yerine koy, yan dosyaları zaten eşleşir
+		if replacing {
+			entries[i].InputSize = fileSize(inputPath)
+			target := replacementTarget(inputPath, output.Settings)
+			endPhase := a.startPhase(job.ID, "verification")
+			err := a.verifyReplacement(output.Path, plan.Video)
+			endPhase()
+			a.recordVerification(job.ID, "replacement", output.Path, err)
+			if err == nil {
+				err = replaceOriginal(inputPath, output.Path, target, output.Settings.KeepOriginalBackup)
+			}
+			if err != nil {
+				err = fmt.Errorf("original not replaced, the encoded file is kept at %s: %v", output.Path, err)
+				log.Printf("Replace error: %v", err)
+				entries[i].Status, entries[i].Error, entries[i].FinishedAt = "failed", err.Error(), time.Now()
+				a.addHistoryEntry(entries[i])
+				return err
+			}
+			a.addJobEvent(job.ID, "replace", "replaced "+inputPath+" with "+target)
+			output.Path, plan.Outputs[i].Path, plan.Outputs[i].Destination = target, target, ""
+			entries[i].OutputPath = target
+			a.updateJob(job.ID, func(job *Job) {
+				job.OutputPath, job.OutputPaths = target, []string{target}
+			})
+			a.refreshLibrary(ctx, job.ID, target)
+		}
+
+		// Copy sidecar files for media servers if requested
+		// İstenirse medya sunucuları için yan dosyaları kopyala
+		if output.Settings.CopySidecars && !replacing && !remote && !isURLInput(job.InputPath) {
+			a.copySidecars(inputPath, output.Path)
+		}
+
+		// Record checksums for later integrity checks if requested
+		// İstenirse sonraki bütünlük kontrolleri için sağlama toplamlarını kaydet
+		if (output.Settings.ChecksumManifest != "" || output.Settings.ChecksumBatch) && !remote {
+			if err := a.writeChecksums(output.Settings, sourceChecksum, output.Path); err != nil {
+				log.Printf("Error writing checksums of %s: %v", output.Path, err)
+			}
+		}
+
+		entries[i].Status, entries[i].FinishedAt = "completed", time.Now()
+		a.addHistoryEntry(entries[i])
+		log.Printf("Conversion completed: %s", output.Path)
+	}
+
+	// Upload the outputs to the bucket if configured
+	// Yapılandırılmışsa çıktıları depoya yükle
+	if a.currentUpload().Enabled && !remote {
+		endPhase := a.startPhase(job.ID, "upload")
+		for _, outputPath := range outputPaths(plan.Outputs) {
+			a.uploadOutput(ctx, job, outputPath)
+		}
+		endPhase()
+	}
+
+	var energy *EnergyUsage
+	if job, ok := a.getJob(job.ID); ok {
+		energy = job.Energy
+	}
+	a.emitJobEvent(events.JobCompleted, job.ID, JobCompletedEvent{
+		OutputPath:  plan.Outputs[0].Path,
+		OutputPaths: outputPaths(plan.Outputs),
+		Energy:      energy,
+	})
 	return nil
 }
 
+// stallTimeoutUnit is the unit of the stall timeout preference, tests shorten it
+// Takılma zaman aşımı tercihinin birimidir, testler bunu kısaltır
+var stallTimeoutUnit = time.Minute
+
 // monitorProgress tracks the conversion progress and emits update events
-// Monitors the FFmpeg log file and sends progress updates to the frontend
-// FFmpeg Log dosyasını izler ve ilerleme güncellemelerini Frontend'e gönderir
-func (a *App) monitorProgress(logPath string, totalFrames int, done chan bool) {
-	// Open the log file
-	// Log dosyasını aç
-	file, err := os.Open(logPath)
-	if err != nil {
-		log.Printf("Error opening log file: %v", err)
-		return
+// Reads the status updates of FFmpeg and sends progress updates to the frontend
+// FFmpeg'in durum güncellemelerini okur ve ilerleme güncellemelerini Frontend'e gönderir
+// The target picks frames, output time or output size, jobs without a video encode report no frames
+// Hedef kareleri, çıktı zamanını veya çıktı boyutunu seçer, video kodlamayan işler kare bildirmez
+func (a *App) monitorProgress(ctx context.Context, jobID, phase string, ffmpeg runner.FFmpegRunner, target progress.Target, onStall func()) {
+	updates := ffmpeg.ProgressStream()
+	ticker := time.NewTicker(250 * time.Millisecond)
+	defer ticker.Stop()
+
+	// Suspend FFmpeg while the CPU is above the thermal limit, the stall timer stops meanwhile
+	// CPU ısıl sınırın üzerindeyken FFmpeg'i askıya al, bu sırada takılma zamanlayıcısı durur
+	cooling := &thermalGuard{app: a, jobID: jobID, ffmpeg: ffmpeg}
+	defer cooling.release()
+	var thermal <-chan time.Time
+	if _, _, ok := a.thermalLimits(); ok {
+		thermalTicker := time.NewTicker(thermalPollInterval)
+		defer thermalTicker.Stop()
+		thermal = thermalTicker.C
 	}
-	defer file.Close()
-
-	// Prepare regular expressions for parsing
-	// Ayrıştırma için düzenli ifadeleri hazırla
-	frameRegex := regexp.MustCompile(`frame=\s*(\d+)`)
-	speedRegex := regexp.MustCompile(`speed=(\S+)`)
 
-	var lastProgress float64
+	// Stall detection: no frame progress within the stall timeout
+	// Takılma algılama: takılma zaman aşımı içinde kare ilerlemesi yok
+	stallTimeout := time.Duration(a.currentPreferences().StallTimeout) * stallTimeoutUnit
+	lastAdvance := time.Now()
+	stallReported := false
+
+	// Record the progress on the job so a wake from sleep can tell a stuck encode
+	// Uykudan uyanma takılan bir kodlamayı ayırt edebilsin diye ilerlemeyi işe kaydet
+	a.updateJob(jobID, func(job *Job) { job.advancedAt = lastAdvance })
+	defer a.updateJob(jobID, func(job *Job) { job.advancedAt = time.Time{} })
+
+	// Coalesced updates wait here until the throttle lets them through
+	// Birleştirilen güncellemeler sınırlayıcı izin verene kadar burada bekler
+	throttle := a.newProgressThrottle()
+	var lastProgress, lastTime, sentProgress float64
+	var lastSize int64
+	var lastSpeed string
+	flush := func() {
+		if lastProgress <= sentProgress || !throttle.allow(lastProgress >= 100) {
+			return
+		}
+		sentProgress = lastProgress
+		a.emitProgress(jobID, phase, lastProgress, lastSpeed)
+	}
 	for {
 		select {
-		case <-done:
-			// Conversion finished, send 100% progress
-			// Dönüşüm bitti, %100  bilgisini gönder
-			runtime.EventsEmit(a.ctx, "conversion:progress", map[string]interface{}{
-				"progress": 100,
-				"speed":    "",
-			})
+		case <-ctx.Done():
+			// Conversion finished or cancelled
+			// Dönüşüm bitti veya iptal edildi
 			return
-		default:
-			// Read the last 1024 bytes of the log file
-			// Log dosyasının son 1024 baytını oku
-			file.Seek(-1024, 2)
-			scanner := bufio.NewScanner(file)
-			var lastLine string
-			for scanner.Scan() {
-				lastLine = scanner.Text()
+		case status, ok := <-updates:
+			if !ok {
+				// FFmpeg exited
+				// FFmpeg çıktı
+				return
+			}
+			// Builds that report N/A frames still move the output time or size forward
+			// N/A kare bildiren derlemeler yine de çıktı zamanını veya boyutunu ilerletir
+			if status.Time > lastTime || status.Size > lastSize {
+				lastTime, lastSize, lastAdvance, stallReported = math.Max(lastTime, status.Time), status.Size, time.Now(), false
+				a.updateJob(jobID, func(job *Job) { job.advancedAt = lastAdvance })
 			}
-			if err := scanner.Err(); err != nil {
-				log.Printf("Error scanning file: %v", err)
+			percent, ok := target.Percent(status)
+			if !ok {
 				continue
 			}
 
-			// Parse progress information
-			// İlerleme bilgisini ayrıştır
-			if strings.Contains(lastLine, "frame=") {
-				frameMatch := frameRegex.FindStringSubmatch(lastLine)
-				speedMatch := speedRegex.FindStringSubmatch(lastLine)
-
-				if len(frameMatch) > 1 && len(speedMatch) > 1 {
-					currentFrame, err := strconv.ParseFloat(frameMatch[1], 64)
-					if err != nil {
-						log.Printf("Error parsing frame: %v", err)
-						continue
-					}
-
-					speed := strings.TrimSpace(speedMatch[1])
-
-					progress := (currentFrame / float64(totalFrames)) * 100
-					if progress > 100 {
-						progress = 100
-					}
-
-					// Send progress update to frontend if progress has increased
-					// İlerleme artmışsa Frontend'e ilerleme güncellemesi gönder
-					if progress > lastProgress {
-						lastProgress = progress
-						fmt.Printf("İlerleme: %.2f%%, Hız: %s\n", progress, speed)
-						runtime.EventsEmit(a.ctx, "conversion:progress", map[string]interface{}{
-							"progress": progress,
-							"speed":    speed,
-						})
-					}
-				}
+			// Send progress update to frontend if progress has increased
+			// İlerleme artmışsa Frontend'e ilerleme güncellemesi gönder
+			if percent > lastProgress {
+				lastProgress, lastSpeed = percent, status.Speed
+				lastAdvance, stallReported = time.Now(), false
+				flush()
+			}
+		case <-thermal:
+			wasSuspended := cooling.suspended
+			if cooling.check() || wasSuspended {
+				lastAdvance = time.Now()
+				a.updateJob(jobID, func(job *Job) { job.advancedAt = lastAdvance })
+			}
+		case <-ticker.C:
+			flush()
+			if stallTimeout > 0 && !stallReported && !cooling.suspended && time.Since(lastAdvance) > stallTimeout {
+				stallReported = true
+				log.Printf("Job %s stalled: no progress for %s", jobID, stallTimeout)
+				a.emitJobEvent(events.JobStalled, jobID, JobStalledEvent{
+					Percent: lastProgress,
+					Seconds: int(time.Since(lastAdvance).Seconds()),
+					Recover: a.currentPreferences().StallRecovery,
+				})
+				onStall()
 			}
 		}
-		time.Sleep(500 * time.Millisecond)
-	}
-}
-
-// sanitizeFileName removes or replaces invalid characters in a filename
-// Ensures the output filename is valid for the file system
-// Çıktı dosya adının dosya sistemi için geçerli olmasını sağlar
-func sanitizeFileName(fileName string) string {
-	// Remove or replace invalid characters
-	// Geçersiz karakterleri kaldır veya değiştir
-	fileName = strings.Map(func(r rune) rune {
-		if r == '/' || r == '\\' || r == ':' || r == '*' || r == '?' || r == '"' || r == '<' || r == '>' || r == '|' {
-			return '_'
-		}
-		return r
-	}, fileName)
-
-	// Truncate filename if it's too long
-	// Dosya adı çok uzunsa kısalt
-	if len(fileName) > 200 {
-		fileName = fileName[:200]
 	}
-
-	return fileName
 }
@@ -0,0 +1,185 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"os/exec"
+	"strings"
+
+	"AV1-video-converter/internal/runner"
+)
+
+// archivalModes lists the preservation profiles
+// lossless is bit exact and needs libaom-av1, nearlossless uses a very low CRF
+// lossless bit düzeyinde aynıdır ve libaom-av1 gerektirir, nearlossless çok düşük CRF kullanır
+var archivalModes = []string{"lossless", "nearlossless"}
+
+// Archival encoding parameters
+// Arşiv kodlama parametreleri
+const (
+	archivalNearLosslessCRF = 8  // CRF of the near lossless profile / Kayıpsıza yakın profilin CRF değeri
+	archivalMinVMAF         = 97 // Lowest VMAF a near lossless output may score / Kayıpsıza yakın bir çıktının alabileceği en düşük VMAF
+)
+
+// ApplyArchivalProfile adjusts the current settings for archiving a master or intermediate file
+// Everything that alters the picture is turned off and the source pixel format is kept
+// Görüntüyü değiştiren her şey kapatılır ve kaynak piksel formatı korunur
+func (a *App) ApplyArchivalProfile(mode string, video VideoInfo) (ProfileResult, error) {
+	if !containsString(archivalModes, mode) {
+		return ProfileResult{}, fmt.Errorf("unknown archival mode: %s", mode)
+	}
+
+	settings := archivalSettings(a.GetSettings(), mode)
+	result := ProfileResult{Settings: settings, Warnings: archivalWarnings(mode, video)}
+	for _, warning := range result.Warnings {
+		log.Printf("Archival %s warning for %s: %s", mode, video.FullPath, warning)
+	}
+	return result, nil
+}
+
+// archivalSettings turns the given settings into the archival profile of the mode
+// Verilen ayarları modun arşiv profiline dönüştürür
+func archivalSettings(settings ConversionSettings, mode string) ConversionSettings {
+	settings.Archival = mode
+	settings.Container = "mkv"
+	settings.PixelFormat = ""
+	settings.MaxWidth, settings.MaxHeight = 0, 0
+	settings.MaxBitrate, settings.MaxFileSize = 0, 0
+	settings.InverseTelecine = false
+	settings.Zones, settings.AutoRelaxZones = nil, false
+	settings.AudioCodec = "copy"
+	if mode == "lossless" {
+		settings.Encoder, settings.CRF, settings.Preset = "libaom-av1", 0, "4"
+	} else {
+		if settings.Encoder != "libsvtav1" && settings.Encoder != "libaom-av1" {
+			settings.Encoder, settings.Preset = "libsvtav1", "4"
+		}
+		settings.CRF = archivalNearLosslessCRF
+	}
+	return settings
+}
+
+// archivalWarnings explains the expected size of an archival encode
+// Lossless AV1 typically compresses raw video 2-3 times, which dwarfs an already lossy source
+// Kayıpsız AV1 ham videoyu genellikle 2-3 kat sıkıştırır, bu zaten kayıplı bir kaynağı fazlasıyla aşar
+func archivalWarnings(mode string, video VideoInfo) []string {
+	var warnings []string
+	if video.Width <= 0 || video.Height <= 0 || video.FrameRate <= 0 || video.DurationSeconds <= 0 {
+		return append(warnings, "source dimensions or duration are unknown, the output size can't be estimated")
+	}
+
+	// Raw 4:2:0 size, 1.5 samples per pixel at the source bit depth
+	// Kaynak bit derinliğinde piksel başına 1,5 örnekle ham 4:2:0 boyutu
+	bitDepth := video.BitDepth
+	if bitDepth <= 0 {
+		bitDepth = 8
+	}
+	rawBytes := float64(video.Width*video.Height) * 1.5 * float64(bitDepth) / 8 * video.FrameRate * video.DurationSeconds
+	estimate := rawBytes / 2.5
+	if mode == "nearlossless" {
+		estimate = rawBytes / 12
+	}
+	warnings = append(warnings, fmt.Sprintf("expect roughly %.1f GB for the video alone", estimate/1024/1024/1024))
+
+	if sourceBytes := float64(video.Bitrate) * 1000 / 8 * video.DurationSeconds; sourceBytes > 0 && estimate > sourceBytes*1.5 {
+		warnings = append(warnings, fmt.Sprintf("the %s source is already lossy, the output will be about %.0fx its size without any quality gain",
+			video.Codec, estimate/sourceBytes))
+	}
+	if video.HDR {
+		warnings = append(warnings, "HDR colorimetry is written explicitly, check the output on an HDR display")
+	}
+	return warnings
+}
+
+// validateArchival checks that nothing in the settings alters the archived picture
+// Ayarlardaki hiçbir şeyin arşivlenen görüntüyü değiştirmediğini kontrol eder
+func validateArchival(settings ConversionSettings) []ValidationError {
+	if settings.Archival == "" {
+		return nil
+	}
+	if !containsString(archivalModes, settings.Archival) {
+		return []ValidationError{{
+			Field:   "archival",
+			Value:   settings.Archival,
+			Message: fmt.Sprintf("must be one of %s", strings.Join(archivalModes, ", ")),
+		}}
+	}
+
+	var errs []ValidationError
+	if settings.Archival == "lossless" && settings.Encoder != "libaom-av1" {
+		errs = append(errs, ValidationError{Field: "encoder", Value: settings.Encoder, Message: "lossless archiving needs libaom-av1"})
+	}
+	if settings.MaxWidth > 0 || settings.MaxHeight > 0 || settings.InverseTelecine {
+		errs = append(errs, ValidationError{Field: "archival", Value: settings.Archival, Message: "archiving can't be combined with scaling or inverse telecine"})
+	}
+	if settings.MaxBitrate > 0 || settings.MaxFileSize > 0 || len(settings.Zones) > 0 || settings.AutoRelaxZones {
+		errs = append(errs, ValidationError{Field: "archival", Value: settings.Archival, Message: "archiving can't be combined with bitrate limits or quality zones"})
+	}
+	return errs
+}
+
+// colorArgs writes the source colorimetry explicitly instead of relying on FFmpeg to carry it
+// Renk bilgisini FFmpeg'in taşımasına güvenmek yerine kaynak renk bilgisini açıkça yazar
+func colorArgs(video VideoInfo) []string {
+	var args []string
+	for _, color := range []struct{ flag, value string }{
+		{"-color_primaries", video.ColorPrimaries},
+		{"-color_trc", video.ColorTransfer},
+		{"-colorspace", video.ColorSpace},
+		{"-color_range", video.ColorRange},
+	} {
+		if color.value != "" && color.value != "unknown" {
+			args = append(args, color.flag, color.value)
+		}
+	}
+	return args
+}
+
+// verifyArchival checks that an archival output holds the source picture and returns the VMAF it measured
+// Lossless outputs must decode to the same frames, near lossless ones must reach archivalMinVMAF
+// The score is 0 for lossless outputs and when libvmaf is missing
+// Kayıpsız çıktılar aynı karelere çözülmeli, kayıpsıza yakın olanlar archivalMinVMAF değerine ulaşmalıdır
+func (a *App) verifyArchival(ctx context.Context, plan conversionPlan, output planOutput) (float64, error) {
+	if output.Settings.Archival == "lossless" {
+		sourceHash, err := a.decodedVideoHash(ctx, plan.InputPath)
+		if err != nil {
+			return 0, err
+		}
+		outputHash, err := a.decodedVideoHash(ctx, output.Path)
+		if err != nil {
+			return 0, err
+		}
+		if sourceHash != outputHash {
+			return 0, fmt.Errorf("lossless output %s doesn't decode to the source frames", output.Path)
+		}
+		return 0, nil
+	}
+
+	score, err := a.measureVMAF(output.Path, plan.InputPath)
+	if err != nil {
+		log.Printf("Skipping archival verification of %s: %v", output.Path, err)
+		return 0, nil
+	}
+	if score < archivalMinVMAF {
+		return score, fmt.Errorf("near lossless output %s only scored VMAF %.2f, below %d", output.Path, score, archivalMinVMAF)
+	}
+	return score, nil
+}
+
+// decodedVideoHash returns the SHA-256 of the decoded frames of the first video stream
+// İlk video akışının çözülmüş karelerinin SHA-256 değerini döndürür
+func (a *App) decodedVideoHash(ctx context.Context, path string) (string, error) {
+	cmd := exec.CommandContext(ctx, a.ffmpegPath,
+		"-hide_banner", "-nostats", "-loglevel", "error",
+		"-i", path,
+		"-map", "0:v:0",
+		"-f", "hash", "-hash", "sha256", "-")
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err := runner.Run(cmd); err != nil {
+		return "", fmt.Errorf("failed to hash decoded frames of %s: %v: %s", path, err, strings.TrimSpace(stderr.String()))
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
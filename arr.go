@@ -0,0 +1,143 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"path/filepath"
+
+	"AV1-video-converter/internal/events"
+)
+
+// maxArrPayloadSize limits the size of a Sonarr/Radarr notification
+// Bir Sonarr/Radarr bildiriminin boyutunu sınırlar
+const maxArrPayloadSize = 1024 * 1024
+
+// arrPayload struct
+// Represents the parts of a Sonarr/Radarr webhook notification that are used
+// Bir Sonarr/Radarr webhook bildiriminin kullanılan kısımlarını temsil eder
+type arrPayload struct {
+	EventType string `json:"eventType"` // Download on import, Test from the settings page / İçe aktarmada Download, ayarlar sayfasından Test
+	Series    *struct {
+		Path string `json:"path"`
+	} `json:"series"`
+	Movie *struct {
+		FolderPath string `json:"folderPath"`
+	} `json:"movie"`
+	EpisodeFile *arrFile `json:"episodeFile"`
+	MovieFile   *arrFile `json:"movieFile"`
+}
+
+// arrFile struct
+// Represents an imported file of a Sonarr/Radarr notification
+// Bir Sonarr/Radarr bildiriminin içe aktarılan dosyasını temsil eder
+type arrFile struct {
+	Path         string `json:"path"`         // Full path, sent by newer versions / Tam yol, yeni sürümler gönderir
+	RelativePath string `json:"relativePath"` // Path below the series or movie folder / Dizi veya film klasörünün altındaki yol
+}
+
+// importedPath returns the full path of the imported file, empty if there is none
+// İçe aktarılan dosyanın tam yolunu döndürür, yoksa boş
+func (p arrPayload) importedPath() string {
+	switch {
+	case p.EpisodeFile != nil:
+		if p.EpisodeFile.Path != "" {
+			return p.EpisodeFile.Path
+		}
+		if p.Series != nil && p.EpisodeFile.RelativePath != "" {
+			return filepath.Join(p.Series.Path, p.EpisodeFile.RelativePath)
+		}
+	case p.MovieFile != nil:
+		if p.MovieFile.Path != "" {
+			return p.MovieFile.Path
+		}
+		if p.Movie != nil && p.MovieFile.RelativePath != "" {
+			return filepath.Join(p.Movie.FolderPath, p.MovieFile.RelativePath)
+		}
+	}
+	return ""
+}
+
+// handleArrWebhook queues files imported by Sonarr or Radarr
+// Point a Connect > Webhook of either at /api/arr with the On Import trigger
+// Herhangi birinin Connect > Webhook ayarını On Import tetikleyicisiyle /api/arr adresine yönlendirin
+func (a *App) handleArrWebhook(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeAPIError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	var payload arrPayload
+	if err := json.NewDecoder(io.LimitReader(r.Body, maxArrPayloadSize)).Decode(&payload); err != nil {
+		writeAPIError(w, http.StatusBadRequest, fmt.Sprintf("invalid payload: %v", err))
+		return
+	}
+
+	switch payload.EventType {
+	case "Test":
+		log.Printf("Sonarr/Radarr test notification received")
+		writeAPIJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+		return
+	case "Download":
+	default:
+		writeAPIJSON(w, http.StatusAccepted, map[string]string{"status": "ignored"})
+		return
+	}
+
+	inputPath := payload.importedPath()
+	if inputPath == "" {
+		writeAPIError(w, http.StatusBadRequest, "payload has no imported file")
+		return
+	}
+	job, err := a.enqueueImport(inputPath)
+	if err != nil {
+		log.Printf("Error queueing import %s: %v", inputPath, err)
+		writeAPIError(w, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+	if job == nil {
+		writeAPIJSON(w, http.StatusOK, map[string]string{"status": "skipped"})
+		return
+	}
+	writeAPIJSON(w, http.StatusAccepted, job)
+}
+
+// enqueueImport creates a job for an imported file and hands it to the queue
+// Files that are already AV1 are skipped and return no job
+// Zaten AV1 olan dosyalar atlanır ve iş döndürmez
+func (a *App) enqueueImport(inputPath string) (*Job, error) {
+	info, err := a.getVideoInfo(inputPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to probe %s: %v", inputPath, err)
+	}
+	if info.Codec == "av1" {
+		log.Printf("Skipping import %s, already AV1", inputPath)
+		return nil, nil
+	}
+
+	settings := a.GetSettings()
+	if profile, ok := findPlatformProfile(a.currentPreferences().ArrProfile); ok {
+		settings = applyPlatformProfile(settings, profile)
+	}
+	return a.queueFile(inputPath, a.currentPreferences().ArrOutputFolder, info, settings)
+}
+
+// queueFile adds a job for a probed file and announces it to the frontend
+// An empty output folder places the output next to the source
+// Boş bir çıktı klasörü çıktıyı kaynağın yanına koyar
+func (a *App) queueFile(inputPath, outputFolder string, info VideoInfo, settings ConversionSettings) (*Job, error) {
+	if outputFolder == "" {
+		outputFolder = filepath.Dir(inputPath)
+	}
+
+	job, err := a.addJob(inputPath, outputFolder, info.FrameCount, settings)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Queued %s as job %s", inputPath, job.ID)
+	a.emitJobEvent(events.JobAdded, job.ID, JobAddedEvent{Job: job, Video: &info})
+	a.emitQueueUpdated()
+	return &job, nil
+}
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Artifact file names inside the log folder of a job
+// Bir işin log klasöründeki yapıt dosya adları
+const (
+	settingsArtifact     = "settings.json"
+	verificationArtifact = "verification.json"
+)
+
+// VerificationResult struct
+// Represents one check of an output before it was accepted
+// Bir çıktının kabul edilmeden önceki bir kontrolünü temsil eder
+type VerificationResult struct {
+	OutputPath string    `json:"outputPath"`      // Checked file / Kontrol edilen dosya
+	Kind       string    `json:"kind"`            // archival, replacement or integrity / archival, replacement veya integrity
+	Passed     bool      `json:"passed"`          // Whether the check passed / Kontrolün geçip geçmediği
+	Error      string    `json:"error,omitempty"` // Failure reason / Hata nedeni
+	CheckedAt  time.Time `json:"checkedAt"`       // Time of the check / Kontrol zamanı
+}
+
+// JobArtifacts struct
+// Represents everything recorded about a job, for inspecting a past conversion
+// Bir iş hakkında kaydedilen her şeyi temsil eder, geçmiş bir dönüştürmeyi incelemek için
+type JobArtifacts struct {
+	JobID        string               `json:"jobId"`        // Job the artifacts belong to / Yapıtların ait olduğu iş
+	Folder       string               `json:"folder"`       // Log folder of the job / İşin log klasörü
+	FFmpegLogs   []string             `json:"ffmpegLogs"`   // FFmpeg logs of the job / İşin FFmpeg logları
+	Settings     *ConversionSettings  `json:"settings"`     // Settings snapshot taken at the start / Başlangıçta alınan ayar anlık görüntüsü
+	Verification []VerificationResult `json:"verification"` // Output checks, empty when none ran / Çıktı kontrolleri, çalışmadıysa boş
+	SourceReport *SourceReport        `json:"sourceReport"` // Source analysis, if one ran / Çalıştıysa kaynak analizi
+	Files        []string             `json:"files"`        // Every file in the log folder / Log klasöründeki her dosya
+	History      []HistoryEntry       `json:"history"`      // History entries of the job / İşin geçmiş kayıtları
+}
+
+// GetJobArtifacts returns the logs, settings snapshot and verification report of a job
+// Works for past jobs as long as the log retention rules kept their folder
+// Log saklama kuralları klasörlerini koruduğu sürece geçmiş işler için de çalışır
+func (a *App) GetJobArtifacts(jobID string) (JobArtifacts, error) {
+	folder, err := a.jobArtifactsDir(jobID)
+	if err != nil {
+		return JobArtifacts{}, err
+	}
+	artifacts := JobArtifacts{JobID: jobID, Folder: folder}
+
+	history, _ := a.GetHistory()
+	for _, entry := range history {
+		if entry.JobID == jobID {
+			artifacts.History = append(artifacts.History, entry)
+		}
+	}
+
+	entries, err := os.ReadDir(folder)
+	if err != nil {
+		if os.IsNotExist(err) && len(artifacts.History) > 0 {
+			return artifacts, nil
+		}
+		return JobArtifacts{}, fmt.Errorf("no artifacts for job %s: %v", jobID, err)
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		path := filepath.Join(folder, entry.Name())
+		artifacts.Files = append(artifacts.Files, path)
+		if strings.HasSuffix(entry.Name(), "_ffmpeg.log") {
+			artifacts.FFmpegLogs = append(artifacts.FFmpegLogs, path)
+		}
+	}
+	sort.Strings(artifacts.Files)
+	sort.Strings(artifacts.FFmpegLogs)
+
+	var settings ConversionSettings
+	if readJobArtifact(filepath.Join(folder, settingsArtifact), &settings) {
+		artifacts.Settings = &settings
+	}
+	readJobArtifact(filepath.Join(folder, verificationArtifact), &artifacts.Verification)
+	var report SourceReport
+	if readJobArtifact(filepath.Join(folder, sourceReportArtifact), &report) {
+		artifacts.SourceReport = &report
+	}
+	return artifacts, nil
+}
+
+// OpenJobLog opens the FFmpeg log of a job with the default application
+// Opens the log folder when the job wrote several logs or none
+// İş birden fazla log yazdıysa veya hiç yazmadıysa log klasörünü açar
+func (a *App) OpenJobLog(jobID string) error {
+	artifacts, err := a.GetJobArtifacts(jobID)
+	if err != nil {
+		return err
+	}
+	if len(artifacts.FFmpegLogs) == 1 {
+		return a.OpenOutputFile(artifacts.FFmpegLogs[0])
+	}
+	return a.OpenOutputFile(artifacts.Folder)
+}
+
+// jobArtifactsDir returns the log folder of a job
+// Rejects IDs that would escape the logs directory
+// Log dizininin dışına çıkacak kimlikleri reddeder
+func (a *App) jobArtifactsDir(jobID string) (string, error) {
+	if jobID == "" || jobID != filepath.Base(jobID) || jobID == "." || jobID == ".." {
+		return "", fmt.Errorf("invalid job ID: %q", jobID)
+	}
+	return filepath.Join(a.logsDir(), jobID), nil
+}
+
+// writeSettingsSnapshot stores the settings a job started with next to its logs
+// Bir işin başladığı ayarları loglarının yanına kaydeder
+func (a *App) writeSettingsSnapshot(jobID string, settings ConversionSettings) {
+	path, err := a.jobLogPath(jobID, settingsArtifact)
+	if err != nil {
+		log.Printf("Error creating log folder of job %s: %v", jobID, err)
+		return
+	}
+	data, err := json.MarshalIndent(settings, "", "  ")
+	if err != nil {
+		log.Printf("Error marshalling settings snapshot: %v", err)
+		return
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		log.Printf("Error writing settings snapshot: %v", err)
+	}
+}
+
+// recordVerification appends the outcome of an output check to the report of a job
+// Bir çıktı kontrolünün sonucunu işin raporuna ekler
+func (a *App) recordVerification(jobID, kind, outputPath string, checkErr error) {
+	path, err := a.jobLogPath(jobID, verificationArtifact)
+	if err != nil {
+		log.Printf("Error creating log folder of job %s: %v", jobID, err)
+		return
+	}
+
+	var results []VerificationResult
+	readJobArtifact(path, &results)
+	result := VerificationResult{OutputPath: outputPath, Kind: kind, Passed: checkErr == nil, CheckedAt: time.Now()}
+	if checkErr != nil {
+		result.Error = checkErr.Error()
+	}
+	results = append(results, result)
+
+	data, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		log.Printf("Error marshalling verification report: %v", err)
+		return
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		log.Printf("Error writing verification report: %v", err)
+	}
+}
+
+// readJobArtifact decodes a JSON artifact, reporting whether it was found
+// Bir JSON yapıtını çözer, bulunup bulunmadığını bildirir
+func readJobArtifact(path string, value interface{}) bool {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Printf("Error reading %s: %v", path, err)
+		}
+		return false
+	}
+	if err := json.Unmarshal(data, value); err != nil {
+		log.Printf("Error unmarshalling %s: %v", path, err)
+		return false
+	}
+	return true
+}
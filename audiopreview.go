@@ -0,0 +1,80 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+
+	"AV1-video-converter/internal/runner"
+)
+
+// Audio preview length limits in seconds
+// Saniye cinsinden ses önizleme uzunluğu sınırları
+const (
+	defaultAudioPreview = 15
+	maxAudioPreview     = 60
+)
+
+// PreviewAudioTrack extracts a short clip of an audio stream and returns a URL the player can load
+// The clip is AAC in M4A, which every webview plays, whatever the source codec is
+// Klip M4A içinde AAC'dir, kaynak codec ne olursa olsun her web görünümü oynatır
+func (a *App) PreviewAudioTrack(path string, streamIndex int, start, duration float64) (string, error) {
+	result, err := a.probeFile(a.baseContext(), path)
+	if err != nil {
+		return "", err
+	}
+	isAudio := false
+	for _, stream := range result.Streams {
+		if stream.Index == streamIndex {
+			isAudio = stream.CodecType == "audio"
+			break
+		}
+	}
+	if !isAudio {
+		return "", fmt.Errorf("stream %d is not an audio track", streamIndex)
+	}
+	if start < 0 {
+		start = 0
+	}
+	if duration <= 0 {
+		duration = defaultAudioPreview
+	}
+	if duration > maxAudioPreview {
+		duration = maxAudioPreview
+	}
+
+	// Clips are named after what they contain, so replaying one doesn't extract it again
+	// Klipler içeriklerine göre adlandırılır, böylece yeniden oynatmak tekrar çıkarmaz
+	dir := a.workCacheDir("av1-previews")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create preview folder: %v", err)
+	}
+	key := sha256.Sum256([]byte(fmt.Sprintf("%s|%d|%g|%g", path, streamIndex, start, duration)))
+	clipPath := filepath.Join(dir, hex.EncodeToString(key[:8])+".m4a")
+
+	if _, err := os.Stat(clipPath); err != nil {
+		cmd := exec.CommandContext(a.baseContext(), a.ffmpegPath,
+			"-hide_banner", "-nostats", "-loglevel", "error", "-y",
+			"-ss", strconv.FormatFloat(start, 'f', 3, 64),
+			"-i", path,
+			"-t", strconv.FormatFloat(duration, 'f', 3, 64),
+			"-map", "0:"+strconv.Itoa(streamIndex),
+			"-vn", "-sn", "-dn",
+			"-c:a", "aac", "-b:a", "160k", "-ac", "2",
+			clipPath)
+		var stderr bytes.Buffer
+		cmd.Stderr = &stderr
+		if err := runner.Run(cmd); err != nil {
+			os.Remove(clipPath)
+			log.Printf("Error extracting audio preview of %s stream %d: %v: %s", path, streamIndex, err, stderr.String())
+			return "", fmt.Errorf("failed to extract audio preview: %v", err)
+		}
+	}
+	return a.media.register(clipPath)
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"fmt"
+	"log"
+)
+
+// maxAudioOffset is the largest audio delay or advance in milliseconds
+// Milisaniye cinsinden en büyük ses gecikmesi veya öne alması
+const maxAudioOffset = 10000
+
+// SetJobAudioOffset shifts the audio of a queued job against its video
+// Positive values delay the audio, negative ones play it earlier, copied audio stays copied
+// Pozitif değerler sesi geciktirir, negatifler daha erken çalar, kopyalanan ses kopyalanmış kalır
+func (a *App) SetJobAudioOffset(jobID string, milliseconds int) error {
+	if milliseconds < -maxAudioOffset || milliseconds > maxAudioOffset {
+		return fmt.Errorf("audio offset must be between -%d and %d ms", maxAudioOffset, maxAudioOffset)
+	}
+	job, ok := a.getJob(jobID)
+	if !ok {
+		return fmt.Errorf("unknown job: %s", jobID)
+	}
+	if job.Status == "running" {
+		return fmt.Errorf("job %s is running", jobID)
+	}
+	if job.AudioOnly || job.Clip != nil {
+		return fmt.Errorf("job %s has no video to sync the audio to", jobID)
+	}
+
+	// Renditions share the decoded source, so they share the offset too
+	// Sürümler çözülen kaynağı paylaşır, bu yüzden kaymayı da paylaşırlar
+	a.updateJob(jobID, func(job *Job) {
+		job.Settings.AudioOffset = milliseconds
+		for i := range job.Renditions {
+			job.Renditions[i].Settings.AudioOffset = milliseconds
+		}
+	})
+	log.Printf("Job %s audio offset set to %d ms", jobID, milliseconds)
+	return nil
+}
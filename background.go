@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"AV1-video-converter/internal/runner"
+)
+
+// backgroundCheckInterval is how often paused background work looks for the encodes to end
+// Duraklatılan arka plan işinin kodlamaların bitip bitmediğine bakma sıklığı
+const backgroundCheckInterval = 2 * time.Second
+
+// backgroundPriorities maps the background priority preference to the process priority
+// Arka plan önceliği tercihini işlem önceliğine eşler
+var backgroundPriorities = map[string]runner.Priority{
+	"":       runner.PriorityLow,
+	"normal": runner.PriorityNormal,
+	"low":    runner.PriorityLow,
+	"idle":   runner.PriorityIdle,
+}
+
+// backgroundTaskKey marks the context of probing, thumbnail and analysis work nobody waits on in a job
+// Bir işte kimsenin beklemediği inceleme, küçük resim ve analiz işinin bağlamını işaretler
+type backgroundTaskKey struct{}
+
+// backgroundContext returns a context for bulk probing, thumbnails and analysis
+// Their processes run at the background priority and wait for the encodes when that is enabled
+// İşlemleri arka plan önceliğinde çalışır ve etkinse kodlamaları bekler
+func (a *App) backgroundContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, backgroundTaskKey{}, true)
+	return runner.WithPriority(ctx, backgroundPriorities[a.currentPreferences().BackgroundPriority])
+}
+
+// waitForEncodes holds background work while an encode is running, if the preferences ask for it
+// Work of a job itself is never held, only contexts from backgroundContext are
+// Bir işin kendi işi asla bekletilmez, yalnızca backgroundContext bağlamları bekletilir
+func (a *App) waitForEncodes(ctx context.Context) error {
+	if background, _ := ctx.Value(backgroundTaskKey{}).(bool); !background || !a.currentPreferences().PauseBackgroundOnEncode || !a.encoding() {
+		return nil
+	}
+	log.Printf("Background work paused while an encode runs")
+	ticker := time.NewTicker(backgroundCheckInterval)
+	defer ticker.Stop()
+	for a.encoding() {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("background work cancelled: %w", context.Cause(ctx))
+		case <-ticker.C:
+		}
+	}
+	return nil
+}
+
+// encoding reports whether any job is in an FFmpeg encode or mux pass
+// Herhangi bir işin FFmpeg kodlama veya birleştirme geçişinde olup olmadığını bildirir
+func (a *App) encoding() bool {
+	for _, job := range a.GetJobs() {
+		if job.Status == "running" && !job.advancedAt.IsZero() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"AV1-video-converter/internal/runner"
+)
+
+// benchmarkSampleSeconds is the length of the benchmark sample
+// Kıyaslama örneğinin uzunluğudur
+const benchmarkSampleSeconds = 20
+
+// BenchmarkResult struct
+// Represents one preset/CRF combination encoded on the sample
+// Örnek üzerinde kodlanan bir ön ayar/CRF birleşimini temsil eder
+type BenchmarkResult struct {
+	Preset        string  `json:"preset"`        // Encoder preset / Kodlayıcı ön ayarı
+	CRF           int     `json:"crf"`           // Quality value / Kalite değeri
+	EncodeSeconds float64 `json:"encodeSeconds"` // Encode time / Kodlama süresi
+	Speed         float64 `json:"speed"`         // Times realtime / Gerçek zamanın katı
+	Size          int64   `json:"size"`          // Output size in bytes / Bayt cinsinden çıktı boyutu
+	Bitrate       int     `json:"bitrate"`       // Average bitrate in kbps / Kbps cinsinden ortalama bit hızı
+	VMAF          float64 `json:"vmaf"`          // VMAF score, 0 if unavailable / VMAF puanı, yoksa 0
+	Error         string  `json:"error"`         // Failure reason / Hata nedeni
+}
+
+// Benchmark encodes a short sample of the input at several presets and CRFs
+// Measures encode time, size and VMAF so users can pick their own tradeoff
+// Kullanıcıların kendi dengelerini seçebilmesi için kodlama süresini, boyutu ve VMAF'ı ölçer
+func (a *App) Benchmark(inputPath string) ([]BenchmarkResult, error) {
+	video, err := a.getVideoInfo(inputPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to probe input: %v", err)
+	}
+
+	workDir, err := a.makeWorkDir("av1-benchmark-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create benchmark folder: %v", err)
+	}
+	defer os.RemoveAll(workDir)
+
+	// Cut the sample from the middle of the source
+	// Örneği kaynağın ortasından kes
+	samplePath := filepath.Join(workDir, "sample.mkv")
+	sampleLength := float64(benchmarkSampleSeconds)
+	start := 0.0
+	if video.DurationSeconds > sampleLength {
+		start = (video.DurationSeconds - sampleLength) / 2
+	} else if video.DurationSeconds > 0 {
+		sampleLength = video.DurationSeconds
+	}
+	cut := exec.Command(a.ffmpegPath, "-hide_banner", "-loglevel", "error",
+		"-ss", strconv.FormatFloat(start, 'f', 2, 64), "-i", inputPath,
+		"-t", strconv.FormatFloat(sampleLength, 'f', 2, 64),
+		"-map", "0:v:0", "-c", "copy", "-y", samplePath)
+	if out, err := runner.CombinedOutput(cut); err != nil {
+		log.Printf("Error cutting benchmark sample: %v: %s", err, out)
+		return nil, fmt.Errorf("failed to cut sample: %v", err)
+	}
+
+	cases := benchmarkCases(a.GetSettings())
+	results := make([]BenchmarkResult, 0, len(cases))
+	for i, settings := range cases {
+		a.events.Emit("benchmark:progress", map[string]interface{}{
+			"current": i + 1,
+			"total":   len(cases),
+			"preset":  settings.Preset,
+			"crf":     settings.CRF,
+		})
+		results = append(results, a.runBenchmarkCase(samplePath, workDir, sampleLength, settings))
+	}
+
+	log.Printf("Benchmark of %s finished with %d results", inputPath, len(results))
+	return results, nil
+}
+
+// benchmarkCases lists the settings to compare for the current encoder
+// Presets are spread over the encoder's range, CRF is varied around the current value
+// Ön ayarlar kodlayıcının aralığına yayılır, CRF geçerli değer etrafında değiştirilir
+func benchmarkCases(base ConversionSettings) []ConversionSettings {
+	spec := encoderSpecs[base.Encoder]
+
+	var cases []ConversionSettings
+	step := len(spec.presets) / 4
+	if step < 1 {
+		step = 1
+	}
+	for i := len(spec.presets) - 1; i >= 0; i -= step {
+		settings := base
+		settings.Preset = spec.presets[i]
+		cases = append(cases, settings)
+	}
+	for _, delta := range []int{-6, 6} {
+		crf := base.CRF + delta
+		if spec.qualityFlag == "" || crf < spec.qualityMin || crf > spec.qualityMax {
+			continue
+		}
+		settings := base
+		settings.CRF = crf
+		cases = append(cases, settings)
+	}
+	return cases
+}
+
+// runBenchmarkCase encodes the sample with one settings combination
+// Örneği tek bir ayar birleşimiyle kodlar
+func (a *App) runBenchmarkCase(samplePath, workDir string, sampleLength float64, settings ConversionSettings) BenchmarkResult {
+	result := BenchmarkResult{Preset: settings.Preset, CRF: settings.CRF}
+	outputPath := filepath.Join(workDir, fmt.Sprintf("p%s_crf%d.mkv", settings.Preset, settings.CRF))
+
+	args := []string{"-hide_banner", "-loglevel", "error", "-i", samplePath}
+	args = append(args, encoderArgs(settings)...)
+	args = append(args, "-an", "-y", outputPath)
+
+	startedAt := time.Now()
+	if out, err := runner.CombinedOutput(exec.Command(a.ffmpegPath, args...)); err != nil {
+		log.Printf("Benchmark encode failed for preset %s crf %d: %v: %s", settings.Preset, settings.CRF, err, out)
+		result.Error = firstLine(string(out))
+		return result
+	}
+	result.EncodeSeconds = time.Since(startedAt).Seconds()
+	if result.EncodeSeconds > 0 {
+		result.Speed = sampleLength / result.EncodeSeconds
+	}
+	result.Size = fileSize(outputPath)
+	if sampleLength > 0 {
+		result.Bitrate = int(float64(result.Size) * 8 / 1000 / sampleLength)
+	}
+
+	vmaf, err := a.measureVMAF(outputPath, samplePath)
+	if err != nil {
+		result.Error = err.Error()
+	}
+	result.VMAF = vmaf
+	return result
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"math"
+
+	"AV1-video-converter/internal/probe"
+)
+
+// maxBitratePoints caps the length of a bitrate series so long files stay chartable
+// Uzun dosyaların grafiğe dökülebilmesi için bit hızı serisinin uzunluğunu sınırlar
+const maxBitratePoints = 3600
+
+// BitratePoint struct
+// Represents the video bitrate of one interval
+// Bir aralığın video bit hızını temsil eder
+type BitratePoint struct {
+	Time float64 `json:"time"` // Interval start in seconds / Saniye cinsinden aralık başlangıcı
+	Kbps float64 `json:"kbps"` // Bitrate in kbit/s / kbit/s cinsinden bit hızı
+}
+
+// BitrateSeries struct
+// Represents the bitrate of a video stream over time
+// Bir video akışının zaman içindeki bit hızını temsil eder
+type BitrateSeries struct {
+	Path        string         `json:"path"`        // Analyzed file / Analiz edilen dosya
+	Interval    float64        `json:"interval"`    // Seconds per point / Nokta başına saniye
+	Points      []BitratePoint `json:"points"`      // Bitrate per interval / Aralık başına bit hızı
+	AverageKbps float64        `json:"averageKbps"` // Average over the whole stream / Tüm akış boyunca ortalama
+	PeakKbps    float64        `json:"peakKbps"`    // Highest interval / En yüksek aralık
+}
+
+// AnalyzeBitrate samples the per-second video bitrate of a file from its packets
+// Longer files are grouped into wider intervals, the frontend charts source and output side by side
+// Uzun dosyalar daha geniş aralıklarda gruplanır, ön yüz kaynak ve çıktıyı yan yana çizer
+func (a *App) AnalyzeBitrate(path string) (BitrateSeries, error) {
+	series := BitrateSeries{Path: path}
+	args := append(append([]string{}, probe.PacketArgs...), path)
+	out, err := a.runner.Output(a.baseContext(), a.ffprobePath, args...)
+	if err != nil {
+		log.Printf("Error reading packets of %s: %v", path, err)
+		return series, fmt.Errorf("failed to read packets: %v", err)
+	}
+	packets := probe.ParsePackets(out)
+	if len(packets) == 0 {
+		return series, fmt.Errorf("no video packets found in %s", path)
+	}
+
+	// Streams may not start at zero, the series does
+	// Akışlar sıfırdan başlamayabilir, seri başlar
+	start, end := probe.PacketSpan(packets)
+	duration := end - start
+	series.Interval = math.Max(1, math.Ceil(duration/maxBitratePoints))
+
+	buckets := make([]int64, int(duration/series.Interval)+1)
+	var total int64
+	for _, packet := range packets {
+		buckets[int((packet.Time-start)/series.Interval)] += int64(packet.Size)
+		total += int64(packet.Size)
+	}
+	for i, bytes := range buckets {
+		kbps := float64(bytes) * 8 / 1000 / series.Interval
+		series.Points = append(series.Points, BitratePoint{Time: float64(i) * series.Interval, Kbps: kbps})
+		series.PeakKbps = math.Max(series.PeakKbps, kbps)
+	}
+	series.AverageKbps = float64(total) * 8 / 1000 / math.Max(duration, series.Interval)
+	return series, nil
+}
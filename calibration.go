@@ -0,0 +1,306 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"AV1-video-converter/internal/runner"
+)
+
+// Calibration constants, the reference clip is generated since none ships with the application
+// Kalibrasyon sabitleri, uygulamayla birlikte gelen bir klip olmadığından referans klip üretilir
+const (
+	calibrationSeconds        = 5   // Length of the reference clip / Referans klibin uzunluğu
+	calibrationFrameRate      = 30  // Frame rate of the generated clip / Üretilen klibin kare hızı
+	calibrationMinSpeed       = 0.5 // Slowest acceptable speed as a multiple of real time / Gerçek zamanın katı olarak kabul edilebilir en yavaş hız
+	calibrationVMAFTolerance  = 0.5 // VMAF a faster preset may lose and still count as equal / Daha hızlı bir ön ayarın kaybedip eşit sayılabileceği VMAF
+	calibrationMinGain        = 0.1 // Throughput gain another parallel encode must bring / Başka bir paralel kodlamanın getirmesi gereken verim artışı
+	maxCalibrationConcurrency = 4   // Most parallel encodes tried / Denenen en fazla paralel kodlama
+)
+
+// calibrationPresets are the SVT-AV1 presets measured, spread over the useful range
+// Ölçülen SVT-AV1 ön ayarlarıdır, kullanışlı aralığa yayılmıştır
+var calibrationPresets = []string{"4", "6", "8", "10", "12"}
+
+// CalibrationPreset struct
+// Represents one preset encoded on the reference clip
+// Referans klip üzerinde kodlanan bir ön ayarı temsil eder
+type CalibrationPreset struct {
+	Preset string  `json:"preset"` // SVT-AV1 preset / SVT-AV1 ön ayarı
+	FPS    float64 `json:"fps"`    // Frames encoded per second / Saniyede kodlanan kare
+	Speed  float64 `json:"speed"`  // Times realtime / Gerçek zamanın katı
+	VMAF   float64 `json:"vmaf"`   // VMAF score, 0 if unavailable / VMAF puanı, yoksa 0
+	Error  string  `json:"error"`  // Failure reason / Hata nedeni
+}
+
+// Calibration struct
+// Represents the measurements of this machine and the preset and concurrency recommended from them
+// Bu makinenin ölçümlerini ve onlardan önerilen ön ayar ile eşzamanlılığı temsil eder
+type Calibration struct {
+	MeasuredAt  time.Time           `json:"measuredAt"`  // When the calibration ran / Kalibrasyonun çalıştığı zaman
+	Clip        string              `json:"clip"`        // Clip encoded, empty for the generated reference / Kodlanan klip, boşsa üretilen referans
+	Presets     []CalibrationPreset `json:"presets"`     // Result of every preset / Her ön ayarın sonucu
+	Throughput  []float64           `json:"throughput"`  // Total FPS with 1, 2, ... parallel encodes / 1, 2, ... paralel kodlamayla toplam FPS
+	Preset      string              `json:"preset"`      // Recommended default preset / Önerilen varsayılan ön ayar
+	Concurrency int                 `json:"concurrency"` // Recommended parallel jobs / Önerilen paralel iş sayısı
+	Applied     bool                `json:"applied"`     // The preset became the default and the concurrency the parallel job limit / Ön ayar varsayılan, eşzamanlılık paralel iş sınırı oldu
+}
+
+// GetCalibration returns the stored calibration, nil before the first one ran
+// The frontend offers the calibration on first run while this is nil
+// Bu nil olduğu sürece ön yüz ilk çalıştırmada kalibrasyonu önerir
+func (a *App) GetCalibration() *Calibration {
+	a.configMu.RLock()
+	defer a.configMu.RUnlock()
+	if a.calibration == nil {
+		return nil
+	}
+	calibration := *a.calibration
+	return &calibration
+}
+
+// RunCalibration encodes a reference clip at several presets, measures FPS and VMAF and stores a recommendation
+// clipPath is an optional clip of the user's own library, empty generates a 1080p reference
+// clipPath kullanıcının kendi kütüphanesinden isteğe bağlı bir kliptir, boşsa 1080p bir referans üretilir
+func (a *App) RunCalibration(clipPath string) (Calibration, error) {
+	// Jobs don't start until the calibration ends, and only one calibration runs at a time
+	// Kalibrasyon bitene kadar işler başlamaz ve aynı anda yalnızca bir kalibrasyon çalışır
+	if err := a.slots.beginCalibration(); err != nil {
+		return Calibration{}, err
+	}
+	defer a.slots.endCalibration()
+
+	workDir, err := a.makeWorkDir("av1-calibration-")
+	if err != nil {
+		return Calibration{}, fmt.Errorf("failed to create calibration folder: %v", err)
+	}
+	defer os.RemoveAll(workDir)
+
+	referencePath, seconds, frameRate, err := a.calibrationClip(clipPath, workDir)
+	if err != nil {
+		return Calibration{}, err
+	}
+
+	calibration := Calibration{MeasuredAt: time.Now(), Clip: clipPath}
+	base := a.GetSettings()
+	if base.Encoder != "libsvtav1" {
+		base = defaultSettings()
+	}
+	base.Level = ""
+
+	total := len(calibrationPresets) + maxCalibrationConcurrency - 1
+	for i, preset := range calibrationPresets {
+		a.emitCalibrationProgress(i+1, total, "preset "+preset)
+		settings := base
+		settings.Preset = preset
+		result := CalibrationPreset{Preset: preset}
+		outputPath := filepath.Join(workDir, "preset"+preset+".mkv")
+		elapsed, err := a.encodeCalibration(referencePath, outputPath, settings)
+		if err != nil {
+			result.Error = err.Error()
+			calibration.Presets = append(calibration.Presets, result)
+			continue
+		}
+		result.Speed = seconds / elapsed
+		result.FPS = result.Speed * frameRate
+		if result.VMAF, err = a.measureVMAF(outputPath, referencePath); err != nil {
+			result.Error = err.Error()
+		}
+		calibration.Presets = append(calibration.Presets, result)
+	}
+
+	preset, ok := recommendPreset(calibration.Presets)
+	if !ok {
+		return Calibration{}, fmt.Errorf("every calibration encode failed: %s", calibration.Presets[0].Error)
+	}
+	calibration.Preset = preset.Preset
+
+	// Add parallel encodes of the recommended preset while they still raise the throughput
+	// Önerilen ön ayarın paralel kodlamalarını verimi artırdıkları sürece ekle
+	base.Preset = preset.Preset
+	calibration.Throughput = []float64{preset.FPS}
+	calibration.Concurrency = 1
+	for n := 2; n <= maxCalibrationConcurrency; n++ {
+		a.emitCalibrationProgress(len(calibrationPresets)+n-1, total, fmt.Sprintf("%d parallel encodes", n))
+		fps, err := a.parallelCalibration(referencePath, workDir, base, n, seconds*frameRate)
+		if err != nil {
+			log.Printf("Calibration with %d parallel encodes failed: %v", n, err)
+			break
+		}
+		calibration.Throughput = append(calibration.Throughput, fps)
+		if fps < calibration.Throughput[calibration.Concurrency-1]*(1+calibrationMinGain) {
+			break
+		}
+		calibration.Concurrency = n
+	}
+
+	log.Printf("Calibration recommends preset %s with %d parallel jobs", calibration.Preset, calibration.Concurrency)
+	a.configMu.Lock()
+	a.calibration = &calibration
+	a.configMu.Unlock()
+	a.saveConfig()
+	return calibration, nil
+}
+
+// ApplyCalibration makes the recommended preset the default of new conversions and the concurrency the parallel job limit
+// Önerilen ön ayarı yeni dönüştürmelerin varsayılanı, eşzamanlılığı paralel iş sınırı yapar
+func (a *App) ApplyCalibration() error {
+	calibration := a.GetCalibration()
+	if calibration == nil {
+		return fmt.Errorf("no calibration has run yet")
+	}
+	settings := a.GetSettings()
+	if settings.Encoder != "libsvtav1" {
+		return fmt.Errorf("the calibration measured libsvtav1, current encoder is %s", settings.Encoder)
+	}
+	settings.Preset = calibration.Preset
+	if err := a.SaveSettings(settings); err != nil {
+		return err
+	}
+	a.updatePreferences(func(preferences *AppPreferences) {
+		preferences.ParallelJobs = calibration.Concurrency
+	})
+	a.slots.limitChanged()
+	calibration.Applied = true
+	a.configMu.Lock()
+	a.calibration = calibration
+	a.configMu.Unlock()
+	a.saveConfig()
+	return nil
+}
+
+// calibrationClip prepares the clip the calibration encodes and returns its length and frame rate
+// The generated clip is noisy on purpose, a clean test pattern encodes unrealistically fast
+// Üretilen klip bilerek gürültülüdür, temiz bir test deseni gerçek dışı hızlı kodlanır
+func (a *App) calibrationClip(clipPath, workDir string) (string, float64, float64, error) {
+	referencePath := filepath.Join(workDir, "reference.mkv")
+	args := []string{"-hide_banner", "-loglevel", "error"}
+	seconds, frameRate := float64(calibrationSeconds), float64(calibrationFrameRate)
+	if clipPath == "" {
+		args = append(args, "-f", "lavfi", "-i", fmt.Sprintf("testsrc2=size=1920x1080:rate=%d", calibrationFrameRate),
+			"-t", strconv.Itoa(calibrationSeconds), "-vf", "noise=alls=6:allf=t:all_seed=1")
+	} else {
+		video, err := a.getVideoInfo(clipPath)
+		if err != nil {
+			return "", 0, 0, fmt.Errorf("failed to probe clip: %v", err)
+		}
+		start := 0.0
+		if video.DurationSeconds > seconds {
+			start = (video.DurationSeconds - seconds) / 2
+		} else if video.DurationSeconds > 0 {
+			seconds = video.DurationSeconds
+		}
+		if video.FrameRate > 0 {
+			frameRate = video.FrameRate
+		}
+		args = append(args, "-ss", strconv.FormatFloat(start, 'f', 2, 64), "-i", clipPath,
+			"-t", strconv.FormatFloat(seconds, 'f', 2, 64), "-map", "0:v:0")
+	}
+
+	// Keep the clip lossless so VMAF measures the presets, not the clip
+	// VMAF'ın klibi değil ön ayarları ölçmesi için klibi kayıpsız tut
+	args = append(args, "-c:v", "ffv1", "-pix_fmt", "yuv420p", "-an", "-y", referencePath)
+	if out, err := runner.CombinedOutput(exec.Command(a.ffmpegPath, args...)); err != nil {
+		log.Printf("Error preparing calibration clip: %v: %s", err, out)
+		return "", 0, 0, fmt.Errorf("failed to prepare calibration clip: %v", err)
+	}
+	return referencePath, seconds, frameRate, nil
+}
+
+// encodeCalibration encodes the reference clip and returns how long it took
+// Referans klibi kodlar ve ne kadar sürdüğünü döndürür
+func (a *App) encodeCalibration(referencePath, outputPath string, settings ConversionSettings) (float64, error) {
+	args := []string{"-hide_banner", "-loglevel", "error", "-i", referencePath}
+	args = append(args, encoderArgs(settings)...)
+	args = append(args, "-an", "-y", outputPath)
+
+	startedAt := time.Now()
+	if out, err := runner.CombinedOutput(exec.Command(a.ffmpegPath, args...)); err != nil {
+		log.Printf("Calibration encode failed for preset %s: %v: %s", settings.Preset, err, out)
+		if line := firstLine(string(out)); line != "" {
+			return 0, fmt.Errorf("%s", line)
+		}
+		return 0, err
+	}
+	return time.Since(startedAt).Seconds(), nil
+}
+
+// parallelCalibration runs n encodes of the reference clip at once and returns their total FPS
+// Referans klibin n kodlamasını aynı anda çalıştırır ve toplam FPS değerlerini döndürür
+func (a *App) parallelCalibration(referencePath, workDir string, settings ConversionSettings, n int, frames float64) (float64, error) {
+	var wg sync.WaitGroup
+	errs := make([]error, n)
+	startedAt := time.Now()
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			outputPath := filepath.Join(workDir, fmt.Sprintf("parallel%d.mkv", i))
+			_, errs[i] = a.encodeCalibration(referencePath, outputPath, settings)
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return 0, err
+		}
+	}
+	return frames * float64(n) / time.Since(startedAt).Seconds(), nil
+}
+
+// recommendPreset picks the fastest preset within calibrationVMAFTolerance of the best quality reached at a usable speed
+// Without VMAF the slowest preset at a usable speed wins, the fastest one when none is usable
+// VMAF olmadan kullanılabilir hızdaki en yavaş ön ayar, hiçbiri kullanılabilir değilse en hızlısı kazanır
+func recommendPreset(results []CalibrationPreset) (CalibrationPreset, bool) {
+	var usable []CalibrationPreset
+	var fastest CalibrationPreset
+	for _, result := range results {
+		if result.Speed <= 0 {
+			continue
+		}
+		if result.Speed > fastest.Speed {
+			fastest = result
+		}
+		if result.Speed >= calibrationMinSpeed {
+			usable = append(usable, result)
+		}
+	}
+	if len(usable) == 0 {
+		return fastest, fastest.Speed > 0
+	}
+
+	// Results run from the slowest preset to the fastest
+	// Sonuçlar en yavaş ön ayardan en hızlıya doğru sıralanır
+	best := 0.0
+	for _, result := range usable {
+		if result.VMAF > best {
+			best = result.VMAF
+		}
+	}
+	if best == 0 {
+		return usable[0], true
+	}
+	pick := usable[0]
+	for _, result := range usable {
+		if result.VMAF >= best-calibrationVMAFTolerance {
+			pick = result
+		}
+	}
+	return pick, true
+}
+
+// emitCalibrationProgress reports the step the calibration is at
+// Kalibrasyonun bulunduğu adımı bildirir
+func (a *App) emitCalibrationProgress(step, total int, stage string) {
+	a.events.Emit("calibration:progress", map[string]interface{}{
+		"current": step,
+		"total":   total,
+		"stage":   stage,
+	})
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// checksumManifestModes lists the per-output manifest formats
+// json records SHA-256 of source and output, sfv the CRC32 of the output
+// json kaynak ve çıktının SHA-256 değerini, sfv çıktının CRC32 değerini kaydeder
+var checksumManifestModes = []string{"json", "sfv"}
+
+// batchChecksumFile is the sha256sum compatible list written next to the outputs of a batch
+// Bir toplu işin çıktılarının yanına yazılan sha256sum uyumlu listedir
+const batchChecksumFile = "checksums.sha256"
+
+// FileChecksum struct
+// Represents the checksum of a file
+// Bir dosyanın sağlama toplamını temsil eder
+type FileChecksum struct {
+	Path   string `json:"path"`   // File path / Dosya yolu
+	Size   int64  `json:"size"`   // Size in bytes / Bayt cinsinden boyut
+	SHA256 string `json:"sha256"` // Hex SHA-256 / Onaltılık SHA-256
+	CRC32  string `json:"crc32"`  // Hex CRC32 as used by SFV / SFV'nin kullandığı onaltılık CRC32
+}
+
+// ChecksumManifest struct
+// Represents the manifest written next to an output
+// Bir çıktının yanına yazılan bildirimi temsil eder
+type ChecksumManifest struct {
+	Source    *FileChecksum `json:"source,omitempty"` // Source, missing when it was streamed / Kaynak, akışla okunduysa yok
+	Output    FileChecksum  `json:"output"`           // Converted file / Dönüştürülmüş dosya
+	CreatedAt time.Time     `json:"createdAt"`        // Creation time / Oluşturulma zamanı
+}
+
+// checksumFile computes SHA-256 and CRC32 of a file in one pass
+// Bir dosyanın SHA-256 ve CRC32 değerlerini tek geçişte hesaplar
+func checksumFile(path string) (FileChecksum, error) {
+	sum := FileChecksum{Path: path}
+	file, err := os.Open(path)
+	if err != nil {
+		return sum, err
+	}
+	defer file.Close()
+
+	sha, crc := sha256.New(), crc32.NewIEEE()
+	if sum.Size, err = io.Copy(io.MultiWriter(sha, crc), file); err != nil {
+		return sum, err
+	}
+	sum.SHA256 = hex.EncodeToString(sha.Sum(nil))
+	sum.CRC32 = fmt.Sprintf("%08X", crc.Sum32())
+	return sum, nil
+}
+
+// writeChecksums writes the manifest of an output and adds it to the batch list if requested
+// Bir çıktının bildirimini yazar ve istenirse toplu listeye ekler
+func (a *App) writeChecksums(settings ConversionSettings, source *FileChecksum, outputPath string) error {
+	output, err := checksumFile(outputPath)
+	if err != nil {
+		return fmt.Errorf("failed to hash %s: %v", outputPath, err)
+	}
+
+	switch settings.ChecksumManifest {
+	case "json":
+		manifest := ChecksumManifest{Source: source, Output: output, CreatedAt: time.Now()}
+		data, err := json.MarshalIndent(manifest, "", "  ")
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(outputPath+".manifest.json", data, 0644); err != nil {
+			return fmt.Errorf("failed to write manifest: %v", err)
+		}
+	case "sfv":
+		sfvPath := strings.TrimSuffix(outputPath, filepath.Ext(outputPath)) + ".sfv"
+		content := fmt.Sprintf("; Generated by AV1 Video Converter on %s\n%s %s\n",
+			time.Now().Format("2006-01-02 15:04:05"), filepath.Base(outputPath), output.CRC32)
+		if err := os.WriteFile(sfvPath, []byte(content), 0644); err != nil {
+			return fmt.Errorf("failed to write SFV: %v", err)
+		}
+	}
+
+	// Concurrent jobs may finish into the same folder, the list is appended under a lock
+	// Eşzamanlı işler aynı klasöre bitebilir, listeye bir kilit altında eklenir
+	if settings.ChecksumBatch {
+		a.checksumMu.Lock()
+		defer a.checksumMu.Unlock()
+		listPath := filepath.Join(filepath.Dir(outputPath), batchChecksumFile)
+		file, err := os.OpenFile(listPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			return fmt.Errorf("failed to open %s: %v", listPath, err)
+		}
+		defer file.Close()
+		if _, err := fmt.Fprintf(file, "%s  %s\n", output.SHA256, filepath.Base(outputPath)); err != nil {
+			return fmt.Errorf("failed to write %s: %v", listPath, err)
+		}
+	}
+	log.Printf("SHA-256 of %s: %s", outputPath, output.SHA256)
+	return nil
+}
@@ -0,0 +1,263 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"AV1-video-converter/internal/command"
+	"AV1-video-converter/internal/events"
+	"AV1-video-converter/internal/progress"
+	"AV1-video-converter/internal/timecode"
+)
+
+// maxClipSeconds limits clip exports to snippets, longer ranges belong in a normal job
+// Klip dışa aktarımlarını kısa parçalarla sınırlar, daha uzun aralıklar normal bir işe aittir
+const maxClipSeconds = 300
+
+// clipFormats lists the clip export formats
+// Klip dışa aktarma biçimlerini listeler
+var clipFormats = []string{"av1-webm", "vp9-webm", "avif"}
+
+// ClipExport struct
+// Represents a short range of a video exported for sharing
+// Paylaşmak için dışa aktarılan bir videonun kısa bir aralığını temsil eder
+type ClipExport struct {
+	Start       string `json:"start"`       // Range start as a timecode or seconds / Zaman kodu veya saniye olarak aralık başı
+	End         string `json:"end"`         // Range end as a timecode or seconds / Zaman kodu veya saniye olarak aralık sonu
+	Format      string `json:"format"`      // av1-webm, vp9-webm or avif (animated, no audio) / av1-webm, vp9-webm veya avif (hareketli, sessiz)
+	MaxFileSize int    `json:"maxFileSize"` // Target maximum size in MB, 0 for none / MB cinsinden en büyük boyut hedefi, 0 sınırsız
+	MaxWidth    int    `json:"maxWidth"`    // Maximum width, 0 for 720 (480 for AVIF) / En büyük genişlik, 0 ise 720 (AVIF için 480)
+	FrameRate   int    `json:"frameRate"`   // Output frame rate, 0 keeps the source (15 for AVIF) / Çıktı kare hızı, 0 kaynağı korur (AVIF için 15)
+	Audio       bool   `json:"audio"`       // Keep the audio, WebM only / Sesi koru, yalnızca WebM
+}
+
+// clipRange is a clip export resolved against its source
+// Kaynağına göre çözümlenmiş bir klip dışa aktarımı
+type clipRange struct {
+	start, length float64
+	frameRate     float64
+}
+
+// AddClipJob queues the export of a time range as a small WebM or animated AVIF
+// The clip goes through the queue like any job and ends up next to the other outputs
+// Klip her iş gibi kuyruktan geçer ve diğer çıktıların yanına yazılır
+func (a *App) AddClipJob(inputPath, outputFolder string, clip ClipExport) (Job, error) {
+	if isRemoteDestination(outputFolder) {
+		return Job{}, fmt.Errorf("clips are exported to a local folder")
+	}
+	info, err := a.getVideoInfo(inputPath)
+	if err != nil {
+		return Job{}, fmt.Errorf("failed to probe input: %v", err)
+	}
+	if info.AudioOnly {
+		return Job{}, fmt.Errorf("%s has no video to export a clip from", inputPath)
+	}
+	r, err := resolveClip(clip, info)
+	if err != nil {
+		return Job{}, err
+	}
+
+	job, err := a.addJob(inputPath, outputFolder, int(r.length*r.frameRate), a.GetSettings())
+	if err != nil {
+		return Job{}, err
+	}
+	a.updateJob(job.ID, func(job *Job) {
+		job.Clip = &clip
+	})
+	job.Clip = &clip
+	log.Printf("Job %s exports %ss of %s as %s", job.ID, formatSeconds(r.length), inputPath, clip.Format)
+	a.emitJobEvent(events.JobAdded, job.ID, JobAddedEvent{Job: job, Video: &info})
+	a.emitQueueUpdated()
+	return job, nil
+}
+
+// resolveClip checks a clip export and reads its range in seconds
+// Bir klip dışa aktarımını kontrol eder ve aralığını saniye olarak okur
+func resolveClip(clip ClipExport, info VideoInfo) (clipRange, error) {
+	if !containsString(clipFormats, clip.Format) {
+		return clipRange{}, fmt.Errorf("clip format must be one of %s", strings.Join(clipFormats, ", "))
+	}
+	if clip.MaxFileSize < 0 || clip.MaxWidth < 0 || clip.FrameRate < 0 {
+		return clipRange{}, fmt.Errorf("clip size, width and frame rate must not be negative")
+	}
+	start, err := timecode.Parse(clip.Start, info.FrameRate)
+	if err != nil {
+		return clipRange{}, fmt.Errorf("invalid clip start: %v", err)
+	}
+	end, err := timecode.Parse(clip.End, info.FrameRate)
+	if err != nil {
+		return clipRange{}, fmt.Errorf("invalid clip end: %v", err)
+	}
+	if end <= start {
+		return clipRange{}, fmt.Errorf("clip end must be after its start")
+	}
+	if info.DurationSeconds > 0 && start >= info.DurationSeconds {
+		return clipRange{}, fmt.Errorf("clip starts after the end of the video (%ss)", formatSeconds(info.DurationSeconds))
+	}
+	if info.DurationSeconds > 0 && end > info.DurationSeconds {
+		end = info.DurationSeconds
+	}
+	if end-start > maxClipSeconds {
+		return clipRange{}, fmt.Errorf("clips are limited to %d seconds, use a normal job for longer ranges", maxClipSeconds)
+	}
+
+	rate := info.FrameRate
+	if clip.FrameRate > 0 {
+		rate = float64(clip.FrameRate)
+	} else if clip.Format == "avif" {
+		rate = 15
+	}
+	return clipRange{start: start, length: end - start, frameRate: rate}, nil
+}
+
+// exportClip encodes the range of a clip job
+// Bir klip işinin aralığını kodlar
+func (a *App) exportClip(ctx context.Context, job Job, onStall func()) error {
+	info, err := a.getVideoInfo(job.InputPath)
+	if err != nil {
+		return fmt.Errorf("failed to probe input: %v", err)
+	}
+	r, err := resolveClip(*job.Clip, info)
+	if err != nil {
+		return err
+	}
+
+	baseName := sanitizeFileName(strings.TrimSuffix(inputBaseName(job.InputPath), filepath.Ext(inputBaseName(job.InputPath))))
+	extension := "webm"
+	if job.Clip.Format == "avif" {
+		extension = "avif"
+	}
+	outputPath := filepath.Join(job.OutputFolder, fmt.Sprintf("%s_clip_%d-%d.%s", baseName, int(r.start), int(r.start+r.length), extension))
+	if err := os.MkdirAll(job.OutputFolder, os.ModePerm); err != nil {
+		return fmt.Errorf("failed to create output directory: %v", err)
+	}
+	outputPath = a.claimOutputPaths(job.ID, []string{outputPath})[0]
+
+	logFilePath, err := a.jobLogPath(job.ID, baseName+"_clip_ffmpeg.log")
+	if err != nil {
+		return fmt.Errorf("failed to create log folder: %v", err)
+	}
+	logFile, err := os.Create(logFilePath)
+	if err != nil {
+		return fmt.Errorf("failed to create log file: %v", err)
+	}
+	defer logFile.Close()
+
+	entry := HistoryEntry{
+		JobID:      job.ID,
+		InputPath:  job.InputPath,
+		OutputPath: outputPath,
+		Settings:   job.Settings,
+		Duration:   r.length,
+		StartedAt:  time.Now(),
+		LogPath:    logFilePath,
+	}
+
+	endPhase := a.startPhase(job.ID, "encode")
+	ffmpeg := a.newFFmpeg(logFile)
+	if err := ffmpeg.Start(ctx, clipArgs(job.InputPath, outputPath, *job.Clip, r)); err != nil {
+		endPhase()
+		return fmt.Errorf("failed to start FFmpeg: %v", err)
+	}
+	progressCtx, stopProgress := context.WithCancel(ctx)
+	monitorDone := make(chan struct{})
+	go func() {
+		defer close(monitorDone)
+		defer a.recoverCrash("monitorProgress")
+		a.monitorProgress(progressCtx, job.ID, "encode", ffmpeg, progress.Target{Seconds: r.length}, onStall)
+	}()
+	err = ffmpeg.Wait()
+	stopProgress()
+	<-monitorDone
+	endPhase()
+	a.addCPUTime(job.ID, ffmpeg.CPUTime())
+
+	if err != nil {
+		if ctx.Err() != nil {
+			err = fmt.Errorf("conversion cancelled: %w", context.Cause(ctx))
+		} else {
+			err = ffmpegExitError(err, logFilePath)
+		}
+		a.discardPartialOutput(outputPath)
+		entry.Status, entry.Error, entry.FinishedAt = "failed", err.Error(), time.Now()
+		a.addHistoryEntry(entry)
+		return fmt.Errorf("FFmpeg error: %w", err)
+	}
+	a.emitProgress(job.ID, "encode", 100, "")
+
+	// The size target steers the bitrate, a clip that still overshoots is kept but reported
+	// Boyut hedefi bit hızını yönlendirir, yine de aşan bir klip tutulur ama bildirilir
+	if limit := int64(job.Clip.MaxFileSize) * 1024 * 1024; limit > 0 && fileSize(outputPath) > limit {
+		message := fmt.Sprintf("clip is %.1f MB, over the %d MB target", float64(fileSize(outputPath))/1024/1024, job.Clip.MaxFileSize)
+		log.Printf("Job %s: %s", job.ID, message)
+		a.addJobEvent(job.ID, "clip", message)
+		a.emitJobEvent(events.JobWarning, job.ID, JobWarningEvent{Message: message})
+	}
+
+	entry.Status, entry.FinishedAt = "completed", time.Now()
+	a.addHistoryEntry(entry)
+	log.Printf("Clip exported: %s", outputPath)
+	a.emitJobEvent(events.JobCompleted, job.ID, JobCompletedEvent{
+		OutputPath:  outputPath,
+		OutputPaths: []string{outputPath},
+	})
+	return nil
+}
+
+// clipArgs builds the FFmpeg arguments of a clip export
+// Seeking before the input keeps long sources fast, the range is cut by duration
+// Girişten önce konumlanmak uzun kaynakları hızlı tutar, aralık süreyle kesilir
+func clipArgs(inputPath, outputPath string, clip ClipExport, r clipRange) []string {
+	args := []string{"-y", "-ss", formatSeconds(r.start)}
+	args = append(args, inputArgs(inputPath)...)
+	args = append(args, "-t", formatSeconds(r.length), "-map", "0:v:0")
+
+	maxWidth := clip.MaxWidth
+	if maxWidth == 0 {
+		maxWidth = 720
+		if clip.Format == "avif" {
+			maxWidth = 480
+		}
+	}
+	filters := []string{fmt.Sprintf("scale='min(%d,iw)':-2", maxWidth)}
+	if clip.FrameRate > 0 || clip.Format == "avif" {
+		filters = append(filters, "fps="+strconv.FormatFloat(r.frameRate, 'f', -1, 64))
+	}
+	args = append(args, "-vf", strings.Join(filters, ","), "-pix_fmt", "yuv420p")
+
+	audio := clip.Audio && clip.Format != "avif"
+	audioBitrate := 0
+	if audio {
+		audioBitrate = 96
+	}
+	maxRate := command.BitrateCap(command.BitrateLimit{MaxFileSize: clip.MaxFileSize, AudioBitrate: audioBitrate}, r.length)
+
+	switch clip.Format {
+	case "vp9-webm":
+		// Constrained quality: the CRF decides unless the size target is tighter
+		// Kısıtlı kalite: boyut hedefi daha sıkı değilse CRF belirler
+		args = append(args, "-c:v", "libvpx-vp9", "-crf", "33", "-b:v", strconv.Itoa(maxRate)+"k",
+			"-deadline", "good", "-cpu-used", "2", "-row-mt", "1")
+	default:
+		args = append(args, "-c:v", "libsvtav1", "-crf", "35", "-preset", "8")
+		if maxRate > 0 {
+			args = append(args, "-maxrate", strconv.Itoa(maxRate)+"k", "-bufsize", strconv.Itoa(maxRate*2)+"k")
+		}
+	}
+
+	if audio {
+		args = append(args, "-map", "0:a:0?", "-c:a", "libopus", "-b:a", strconv.Itoa(audioBitrate)+"k")
+	} else {
+		args = append(args, "-an")
+	}
+	if clip.Format == "avif" {
+		args = append(args, "-loop", "0")
+	}
+	return append(args, "-sn", "-dn", "-map_metadata", "-1", outputPath)
+}
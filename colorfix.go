@@ -0,0 +1,137 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+// Values accepted by the color overrides, named the way FFmpeg tags them
+// Renk geçersiz kılmalarının kabul ettiği değerler, FFmpeg'in etiketlediği gibi adlandırılır
+var (
+	colorRanges    = []string{"tv", "pc"}
+	colorPrimaries = []string{"bt709", "bt470bg", "smpte170m", "bt2020", "smpte432"}
+	colorTransfers = []string{"bt709", "smpte170m", "iec61966-2-1", "bt2020-10", "smpte2084", "arib-std-b67"}
+	colorMatrices  = []string{"bt709", "bt470bg", "smpte170m", "bt2020nc"}
+)
+
+// ColorOverrides struct
+// Represents the real colorimetry of a mistagged source, empty fields keep the source tags
+// Yanlış etiketlenmiş bir kaynağın gerçek renk bilgisini temsil eder, boş alanlar kaynak etiketlerini korur
+type ColorOverrides struct {
+	Range     string `json:"range"`     // tv (limited) or pc (full) / tv (sınırlı) veya pc (tam)
+	Primaries string `json:"primaries"` // Color primaries, e.g. bt709 / Renk primerleri, örn. bt709
+	Transfer  string `json:"transfer"`  // Transfer characteristics, e.g. bt709 / Aktarım özellikleri, örn. bt709
+	Matrix    string `json:"matrix"`    // Matrix coefficients, e.g. bt709 / Matris katsayıları, örn. bt709
+}
+
+// empty reports whether no override is set
+// Hiçbir geçersiz kılmanın ayarlanmadığını bildirir
+func (o ColorOverrides) empty() bool {
+	return o == ColorOverrides{}
+}
+
+// SetJobColorOverrides corrects the color tags of a queued job's source
+// The frames are re-tagged before filtering and the output carries the corrected tags
+// Kareler filtrelemeden önce yeniden etiketlenir ve çıktı düzeltilmiş etiketleri taşır
+func (a *App) SetJobColorOverrides(jobID string, overrides ColorOverrides) error {
+	if errs := validateColorOverrides(overrides); len(errs) > 0 {
+		return errs
+	}
+	job, ok := a.getJob(jobID)
+	if !ok {
+		return fmt.Errorf("unknown job: %s", jobID)
+	}
+	if job.Status == "running" {
+		return fmt.Errorf("job %s is running", jobID)
+	}
+	if job.AudioOnly {
+		return fmt.Errorf("job %s has no video to correct", jobID)
+	}
+	a.updateJob(jobID, func(job *Job) {
+		job.Settings.ColorOverrides = overrides
+		for i := range job.Renditions {
+			job.Renditions[i].Settings.ColorOverrides = overrides
+		}
+	})
+	log.Printf("Job %s color overrides set to %+v", jobID, overrides)
+	return nil
+}
+
+// validateColorOverrides checks every override against the values FFmpeg knows
+// Her geçersiz kılmayı FFmpeg'in bildiği değerlere göre kontrol eder
+func validateColorOverrides(overrides ColorOverrides) ValidationErrors {
+	var errs ValidationErrors
+	for _, override := range []struct {
+		field, value string
+		values       []string
+	}{
+		{"colorOverrides.range", overrides.Range, colorRanges},
+		{"colorOverrides.primaries", overrides.Primaries, colorPrimaries},
+		{"colorOverrides.transfer", overrides.Transfer, colorTransfers},
+		{"colorOverrides.matrix", overrides.Matrix, colorMatrices},
+	} {
+		if override.value != "" && !containsString(override.values, override.value) {
+			errs = append(errs, ValidationError{
+				Field:   override.field,
+				Value:   override.value,
+				Message: fmt.Sprintf("must be one of %s", strings.Join(override.values, ", ")),
+			})
+		}
+	}
+	return errs
+}
+
+// colorOverrideFilters re-tags the decoded frames and expands full range to limited
+// Archival outputs are only re-tagged, converting the range would change their pixels
+// Arşiv çıktıları yalnızca yeniden etiketlenir, aralığı dönüştürmek piksellerini değiştirirdi
+func colorOverrideFilters(settings ConversionSettings) []string {
+	overrides := settings.ColorOverrides
+	if overrides.empty() {
+		return nil
+	}
+	var params []string
+	for _, param := range []struct{ name, value string }{
+		{"range", overrides.Range},
+		{"color_primaries", overrides.Primaries},
+		{"color_trc", overrides.Transfer},
+		{"colorspace", overrides.Matrix},
+	} {
+		if param.value != "" {
+			params = append(params, param.name+"="+param.value)
+		}
+	}
+	filters := []string{"setparams=" + strings.Join(params, ":")}
+	if convertsColorRange(settings) {
+		filters = append(filters, "scale=in_range=pc:out_range=tv")
+	}
+	return filters
+}
+
+// convertsColorRange reports whether full range frames are converted to limited range
+// Tam aralıklı karelerin sınırlı aralığa dönüştürülüp dönüştürülmediğini bildirir
+func convertsColorRange(settings ConversionSettings) bool {
+	return settings.ColorOverrides.Range == "pc" && settings.Archival == ""
+}
+
+// outputColor returns the colorimetry the output is tagged with
+// Çıktının etiketlendiği renk bilgisini döndürür
+func outputColor(video VideoInfo, settings ConversionSettings) VideoInfo {
+	overrides := settings.ColorOverrides
+	if overrides.Range != "" {
+		video.ColorRange = overrides.Range
+	}
+	if overrides.Primaries != "" {
+		video.ColorPrimaries = overrides.Primaries
+	}
+	if overrides.Transfer != "" {
+		video.ColorTransfer = overrides.Transfer
+	}
+	if overrides.Matrix != "" {
+		video.ColorSpace = overrides.Matrix
+	}
+	if convertsColorRange(settings) {
+		video.ColorRange = "tv"
+	}
+	return video
+}
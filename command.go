@@ -0,0 +1,113 @@
+package main
+
+import "AV1-video-converter/internal/command"
+
+// conversionPlan struct
+// Represents everything needed to build the FFmpeg command of a conversion
+// Bir dönüştürmenin FFmpeg komutunu oluşturmak için gereken her şeyi temsil eder
+type conversionPlan struct {
+	InputPath   string       // Source file / Kaynak dosya
+	Outputs     []planOutput // Files produced from the single decode / Tek kod çözümden üretilen dosyalar
+	Video       VideoInfo    // Probed source information / İncelenen kaynak bilgisi
+	Streams     []StreamInfo // Streams of the source / Kaynağın akışları
+	ZoneVideo   string       // Concat list of zone encoded video, if any / Varsa bölge kodlu videonun concat listesi
+	AudioOnly   bool         // Encode only the audio with the music settings / Yalnızca sesi müzik ayarlarıyla kodla
+	AudioOffset int          // Audio delay in milliseconds, negative advances it / Milisaniye cinsinden ses gecikmesi, negatif öne alır
+}
+
+// planOutput struct
+// Represents one output file of a conversion
+// Bir dönüştürmenin tek bir çıktı dosyasını temsil eder
+type planOutput struct {
+	Name     string             // Rendition name, empty for a single output / Sürüm adı, tek çıktıda boş
+	Path     string             // File FFmpeg writes / FFmpeg'in yazdığı dosya
+	Settings ConversionSettings // Settings used / Kullanılan ayarlar
+	Record   ConversionRecord   // Metadata for the output / Çıktı için meta veri
+
+	Destination string // Final file when Path is staged locally / Path yerel olarak hazırlanıyorsa son dosya
+}
+
+// finalPath returns where the output ends up once the conversion is done
+// Dönüştürme bittiğinde çıktının varacağı yeri döndürür
+func (output planOutput) finalPath() string {
+	if output.Destination != "" {
+		return output.Destination
+	}
+	return output.Path
+}
+
+// buildFFmpegArgs assembles the FFmpeg arguments for a conversion
+// The source is decoded once and every output gets its own options
+// Kaynak bir kez çözülür ve her çıktı kendi seçeneklerini alır
+func buildFFmpegArgs(plan conversionPlan) []string {
+	outputs := make([][]string, 0, len(plan.Outputs))
+	for _, output := range plan.Outputs {
+		outputs = append(outputs, outputArgs(plan, output))
+	}
+	return command.Build(plan.input(), outputs)
+}
+
+// input returns the inputs of the plan
+// Planın girdilerini döndürür
+func (plan conversionPlan) input() command.Input {
+	return command.Input{
+		Args:        inputArgs(plan.InputPath),
+		ZoneVideo:   plan.ZoneVideo,
+		AudioOffset: plan.AudioOffset,
+	}
+}
+
+// outputArgs assembles the options of a single output
+// Combines stream, video, audio and container options for the output file
+// Çıktı dosyası için akış, video, ses ve kapsayıcı seçeneklerini birleştirir
+func outputArgs(plan conversionPlan, output planOutput) []string {
+	settings := output.Settings
+	if plan.AudioOnly {
+		return musicOutputArgs(plan, output)
+	}
+	options := command.Output{
+		Path:      output.Path,
+		Container: settings.Container,
+		Metadata:  metadataArgs(output.Record),
+	}
+
+	// Zone encoded video only needs to be muxed
+	// Bölge kodlu videonun yalnızca birleştirilmesi gerekir
+	if plan.ZoneVideo != "" {
+		options.Streams = command.StreamArgs(streamOptions(settings), plan.Streams, "1:v:0", plan.input().AudioInput())
+		options.CopyVideo = true
+		return command.OutputArgs(options)
+	}
+
+	options.Streams = command.StreamArgs(streamOptions(settings), plan.Streams, "", plan.input().AudioInput())
+	options.Encoder = encoderArgs(settings)
+	if settings.Archival != "" || !settings.ColorOverrides.empty() {
+		options.Color = colorArgs(outputColor(plan.Video, settings))
+	}
+	options.Filters = videoFilters(settings)
+	options.MaxRate = videoBitrateCap(settings, plan.Video.DurationSeconds)
+	return command.OutputArgs(options)
+}
+
+// videoFilters returns the video filter chain for the settings
+// Ayarlar için video filtre zincirini döndürür
+func videoFilters(settings ConversionSettings) []string {
+	filters := colorOverrideFilters(settings)
+	if settings.InverseTelecine {
+		filters = append(filters, inverseTelecineFilter)
+	}
+	if filter := command.ScaleFilter(settings.MaxWidth, settings.MaxHeight); filter != "" {
+		filters = append(filters, filter)
+	}
+	return filters
+}
+
+// videoBitrateCap returns the maximum video bitrate of the settings in kbps, 0 for none
+// Ayarların kbps cinsinden en yüksek video bit hızını döndürür, 0 yok
+func videoBitrateCap(settings ConversionSettings, durationSeconds float64) int {
+	return command.BitrateCap(command.BitrateLimit{
+		MaxBitrate:   settings.MaxBitrate,
+		MaxFileSize:  settings.MaxFileSize,
+		AudioBitrate: settings.AudioBitrate,
+	}, durationSeconds)
+}
@@ -0,0 +1,367 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"AV1-video-converter/internal/events"
+	"AV1-video-converter/internal/probe"
+	"AV1-video-converter/internal/progress"
+	"AV1-video-converter/internal/runner"
+)
+
+// fakeProber describes the test input as a 100 frame, 4 second H.264 video and fails for everything else
+// Test girdisini 100 karelik, 4 saniyelik bir H.264 videosu olarak tanımlar ve diğer her şey için hata verir
+type fakeProber struct {
+	input string
+}
+
+func (p fakeProber) Probe(ctx context.Context, path string) (probe.Result, error) {
+	if path != p.input {
+		return probe.Result{}, errors.New("no such file")
+	}
+	var result probe.Result
+	result.Streams = []probe.Stream{{
+		CodecName:    "h264",
+		CodecType:    "video",
+		Width:        1920,
+		Height:       1080,
+		PixFmt:       "yuv420p",
+		AvgFrameRate: "25/1",
+		NbFrames:     "100",
+	}}
+	result.Format.Duration = "4.000000"
+	result.Format.Size = "1000000"
+	return result, nil
+}
+
+// outputFake is a fake FFmpeg that writes the output file FFmpeg would have made before playing back
+// Oynatmadan önce FFmpeg'in oluşturacağı çıktı dosyasını yazan sahte bir FFmpeg'dir
+type outputFake struct {
+	*runner.Fake
+	started chan<- struct{}
+}
+
+func (f outputFake) Start(ctx context.Context, args []string) error {
+	if f.StartErr == nil && len(args) > 0 {
+		os.WriteFile(args[len(args)-1], []byte("av1"), 0644)
+	}
+	err := f.Fake.Start(ctx, args)
+	f.started <- struct{}{}
+	return err
+}
+
+// eventRecorder keeps the envelopes of every job event the app emits
+// Uygulamanın yaydığı her iş olayının zarfını tutar
+type eventRecorder struct {
+	mu        sync.Mutex
+	envelopes []events.Envelope
+}
+
+func (r *eventRecorder) Emit(name string, data interface{}) {
+	if envelope, ok := data.(events.Envelope); ok {
+		r.mu.Lock()
+		r.envelopes = append(r.envelopes, envelope)
+		r.mu.Unlock()
+	}
+}
+
+// of returns the recorded events of the given type
+// Verilen türdeki kaydedilmiş olayları döndürür
+func (r *eventRecorder) of(kind string) []events.Envelope {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var list []events.Envelope
+	for _, envelope := range r.envelopes {
+		if envelope.Type == kind {
+			list = append(list, envelope)
+		}
+	}
+	return list
+}
+
+// testApp is an app with a queued job that runs fakes instead of FFmpeg
+// FFmpeg yerine sahteleri çalıştıran, kuyrukta bir işi olan bir uygulamadır
+type testApp struct {
+	*App
+	recorder *eventRecorder
+	jobID    string
+	started  chan struct{} // Receives once per started FFmpeg / Başlatılan her FFmpeg için bir kez alır
+}
+
+// newTestApp returns a test app that runs the given fakes in order
+// Verilen sahteleri sırayla çalıştıran bir test uygulaması döndürür
+func newTestApp(t *testing.T, fakes ...*runner.Fake) testApp {
+	t.Helper()
+	dir := t.TempDir()
+	input := filepath.Join(dir, "input.mp4")
+	if err := os.WriteFile(input, []byte("h264"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	a := NewApp()
+	a.appDir = dir
+	a.historyPath = filepath.Join(dir, "history.json")
+	a.prober = fakeProber{input: input}
+	recorder := &eventRecorder{}
+	a.events = recorder
+
+	started := make(chan struct{}, len(fakes)+1)
+	var mu sync.Mutex
+	a.newFFmpeg = func(io.Writer) runner.FFmpegRunner {
+		mu.Lock()
+		defer mu.Unlock()
+		if len(fakes) == 0 {
+			t.Error("FFmpeg started more often than expected")
+			return outputFake{&runner.Fake{StartErr: errors.New("no fake left")}, started}
+		}
+		fake := fakes[0]
+		fakes = fakes[1:]
+		return outputFake{fake, started}
+	}
+
+	job, err := a.addJob(input, filepath.Join(dir, "out"), 100, a.GetSettings())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return testApp{App: a, recorder: recorder, jobID: job.ID, started: started}
+}
+
+// frames returns one status update per given frame number
+// Verilen her kare numarası için bir durum güncellemesi döndürür
+func frames(numbers ...int) []progress.Status {
+	var statuses []progress.Status
+	for _, n := range numbers {
+		statuses = append(statuses, progress.Status{Frame: n, HasFrame: true, Time: float64(n) / 25, HasTime: true})
+	}
+	return statuses
+}
+
+// lastHistory returns the newest history entry
+// En yeni geçmiş kaydını döndürür
+func lastHistory(t *testing.T, a *App) HistoryEntry {
+	t.Helper()
+	history, err := a.GetHistory()
+	if err != nil {
+		t.Fatalf("GetHistory: %v", err)
+	}
+	if len(history) == 0 {
+		t.Fatal("no history entry was written")
+	}
+	return history[len(history)-1]
+}
+
+// TestConvertProgress reports rising progress up to 100% and completes the job
+// Yüzde 100'e kadar artan ilerleme bildirir ve işi tamamlar
+func TestConvertProgress(t *testing.T) {
+	fake := &runner.Fake{Statuses: frames(25, 50, 75, 100), Interval: 300 * time.Millisecond}
+	a := newTestApp(t, fake)
+
+	if err := a.ConvertVideo(a.jobID); err != nil {
+		t.Fatalf("ConvertVideo: %v", err)
+	}
+	if job, _ := a.getJob(a.jobID); job.Status != "completed" {
+		t.Errorf("status = %s (%s), want completed", job.Status, job.Error)
+	}
+
+	updates := a.recorder.of(events.JobProgress)
+	if len(updates) < 2 {
+		t.Fatalf("got %d progress events, want several", len(updates))
+	}
+	previous := -1.0
+	for _, envelope := range updates {
+		percent := envelope.Data.(JobProgressEvent).Percent
+		if percent < previous {
+			t.Errorf("progress went back from %v to %v", previous, percent)
+		}
+		previous = percent
+	}
+	if previous != 100 {
+		t.Errorf("last progress = %v, want 100", previous)
+	}
+	entry := lastHistory(t, a.App)
+	if entry.Status != "completed" {
+		t.Errorf("history status = %s, want completed", entry.Status)
+	}
+	if _, err := os.Stat(entry.OutputPath); err != nil {
+		t.Errorf("output missing: %v", err)
+	}
+}
+
+// TestConvertCancel stops FFmpeg when the user cancels and records the job as failed
+// Kullanıcı iptal ettiğinde FFmpeg'i durdurur ve işi başarısız olarak kaydeder
+func TestConvertCancel(t *testing.T) {
+	fake := &runner.Fake{Statuses: frames(10, 20, 30), Interval: time.Hour}
+	a := newTestApp(t, fake)
+
+	done := make(chan error, 1)
+	go func() { done <- a.ConvertVideo(a.jobID) }()
+	select {
+	case <-a.started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("FFmpeg was never started")
+	}
+	if err := a.CancelConversion(a.jobID); err != nil {
+		t.Fatalf("CancelConversion: %v", err)
+	}
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, errCancelledByUser) {
+			t.Errorf("ConvertVideo = %v, want a user cancellation", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("ConvertVideo didn't return after cancelling")
+	}
+	if job, _ := a.getJob(a.jobID); job.Status != "failed" {
+		t.Errorf("status = %s, want failed", job.Status)
+	}
+	if entry := lastHistory(t, a.App); entry.Status != "failed" {
+		t.Errorf("history status = %s, want failed", entry.Status)
+	}
+}
+
+// TestShutdownWaitsForJobs lets a cancelled job record its result before shutdown returns
+// Kapanış dönmeden önce iptal edilen bir işin sonucunu kaydetmesine izin verir
+func TestShutdownWaitsForJobs(t *testing.T) {
+	fake := &runner.Fake{Statuses: frames(10, 20, 30), Interval: time.Hour}
+	a := newTestApp(t, fake)
+	a.appCtx, a.appCancel = context.WithCancelCause(context.Background())
+
+	done := make(chan error, 1)
+	go func() { done <- a.ConvertVideo(a.jobID) }()
+	select {
+	case <-a.started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("FFmpeg was never started")
+	}
+	a.shutdown(context.Background())
+
+	if entry := lastHistory(t, a.App); entry.Status != "failed" {
+		t.Errorf("history status = %s, want failed", entry.Status)
+	}
+	select {
+	case err := <-done:
+		if !errors.Is(err, errShuttingDown) {
+			t.Errorf("ConvertVideo = %v, want a shutdown cancellation", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("ConvertVideo didn't return after shutdown")
+	}
+}
+
+// TestConvertStall restarts an attempt that stops reporting progress and fails once the retries run out
+// İlerleme bildirmeyi bırakan bir denemeyi yeniden başlatır ve yeniden denemeler bitince başarısız olur
+func TestConvertStall(t *testing.T) {
+	defer func(unit time.Duration) { stallTimeoutUnit = unit }(stallTimeoutUnit)
+	stallTimeoutUnit = 200 * time.Millisecond
+
+	first := &runner.Fake{Statuses: frames(10), Interval: time.Hour}
+	second := &runner.Fake{Statuses: frames(10), Interval: time.Hour}
+	a := newTestApp(t, first, second)
+	a.updatePreferences(func(preferences *AppPreferences) {
+		preferences.StallTimeout, preferences.StallRecovery, preferences.StallRetries = 1, true, 1
+	})
+
+	err := a.ConvertVideo(a.jobID)
+	if !errors.Is(err, errStalled) {
+		t.Fatalf("ConvertVideo = %v, want a stall", err)
+	}
+	if got := len(a.recorder.of(events.JobStalled)); got != 2 {
+		t.Errorf("got %d stall events, want 2", got)
+	}
+	retries := a.recorder.of(events.JobRetry)
+	if len(retries) != 1 || retries[0].Data.(JobRetryEvent).Reason != "stalled" {
+		t.Errorf("retry events = %+v, want one stalled retry", retries)
+	}
+	if got := len(a.started); got != 2 {
+		t.Errorf("FFmpeg started %d times, want 2", got)
+	}
+	if job, _ := a.getJob(a.jobID); job.Status != "failed" {
+		t.Errorf("status = %s, want failed", job.Status)
+	}
+}
+
+// TestConvertExitFailure records a non-zero FFmpeg exit as a failed job and history entry
+// Sıfır olmayan bir FFmpeg çıkışını başarısız bir iş ve geçmiş kaydı olarak kaydeder
+func TestConvertExitFailure(t *testing.T) {
+	fake := &runner.Fake{Statuses: frames(10, 20), ExitErr: errors.New("exit status 1")}
+	a := newTestApp(t, fake)
+
+	err := a.ConvertVideo(a.jobID)
+	if err == nil || !strings.Contains(err.Error(), "exit status 1") {
+		t.Fatalf("ConvertVideo = %v, want the FFmpeg exit error", err)
+	}
+	job, _ := a.getJob(a.jobID)
+	if job.Status != "failed" || job.Error == "" {
+		t.Errorf("job = %s (%q), want failed with an error", job.Status, job.Error)
+	}
+	if got := len(a.recorder.of(events.JobFailed)); got != 1 {
+		t.Errorf("got %d failure events, want 1", got)
+	}
+	entry := lastHistory(t, a.App)
+	if entry.Status != "failed" || !strings.Contains(entry.Error, "exit status 1") {
+		t.Errorf("history entry = %s (%q), want the failure", entry.Status, entry.Error)
+	}
+	if _, err := os.Stat(entry.OutputPath); !os.IsNotExist(err) {
+		t.Errorf("partial output %s was kept", entry.OutputPath)
+	}
+}
+
+// TestConvertAlreadyRunning refuses to start a job a second time while it runs
+// Çalışırken bir işi ikinci kez başlatmayı reddeder
+func TestConvertAlreadyRunning(t *testing.T) {
+	fake := &runner.Fake{Statuses: frames(10), Interval: time.Hour}
+	a := newTestApp(t, fake)
+
+	done := make(chan error, 1)
+	go func() { done <- a.ConvertVideo(a.jobID) }()
+	<-a.started
+	if err := a.ConvertVideo(a.jobID); err == nil || !strings.Contains(err.Error(), "already running") {
+		t.Errorf("second ConvertVideo = %v, want already running", err)
+	}
+	a.CancelConversion(a.jobID)
+	<-done
+}
+
+// TestConvertHardwareFallback runs a failed hardware encode of the balanced mode again with SVT-AV1
+// Dengeli modun başarısız bir donanım kodlamasını SVT-AV1 ile yeniden çalıştırır
+func TestConvertHardwareFallback(t *testing.T) {
+	hardware := &runner.Fake{ExitErr: errors.New("exit status 1")}
+	software := &runner.Fake{Statuses: frames(100)}
+	a := newTestApp(t, hardware, software)
+	encoder := "av1_nvenc"
+	a.hardwareEncoder = &encoder
+	a.updatePreferences(func(preferences *AppPreferences) {
+		preferences.EncoderMode, preferences.BalancedQueueLength = "balanced", 1
+	})
+	job, _ := a.getJob(a.jobID)
+	if _, err := a.addJob(job.InputPath, job.OutputFolder, 100, job.Settings); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.ConvertVideo(a.jobID); err != nil {
+		t.Fatalf("ConvertVideo: %v", err)
+	}
+	if !strings.Contains(strings.Join(hardware.Args, " "), "av1_nvenc") {
+		t.Errorf("first attempt args = %v, want the hardware encoder", hardware.Args)
+	}
+	if !strings.Contains(strings.Join(software.Args, " "), "libsvtav1") {
+		t.Errorf("fallback args = %v, want SVT-AV1", software.Args)
+	}
+	job, _ = a.getJob(a.jobID)
+	if job.Status != "completed" || job.Route == nil || job.Route.Path != "software" || job.Settings.Encoder != "libsvtav1" {
+		t.Errorf("job = %s with route %+v and encoder %s", job.Status, job.Route, job.Settings.Encoder)
+	}
+	retries := a.recorder.of(events.JobRetry)
+	if len(retries) != 1 || retries[0].Data.(JobRetryEvent).Reason != "hardware" {
+		t.Errorf("retry events = %+v, want one hardware fallback", retries)
+	}
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"AV1-video-converter/internal/runner"
+)
+
+// coverArtModes lists where the embedded cover comes from
+// source keeps the cover of the source or falls back to a frame, image uses CoverArtPath, frame extracts one
+// source kaynağın kapağını korur veya bir kareye düşer, image CoverArtPath'i kullanır, frame bir kare çıkarır
+var coverArtModes = []string{"source", "image", "frame"}
+
+// coverFramePosition is where in the video the cover frame is taken, as a fraction of the duration
+// Kapak karesinin videoda alındığı yerdir, sürenin oranı olarak
+const coverFramePosition = 0.1
+
+// embedCoverArt adds a cover picture to a finished output with a stream copy remux
+// Doing it after the encode keeps the encoder options and filters away from the picture
+// Bunu kodlamadan sonra yapmak kodlayıcı seçeneklerini ve filtrelerini resimden uzak tutar
+func (a *App) embedCoverArt(ctx context.Context, plan conversionPlan, output planOutput) error {
+	settings := output.Settings
+	if settings.Container == "webm" {
+		return fmt.Errorf("WebM can't hold cover art")
+	}
+
+	workDir, err := a.makeWorkDir("av1-cover-")
+	if err != nil {
+		return fmt.Errorf("failed to create cover folder: %v", err)
+	}
+	defer os.RemoveAll(workDir)
+
+	coverPath, err := a.coverImage(ctx, plan, settings, workDir)
+	if err != nil {
+		return err
+	}
+
+	// Matroska stores covers as attachments, after the attachments already in the output
+	// Matroska kapakları, çıktıda zaten bulunan eklerin ardından ek olarak saklar
+	tempPath := filepath.Join(filepath.Dir(output.Path), ".cover-"+filepath.Base(output.Path))
+	args := []string{"-hide_banner", "-nostats", "-loglevel", "error", "-y", "-i", output.Path}
+	if settings.Container == "mkv" {
+		attachments := 0
+		for _, stream := range plan.Streams {
+			if stream.Type == "attachment" {
+				attachments++
+			}
+		}
+		mimeType := "image/jpeg"
+		if strings.EqualFold(filepath.Ext(coverPath), ".png") {
+			mimeType = "image/png"
+		}
+		args = append(args, "-map", "0", "-c", "copy",
+			"-attach", coverPath,
+			"-metadata:s:t:"+strconv.Itoa(attachments), "mimetype="+mimeType,
+			"-metadata:s:t:"+strconv.Itoa(attachments), "filename=cover"+strings.ToLower(filepath.Ext(coverPath)))
+	} else {
+		args = append(args, "-i", coverPath,
+			"-map", "0", "-map", "1:v:0", "-c", "copy",
+			"-disposition:v:1", "attached_pic",
+			"-movflags", "+faststart")
+	}
+	args = append(args, tempPath)
+
+	cmd := exec.CommandContext(ctx, a.ffmpegPath, args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := runner.Run(cmd); err != nil {
+		os.Remove(tempPath)
+		return fmt.Errorf("failed to embed cover art: %v: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	if err := os.Rename(tempPath, output.Path); err != nil {
+		os.Remove(tempPath)
+		return fmt.Errorf("failed to replace output with the covered file: %v", err)
+	}
+	return nil
+}
+
+// coverImage returns the picture to embed according to the cover art mode
+// Kapak görseli moduna göre eklenecek resmi döndürür
+func (a *App) coverImage(ctx context.Context, plan conversionPlan, settings ConversionSettings, workDir string) (string, error) {
+	switch settings.CoverArt {
+	case "image":
+		if _, err := os.Stat(settings.CoverArtPath); err != nil {
+			return "", fmt.Errorf("cover image not found: %v", err)
+		}
+		return settings.CoverArtPath, nil
+	case "source":
+		for _, stream := range plan.Streams {
+			if stream.Type != "video" || !stream.AttachedPic {
+				continue
+			}
+			// JPEG and PNG covers are copied as they are, anything else becomes a JPEG
+			// JPEG ve PNG kapaklar olduğu gibi kopyalanır, diğerleri JPEG olur
+			codec, ext := "mjpeg", ".jpg"
+			switch stream.Codec {
+			case "mjpeg":
+				codec = "copy"
+			case "png":
+				codec, ext = "copy", ".png"
+			}
+			coverPath := filepath.Join(workDir, "cover"+ext)
+			if err := a.runCoverFFmpeg(ctx, "-i", plan.InputPath, "-map", fmt.Sprintf("0:%d", stream.Index), "-c:v", codec, "-frames:v", "1", coverPath); err != nil {
+				return "", err
+			}
+			return coverPath, nil
+		}
+		log.Printf("%s has no cover art, using a frame instead", plan.InputPath)
+	}
+
+	coverPath := filepath.Join(workDir, "cover.jpg")
+	position := strconv.FormatFloat(plan.Video.DurationSeconds*coverFramePosition, 'f', 3, 64)
+	if err := a.runCoverFFmpeg(ctx, "-ss", position, "-i", plan.InputPath, "-map", "0:v:0", "-frames:v", "1", "-q:v", "2", coverPath); err != nil {
+		return "", err
+	}
+	return coverPath, nil
+}
+
+// runCoverFFmpeg runs an FFmpeg command that writes a cover picture
+// Bir kapak resmi yazan FFmpeg komutunu çalıştırır
+func (a *App) runCoverFFmpeg(ctx context.Context, args ...string) error {
+	args = append([]string{"-hide_banner", "-nostats", "-loglevel", "error", "-y"}, args...)
+	cmd := exec.CommandContext(ctx, a.ffmpegPath, args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := runner.Run(cmd); err != nil {
+		return fmt.Errorf("failed to get cover picture: %v: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
@@ -0,0 +1,215 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	goruntime "runtime"
+	"runtime/debug"
+	"sort"
+	"strings"
+	"time"
+)
+
+// crashLogLines is the number of app.log lines attached to a crash report
+// Bir çökme raporuna eklenen app.log satırı sayısıdır
+const crashLogLines = 50
+
+// crashIssueURL opens a new issue on the project page
+// Proje sayfasında yeni bir sorun kaydı açar
+const crashIssueURL = "https://github.com/mos1907/AV1-video-converter/issues/new"
+
+// crashSeenSuffix marks a crash report the user has already been told about
+// Kullanıcıya zaten bildirilmiş bir çökme raporunu işaretler
+const crashSeenSuffix = ".seen"
+
+// CrashReport struct
+// Represents a panic recorded to disk
+// Diske kaydedilen bir paniği temsil eder
+type CrashReport struct {
+	ID            string    `json:"id"`            // Report file name / Rapor dosya adı
+	Time          time.Time `json:"time"`          // When the panic happened / Paniğin gerçekleştiği zaman
+	Where         string    `json:"where"`         // Method or goroutine that panicked / Paniğe giren metot veya goroutine
+	Panic         string    `json:"panic"`         // Panic value / Panik değeri
+	Stack         string    `json:"stack"`         // Stack trace / Yığın izi
+	AppVersion    string    `json:"appVersion"`    // Application version / Uygulama sürümü
+	FFmpegVersion string    `json:"ffmpegVersion"` // FFmpeg version / FFmpeg sürümü
+	OS            string    `json:"os"`            // Operating system and architecture / İşletim sistemi ve mimari
+	LogTail       []string  `json:"logTail"`       // Last lines of app.log / app.log dosyasının son satırları
+}
+
+// recoverCrash records a panic instead of letting it kill the application
+// Must be deferred directly: defer a.recoverCrash("name")
+// Doğrudan ertelenmelidir: defer a.recoverCrash("ad")
+func (a *App) recoverCrash(where string) {
+	if r := recover(); r != nil {
+		a.writeCrashReport(where, r, debug.Stack())
+	}
+}
+
+// writeCrashReport saves a crash report and returns its path
+// Bir çökme raporunu kaydeder ve yolunu döndürür
+func (a *App) writeCrashReport(where string, value interface{}, stack []byte) string {
+	log.Printf("Panic in %s: %v\n%s", where, value, stack)
+
+	now := time.Now()
+	report := CrashReport{
+		ID:            fmt.Sprintf("crash-%s.json", now.Format("20060102-150405.000")),
+		Time:          now,
+		Where:         where,
+		Panic:         fmt.Sprint(value),
+		Stack:         string(stack),
+		AppVersion:    appVersion,
+		FFmpegVersion: a.ffmpegVersion,
+		OS:            goruntime.GOOS + "/" + goruntime.GOARCH,
+		LogTail:       appLogTail(filepath.Join(a.crashBaseDir(), "logs", "app.log"), crashLogLines),
+	}
+
+	dir := filepath.Join(a.crashBaseDir(), "crashes")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		log.Printf("Error creating crash folder: %v", err)
+		return ""
+	}
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		log.Printf("Error marshalling crash report: %v", err)
+		return ""
+	}
+	path := filepath.Join(dir, report.ID)
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		log.Printf("Error writing crash report: %v", err)
+		return ""
+	}
+	return path
+}
+
+// GetCrashReports returns the crash reports the user hasn't seen yet
+// The frontend offers to open or submit them on launch
+// Ön yüz açılışta bunları açmayı veya göndermeyi önerir
+func (a *App) GetCrashReports() []CrashReport {
+	paths, err := filepath.Glob(filepath.Join(a.crashBaseDir(), "crashes", "crash-*.json"))
+	if err != nil {
+		log.Printf("Error listing crash reports: %v", err)
+		return nil
+	}
+	sort.Strings(paths)
+
+	var reports []CrashReport
+	for _, path := range paths {
+		report, err := readCrashReport(path)
+		if err != nil {
+			log.Printf("Error reading crash report %s: %v", path, err)
+			continue
+		}
+		reports = append(reports, report)
+	}
+	return reports
+}
+
+// OpenCrashReport opens a crash report with the default application
+// Bir çökme raporunu varsayılan uygulamayla açar
+func (a *App) OpenCrashReport(id string) error {
+	path, err := a.crashReportPath(id)
+	if err != nil {
+		return err
+	}
+	return a.OpenOutputFile(path)
+}
+
+// SubmitCrashReport opens a prefilled issue for a crash report in the browser
+// Nothing is sent without the user reviewing it on the issue page
+// Kullanıcı sorun sayfasında incelemeden hiçbir şey gönderilmez
+func (a *App) SubmitCrashReport(id string) error {
+	path, err := a.crashReportPath(id)
+	if err != nil {
+		return err
+	}
+	report, err := readCrashReport(path)
+	if err != nil {
+		return fmt.Errorf("failed to read crash report: %v", err)
+	}
+
+	// Keep the URL short enough for browsers, the full report stays on disk
+	// URL'yi tarayıcılar için yeterince kısa tut, tam rapor diskte kalır
+	stack := strings.Split(report.Stack, "\n")
+	if len(stack) > 30 {
+		stack = stack[:30]
+	}
+	body := fmt.Sprintf("**Panic:** %s\n**In:** %s\n**Version:** %s, FFmpeg %s, %s\n\n```\n%s\n```\n",
+		report.Panic, report.Where, report.AppVersion, report.FFmpegVersion, report.OS, strings.Join(stack, "\n"))
+	query := url.Values{
+		"title": {"Crash: " + firstLine(report.Panic)},
+		"body":  {body},
+	}
+	if err := a.openBrowser(crashIssueURL + "?" + query.Encode()); err != nil {
+		return err
+	}
+	return a.DismissCrashReport(id)
+}
+
+// DismissCrashReport marks a crash report as seen, it stays on disk
+// Bir çökme raporunu görüldü olarak işaretler, diskte kalır
+func (a *App) DismissCrashReport(id string) error {
+	path, err := a.crashReportPath(id)
+	if err != nil {
+		return err
+	}
+	if err := os.Rename(path, path+crashSeenSuffix); err != nil {
+		return fmt.Errorf("failed to dismiss crash report: %v", err)
+	}
+	return nil
+}
+
+// crashReportPath resolves a report ID without letting it leave the crash folder
+// Bir rapor kimliğini çökme klasöründen çıkmasına izin vermeden çözümler
+func (a *App) crashReportPath(id string) (string, error) {
+	if id != filepath.Base(id) || !strings.HasPrefix(id, "crash-") || !strings.HasSuffix(id, ".json") {
+		return "", fmt.Errorf("invalid crash report: %s", id)
+	}
+	return filepath.Join(a.crashBaseDir(), "crashes", id), nil
+}
+
+// crashBaseDir returns the app folder, even for panics before startup finished
+// Başlangıç bitmeden önceki paniklerde bile uygulama klasörünü döndürür
+func (a *App) crashBaseDir() string {
+	if a.appDir != "" {
+		return a.appDir
+	}
+	executablePath, err := os.Executable()
+	if err != nil {
+		return os.TempDir()
+	}
+	return filepath.Dir(executablePath)
+}
+
+// readCrashReport loads a crash report from disk
+// Bir çökme raporunu diskten yükler
+func readCrashReport(path string) (CrashReport, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return CrashReport{}, err
+	}
+	var report CrashReport
+	if err := json.Unmarshal(data, &report); err != nil {
+		return CrashReport{}, err
+	}
+	report.ID = filepath.Base(path)
+	return report, nil
+}
+
+// appLogTail returns the last lines of the application log
+// Uygulama logunun son satırlarını döndürür
+func appLogTail(path string, count int) []string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	lines := strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+	if len(lines) > count {
+		lines = lines[len(lines)-count:]
+	}
+	return lines
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+// credentialRemotePrefix prefixes the credential ID of a remote connection
+// Bir uzak bağlantının kimlik bilgisi kimliğinin önekidir
+const credentialRemotePrefix = "remote:"
+
+// CredentialInfo struct
+// Represents a stored credential without its value
+// Değeri olmadan saklanan bir kimlik bilgisini temsil eder
+type CredentialInfo struct {
+	ID    string `json:"id"`    // upload, api or remote:<name> / upload, api veya remote:<ad>
+	Label string `json:"label"` // Display name / Görünen ad
+	Set   bool   `json:"set"`   // A value is stored / Bir değer saklanıyor
+}
+
+// SecretStorage struct
+// Represents where stored credentials are protected
+// Saklanan kimlik bilgilerinin nerede korunduğunu temsil eder
+type SecretStorage struct {
+	Backend     string           `json:"backend"`     // keychain or file / keychain veya file
+	Credentials []CredentialInfo `json:"credentials"` // Known credentials / Bilinen kimlik bilgileri
+}
+
+// GetSecretStorage lists the stored credentials and where their key is kept
+// Values never leave the backend, only whether they are set
+// Değerler arka uçtan asla çıkmaz, yalnızca ayarlanıp ayarlanmadıkları
+func (a *App) GetSecretStorage() (SecretStorage, error) {
+	if _, err := a.secretKey(); err != nil {
+		return SecretStorage{}, fmt.Errorf("failed to load secret key: %v", err)
+	}
+	storage := SecretStorage{
+		Backend: a.secretStorage,
+		Credentials: []CredentialInfo{
+			{ID: "upload", Label: "Bucket secret key", Set: a.currentUpload().SecretAccessKey != ""},
+			{ID: "api", Label: "API key", Set: a.currentPreferences().APIKey != ""},
+		},
+	}
+	for _, remote := range a.currentRemotes() {
+		storage.Credentials = append(storage.Credentials, CredentialInfo{
+			ID:    credentialRemotePrefix + remote.Name,
+			Label: remote.Name + " password",
+			Set:   remote.Password != "",
+		})
+	}
+	return storage, nil
+}
+
+// SetCredential stores the value of a credential
+// Bir kimlik bilgisinin değerini saklar
+func (a *App) SetCredential(id, value string) error {
+	if value == "" {
+		return fmt.Errorf("credential value must not be empty, use ClearCredential instead")
+	}
+	if id == "api" && len(value) < minAPIKeyLength {
+		return fmt.Errorf("API key must be at least %d characters", minAPIKeyLength)
+	}
+	return a.updateCredential(id, value)
+}
+
+// ClearCredential removes the value of a credential
+// Features that need it stop working until it is set again
+// Ona ihtiyaç duyan özellikler yeniden ayarlanana kadar çalışmaz
+func (a *App) ClearCredential(id string) error {
+	return a.updateCredential(id, "")
+}
+
+// updateCredential writes a credential value and saves the config
+// Bir kimlik bilgisi değerini yazar ve yapılandırmayı kaydeder
+func (a *App) updateCredential(id, value string) error {
+	a.forgetSealedSecret(id)
+	switch {
+	case id == "upload":
+		a.updateUpload(func(upload *UploadConfig) {
+			upload.SecretAccessKey = value
+			if value == "" {
+				upload.Enabled = false
+			}
+		})
+	case id == "api":
+		disabled := false
+		a.updatePreferences(func(preferences *AppPreferences) {
+			preferences.APIKey = value
+			if value == "" && preferences.APIEnabled {
+				preferences.APIEnabled, disabled = false, true
+			}
+		})
+		if disabled {
+			a.stopAPI()
+		}
+	case strings.HasPrefix(id, credentialRemotePrefix):
+		name := strings.TrimPrefix(id, credentialRemotePrefix)
+		found := false
+		a.remotesMu.Lock()
+		for i := range a.remotes {
+			if a.remotes[i].Name == name {
+				a.remotes[i].Password, found = value, true
+			}
+		}
+		a.remotesMu.Unlock()
+		if !found {
+			return fmt.Errorf("unknown connection: %s", name)
+		}
+	default:
+		return fmt.Errorf("unknown credential: %s", id)
+	}
+
+	log.Printf("Credential %s updated", id)
+	a.saveConfig()
+	return nil
+}
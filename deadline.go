@@ -0,0 +1,255 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"AV1-video-converter/internal/events"
+)
+
+// defaultEncodeSpeed is the speed, as a multiple of real time, assumed without history
+// Geçmiş yokken varsayılan hız, gerçek zamanın katı olarak
+const defaultEncodeSpeed = 0.5
+
+// QueueDeadline struct
+// Represents a time the queue must be done by
+// Kuyruğun bitmiş olması gereken zamanı temsil eder
+type QueueDeadline struct {
+	FinishBy           time.Time `json:"finishBy"`           // When the queue must be done / Kuyruğun bitmesi gereken zaman
+	AllowFasterPresets bool      `json:"allowFasterPresets"` // Step jobs to faster presets when late / Gecikince işleri daha hızlı ön ayarlara geçir
+	Pending            []string  `json:"pending"`            // Files waiting in the frontend list, not queued yet / Ön yüz listesinde bekleyen, henüz kuyruğa eklenmemiş dosyalar
+}
+
+// JobETA struct
+// Represents the estimated encode time left for one file
+// Bir dosya için kalan tahmini kodlama süresini temsil eder
+type JobETA struct {
+	JobID     string  `json:"jobId"`     // Job, empty for pending files / İş, bekleyen dosyalar için boş
+	InputPath string  `json:"inputPath"` // Source file / Kaynak dosya
+	Seconds   float64 `json:"seconds"`   // Estimated seconds left / Tahmini kalan saniye
+}
+
+// DeadlineEstimate struct
+// Represents whether the queue is expected to finish by the deadline
+// Kuyruğun son tarihe kadar bitmesinin beklenip beklenmediğini temsil eder
+type DeadlineEstimate struct {
+	FinishBy         time.Time `json:"finishBy"`         // Deadline / Son tarih
+	ProjectedFinish  time.Time `json:"projectedFinish"`  // Expected end of the queue / Kuyruğun beklenen bitişi
+	RemainingSeconds float64   `json:"remainingSeconds"` // Estimated work left, summed over every file / Her dosya üzerinden toplanan tahmini kalan iş
+	OnTime           bool      `json:"onTime"`           // Projected finish is before the deadline / Beklenen bitiş son tarihten önce
+	Unknown          int       `json:"unknown"`          // Files whose duration is unknown / Süresi bilinmeyen dosyalar
+	Jobs             []JobETA  `json:"jobs"`             // Estimate of every file / Her dosyanın tahmini
+}
+
+// SetQueueDeadline sets the time the queue must finish by and returns the first estimate
+// Pending lists the files the frontend will queue, they are dropped once their job starts
+// Pending ön yüzün kuyruğa ekleyeceği dosyaları listeler, işleri başlayınca çıkarılırlar
+func (a *App) SetQueueDeadline(deadline QueueDeadline) (DeadlineEstimate, error) {
+	if !deadline.FinishBy.After(time.Now()) {
+		return DeadlineEstimate{}, fmt.Errorf("deadline must be in the future")
+	}
+	a.deadlineMu.Lock()
+	a.deadline = &deadline
+	a.deadlineMu.Unlock()
+
+	estimate := a.estimateDeadline(deadline)
+	log.Printf("Queue deadline set to %s, projected finish %s", deadline.FinishBy.Format(time.RFC3339), estimate.ProjectedFinish.Format(time.RFC3339))
+	return estimate, nil
+}
+
+// ClearQueueDeadline removes the deadline
+// Son tarihi kaldırır
+func (a *App) ClearQueueDeadline() {
+	a.deadlineMu.Lock()
+	a.deadline = nil
+	a.deadlineMu.Unlock()
+}
+
+// GetDeadlineEstimate estimates whether the queue finishes by the deadline
+// Kuyruğun son tarihe kadar bitip bitmeyeceğini tahmin eder
+func (a *App) GetDeadlineEstimate() (DeadlineEstimate, error) {
+	deadline, ok := a.currentDeadline()
+	if !ok {
+		return DeadlineEstimate{}, fmt.Errorf("no deadline set")
+	}
+	return a.estimateDeadline(deadline), nil
+}
+
+// currentDeadline returns a copy of the deadline, if one is set
+// Ayarlanmışsa son tarihin bir kopyasını döndürür
+func (a *App) currentDeadline() (QueueDeadline, bool) {
+	a.deadlineMu.Lock()
+	defer a.deadlineMu.Unlock()
+	if a.deadline == nil {
+		return QueueDeadline{}, false
+	}
+	deadline := *a.deadline
+	deadline.Pending = append([]string(nil), a.deadline.Pending...)
+	return deadline, true
+}
+
+// estimateDeadline adds up the work left in running, queued and pending files
+// The work is spread over the parallel job limit, but the queue can't end before its longest file
+// İş paralel iş sınırına dağıtılır, ancak kuyruk en uzun dosyasından önce bitemez
+func (a *App) estimateDeadline(deadline QueueDeadline) DeadlineEstimate {
+	estimate := DeadlineEstimate{FinishBy: deadline.FinishBy, Jobs: []JobETA{}}
+	longest := 0.0
+	add := func(jobID, inputPath string, seconds float64, ok bool) {
+		if !ok {
+			estimate.Unknown++
+			return
+		}
+		estimate.RemainingSeconds += seconds
+		longest = math.Max(longest, seconds)
+		estimate.Jobs = append(estimate.Jobs, JobETA{JobID: jobID, InputPath: inputPath, Seconds: seconds})
+	}
+
+	queued := make(map[string]bool)
+	for _, job := range a.GetJobs() {
+		if job.Status != "queued" && job.Status != "running" {
+			continue
+		}
+		queued[job.InputPath] = true
+		seconds, ok := a.estimateEncodeSeconds(job.InputPath, job.Settings)
+		if job.Status == "running" {
+			seconds -= time.Since(jobStartedAt(job)).Seconds()
+			if seconds < 0 {
+				seconds = 0
+			}
+		}
+		add(job.ID, job.InputPath, seconds, ok)
+	}
+	for _, path := range deadline.Pending {
+		if queued[path] {
+			continue
+		}
+		seconds, ok := a.estimateEncodeSeconds(path, a.GetSettings())
+		add("", path, seconds, ok)
+	}
+
+	wall := math.Max(estimate.RemainingSeconds/float64(a.parallelJobs()), longest)
+	estimate.ProjectedFinish = time.Now().Add(time.Duration(wall * float64(time.Second)))
+	estimate.OnTime = !estimate.ProjectedFinish.After(deadline.FinishBy)
+	return estimate
+}
+
+// estimateEncodeSeconds estimates how long a file takes to encode with the settings
+// Bir dosyanın ayarlarla kodlanmasının ne kadar süreceğini tahmin eder
+func (a *App) estimateEncodeSeconds(inputPath string, settings ConversionSettings) (float64, bool) {
+	if isURLInput(inputPath) {
+		return 0, false
+	}
+	info, err := a.getVideoInfo(inputPath)
+	if err != nil || info.DurationSeconds <= 0 {
+		return 0, false
+	}
+	return info.DurationSeconds / a.encodeSpeed(settings), true
+}
+
+// encodeSpeed returns the average speed of past encodes with the same encoder and preset
+// Falls back to the encoder alone, then to defaultEncodeSpeed
+// Önce yalnızca kodlayıcıya, sonra defaultEncodeSpeed değerine geri düşer
+func (a *App) encodeSpeed(settings ConversionSettings) float64 {
+	var presetSum, encoderSum float64
+	var presetCount, encoderCount int
+	history, _ := a.GetHistory()
+	for _, entry := range history {
+		elapsed := entry.FinishedAt.Sub(entry.StartedAt).Seconds()
+		if entry.Status != "completed" || elapsed <= 0 || entry.Duration <= 0 || entry.Settings.Encoder != settings.Encoder {
+			continue
+		}
+		speed := entry.Duration / elapsed
+		encoderSum += speed
+		encoderCount++
+		if entry.Settings.Preset == settings.Preset {
+			presetSum += speed
+			presetCount++
+		}
+	}
+	switch {
+	case presetCount > 0:
+		return presetSum / float64(presetCount)
+	case encoderCount > 0:
+		return encoderSum / float64(encoderCount)
+	}
+	return defaultEncodeSpeed
+}
+
+// jobStartedAt returns when the current run of a job started
+// Bir işin geçerli çalışmasının ne zaman başladığını döndürür
+func jobStartedAt(job Job) time.Time {
+	for i := len(job.Timeline) - 1; i >= 0; i-- {
+		if job.Timeline[i].Message == "conversion started" {
+			return job.Timeline[i].Time
+		}
+	}
+	return time.Now()
+}
+
+// applyDeadline checks the deadline as a job starts, warning and speeding the job up when late
+// The parallel job limit is the user's choice, so a faster preset is the only lever
+// Paralel iş sınırı kullanıcının seçimidir, bu yüzden daha hızlı bir ön ayar tek çaredir
+func (a *App) applyDeadline(job Job) Job {
+	a.deadlineMu.Lock()
+	if a.deadline != nil {
+		pending := a.deadline.Pending[:0]
+		for _, path := range a.deadline.Pending {
+			if path != job.InputPath {
+				pending = append(pending, path)
+			}
+		}
+		a.deadline.Pending = pending
+	}
+	a.deadlineMu.Unlock()
+
+	deadline, ok := a.currentDeadline()
+	if !ok {
+		return job
+	}
+	estimate := a.estimateDeadline(deadline)
+	if estimate.OnTime {
+		return job
+	}
+
+	late := estimate.ProjectedFinish.Sub(deadline.FinishBy).Round(time.Minute)
+	message := fmt.Sprintf("queue is expected to finish %s after the deadline", late)
+	if deadline.AllowFasterPresets && len(job.Renditions) == 0 {
+		if preset, ok := fasterPreset(job.Settings.Encoder, job.Settings.Preset); ok {
+			message += fmt.Sprintf(", encoding with preset %s instead of %s", preset, job.Settings.Preset)
+			job.Settings.Preset = preset
+			a.updateJob(job.ID, func(job *Job) {
+				job.Settings.Preset = preset
+			})
+		}
+	}
+	log.Printf("Job %s: %s", job.ID, message)
+	a.addJobEvent(job.ID, "deadline", message)
+	a.emitJobEvent(events.JobWarning, job.ID, JobWarningEvent{Message: message})
+	return job
+}
+
+// fasterPreset returns the preset one step faster than the given one
+// Late jobs move a single step so quality drops no more than needed
+// Geciken işler tek adım ilerler, böylece kalite gerekenden fazla düşmez
+func fasterPreset(encoder, preset string) (string, bool) {
+	spec, ok := encoderSpecs[encoder]
+	if !ok || spec.intermediate != "" {
+		return "", false
+	}
+	step := 1
+	if spec.presetsFastFirst {
+		step = -1
+	}
+	for i, candidate := range spec.presets {
+		if candidate != preset {
+			continue
+		}
+		if next := i + step; next >= 0 && next < len(spec.presets) {
+			return spec.presets[next], true
+		}
+		return "", false
+	}
+	return "", false
+}
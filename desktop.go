@@ -0,0 +1,66 @@
+//go:build !headless
+
+package main
+
+import (
+	"context"
+
+	"AV1-video-converter/internal/events"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+// frontendSink sends the events to the Wails frontend
+// Olayları Wails ön yüzüne gönderir
+func frontendSink(ctx context.Context) events.Sink {
+	return events.SinkFunc(func(name string, data interface{}) {
+		runtime.EventsEmit(ctx, name, data)
+	})
+}
+
+// runtimeFilters converts file filters to the Wails type
+// Dosya filtrelerini Wails türüne dönüştürür
+func runtimeFilters(filters []fileFilter) []runtime.FileFilter {
+	converted := make([]runtime.FileFilter, 0, len(filters))
+	for _, filter := range filters {
+		converted = append(converted, runtime.FileFilter{DisplayName: filter.name, Pattern: filter.pattern})
+	}
+	return converted
+}
+
+// openFilesDialog asks the user for one or more files
+// Kullanıcıdan bir veya daha fazla dosya ister
+func (a *App) openFilesDialog(title string, filters ...fileFilter) ([]string, error) {
+	return runtime.OpenMultipleFilesDialog(a.ctx, runtime.OpenDialogOptions{Title: title, Filters: runtimeFilters(filters)})
+}
+
+// openFileDialog asks the user for a file, empty when the dialog was cancelled
+// Kullanıcıdan bir dosya ister, iletişim kutusu iptal edildiyse boş
+func (a *App) openFileDialog(title string, filters ...fileFilter) (string, error) {
+	return runtime.OpenFileDialog(a.ctx, runtime.OpenDialogOptions{Title: title, Filters: runtimeFilters(filters)})
+}
+
+// openDirectoryDialog asks the user for a folder, empty when the dialog was cancelled
+// Kullanıcıdan bir klasör ister, iletişim kutusu iptal edildiyse boş
+func (a *App) openDirectoryDialog(title string) (string, error) {
+	return runtime.OpenDirectoryDialog(a.ctx, runtime.OpenDialogOptions{Title: title})
+}
+
+// saveFileDialog asks the user where to save a file, empty when the dialog was cancelled
+// Kullanıcıya bir dosyanın nereye kaydedileceğini sorar, iletişim kutusu iptal edildiyse boş
+func (a *App) saveFileDialog(title, defaultName string, filters ...fileFilter) (string, error) {
+	return runtime.SaveFileDialog(a.ctx, runtime.SaveDialogOptions{Title: title, DefaultFilename: defaultName, Filters: runtimeFilters(filters)})
+}
+
+// clipboardText reads the text in the clipboard
+// Panodaki metni okur
+func (a *App) clipboardText() (string, error) {
+	return runtime.ClipboardGetText(a.ctx)
+}
+
+// openBrowser opens a URL in the default browser
+// Bir adresi varsayılan tarayıcıda açar
+func (a *App) openBrowser(url string) error {
+	runtime.BrowserOpenURL(a.ctx, url)
+	return nil
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"AV1-video-converter/internal/events"
+)
+
+// destinationSpacePollInterval is how often a paused job checks the free space of its destination
+// Duraklatılan bir işin hedefindeki boş alanı kontrol etme sıklığı
+const destinationSpacePollInterval = 30 * time.Second
+
+// DestinationWatermark struct
+// Represents the free space a destination folder must keep for jobs to write to it
+// İşlerin yazabilmesi için bir hedef klasörün koruması gereken boş alanı temsil eder
+type DestinationWatermark struct {
+	Folder    string `json:"folder"`    // Destination folder, also covers its subfolders / Hedef klasör, alt klasörlerini de kapsar
+	MinFreeGB int    `json:"minFreeGB"` // Free space in GB below which the queue pauses / Altında kuyruğun duraklatıldığı GB cinsinden boş alan
+}
+
+// validateDestinationWatermarks checks the destination watermarks
+// Hedef eşiklerini kontrol eder
+func validateDestinationWatermarks(watermarks []DestinationWatermark) error {
+	seen := make(map[string]bool)
+	for _, watermark := range watermarks {
+		if !filepath.IsAbs(watermark.Folder) {
+			return fmt.Errorf("watermark folder must be an absolute path: %s", watermark.Folder)
+		}
+		if watermark.MinFreeGB < 1 {
+			return fmt.Errorf("watermark of %s must be at least 1 GB", watermark.Folder)
+		}
+		folder := filepath.Clean(watermark.Folder)
+		if seen[folder] {
+			return fmt.Errorf("duplicate watermark folder: %s", watermark.Folder)
+		}
+		seen[folder] = true
+	}
+	return nil
+}
+
+// destinationWatermark returns the watermark of the closest configured folder holding the destination
+// Hedefi tutan en yakın yapılandırılmış klasörün eşiğini döndürür
+func (a *App) destinationWatermark(folder string) (DestinationWatermark, bool) {
+	var found DestinationWatermark
+	ok := false
+	for _, watermark := range a.currentPreferences().DestinationWatermarks {
+		rel, err := filepath.Rel(filepath.Clean(watermark.Folder), filepath.Clean(folder))
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			continue
+		}
+		if !ok || len(watermark.Folder) > len(found.Folder) {
+			found, ok = watermark, true
+		}
+	}
+	return found, ok
+}
+
+// waitForDestinationSpace holds a job back while its destination is below its watermark
+// The scheduler checks before a job starts and convert checks again before staged outputs are copied,
+// a running encode isn't paused, and a free space that can't be read counts as still too low
+// Zamanlayıcı bir iş başlamadan önce, convert ise hazırlanan çıktılar kopyalanmadan önce yeniden kontrol eder,
+// çalışan bir kodlama duraklatılmaz ve okunamayan boş alan hâlâ yetersiz sayılır
+func (a *App) waitForDestinationSpace(ctx context.Context, jobID, folder string) error {
+	watermark, ok := a.destinationWatermark(folder)
+	if !ok {
+		return nil
+	}
+	minFree := int64(watermark.MinFreeGB) << 30
+	free, err := freeDiskSpace(existingDir(folder))
+	if err == nil && free >= minFree {
+		return nil
+	}
+
+	message := fmt.Sprintf("paused, %.1f GB free in %s, waiting for %d GB", float64(free)/(1<<30), folder, watermark.MinFreeGB)
+	if err != nil {
+		message = fmt.Sprintf("paused, can't read the free space of %s: %v", folder, err)
+	}
+	log.Printf("Job %s %s", jobID, message)
+	a.addJobEvent(jobID, "space", message)
+	a.emitJobEvent(events.JobWarning, jobID, JobWarningEvent{Message: message})
+	a.emitJobEvent(events.JobPaused, jobID, JobPausedEvent{
+		Reason:    "space",
+		Message:   message,
+		Folder:    folder,
+		FreeGB:    float64(free) / (1 << 30),
+		MinFreeGB: watermark.MinFreeGB,
+	})
+
+	ticker := time.NewTicker(destinationSpacePollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("conversion cancelled: %w", context.Cause(ctx))
+		case <-ticker.C:
+			free, err = freeDiskSpace(existingDir(folder))
+			if err != nil || free < minFree {
+				continue
+			}
+			log.Printf("Job %s resumed: %.1f GB free in %s", jobID, float64(free)/(1<<30), folder)
+			message := fmt.Sprintf("resumed, %.1f GB free", float64(free)/(1<<30))
+			a.addJobEvent(jobID, "space", message)
+			a.emitJobEvent(events.JobResumed, jobID, JobResumedEvent{Reason: "space", Message: message})
+			return nil
+		}
+	}
+}
+
+// existingDir returns the folder itself or its closest parent that exists, destinations are created when a job starts
+// Klasörün kendisini veya var olan en yakın üst klasörünü döndürür, hedefler bir iş başladığında oluşturulur
+func existingDir(folder string) string {
+	for {
+		if _, err := os.Stat(folder); err == nil {
+			return folder
+		}
+		parent := filepath.Dir(folder)
+		if parent == folder {
+			return folder
+		}
+		folder = parent
+	}
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log"
+	"path/filepath"
+)
+
+// DuplicateInfo struct
+// Represents a file that would be converted twice
+// İki kez dönüştürülecek bir dosyayı temsil eder
+type DuplicateInfo struct {
+	Path      string `json:"path"`      // Candidate file / Aday dosya
+	Reason    string `json:"reason"`    // samePath, sameContent or alreadyConverted / Neden
+	MatchPath string `json:"matchPath"` // File or output it matches / Eşleştiği dosya veya çıktı
+}
+
+// FindDuplicates checks candidate files against the queue and the history
+// Detects the same path, the same content and files already converted with the current settings
+// Aynı yolu, aynı içeriği ve geçerli ayarlarla zaten dönüştürülmüş dosyaları algılar
+func (a *App) FindDuplicates(candidates []string, queued []string) []DuplicateInfo {
+	var duplicates []DuplicateInfo
+
+	// Index the queued files by path and content
+	// Kuyruktaki dosyaları yola ve içeriğe göre indeksle
+	seenPaths := make(map[string]string)
+	seenHashes := make(map[string]string)
+	for _, path := range queued {
+		seenPaths[normalizePath(path)] = path
+		if hash, err := quickHash(path); err == nil {
+			seenHashes[hash] = path
+		}
+	}
+
+	// Index completed conversions made with the current settings
+	// Geçerli ayarlarla yapılmış tamamlanmış dönüştürmeleri indeksle
+	fingerprint := settingsFingerprint(a.GetSettings())
+	converted := make(map[string]string)
+	history, _ := a.GetHistory()
+	for _, entry := range history {
+		if entry.Status == "completed" && entry.Record.SourceHash != "" && settingsFingerprint(entry.Settings) == fingerprint {
+			converted[entry.Record.SourceHash] = entry.OutputPath
+		}
+	}
+
+	for _, path := range candidates {
+		normalized := normalizePath(path)
+		if match, ok := seenPaths[normalized]; ok {
+			duplicates = append(duplicates, DuplicateInfo{Path: path, Reason: "samePath", MatchPath: match})
+			continue
+		}
+		seenPaths[normalized] = path
+
+		hash, err := quickHash(path)
+		if err != nil {
+			log.Printf("Error hashing %s for duplicate check: %v", path, err)
+			continue
+		}
+		if match, ok := seenHashes[hash]; ok {
+			duplicates = append(duplicates, DuplicateInfo{Path: path, Reason: "sameContent", MatchPath: match})
+			continue
+		}
+		seenHashes[hash] = path
+
+		if output, ok := converted[hash]; ok {
+			duplicates = append(duplicates, DuplicateInfo{Path: path, Reason: "alreadyConverted", MatchPath: output})
+		}
+	}
+
+	log.Printf("Duplicate check: %d of %d candidates are duplicates", len(duplicates), len(candidates))
+	return duplicates
+}
+
+// settingsFingerprint returns a stable hash of the settings
+// Ayarların değişmeyen bir özetini döndürür
+func settingsFingerprint(settings ConversionSettings) string {
+	data, err := json.Marshal(settings)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// normalizePath returns a cleaned absolute path for comparisons
+// Karşılaştırmalar için temizlenmiş mutlak bir yol döndürür
+func normalizePath(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		path = resolved
+	}
+	return filepath.Clean(path)
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"log"
+	"math"
+	"strconv"
+	"time"
+
+	"AV1-video-converter/internal/probe"
+)
+
+// maxPlausibleDuration is the longest duration taken at face value, a week in seconds
+// Broken transport streams report timestamps that wrapped around to days or years
+// Bozuk aktarım akışları günlere veya yıllara sarmış zaman damgaları bildirir
+const maxPlausibleDuration = 7 * 24 * 3600
+
+// durationScanTimeout limits the packet scan of a file without a usable duration
+// Kullanılabilir süresi olmayan bir dosyanın paket taramasını sınırlar
+const durationScanTimeout = 2 * time.Minute
+
+// Duration sources reported in VideoInfo
+// VideoInfo'da bildirilen süre kaynakları
+const (
+	durationFromContainer = "container" // Reported by the container / Kapsayıcı tarafından bildirilen
+	durationFromStream    = "stream"    // Reported by the video stream / Video akışı tarafından bildirilen
+	durationFromFrames    = "frames"    // Frame count divided by the frame rate / Kare sayısının kare hızına bölümü
+	durationFromPackets   = "packets"   // Measured by scanning the packets / Paketler taranarak ölçülen
+	durationUnknown       = "unknown"   // No usable duration was found / Kullanılabilir süre bulunamadı
+)
+
+// plausibleDuration reports whether a duration can be used for timecodes and progress
+// Bir sürenin zaman kodları ve ilerleme için kullanılıp kullanılamayacağını bildirir
+func plausibleDuration(seconds float64) bool {
+	return seconds > 0 && !math.IsInf(seconds, 0) && !math.IsNaN(seconds) && seconds <= maxPlausibleDuration
+}
+
+// resolveDuration finds the duration of a file and where it came from
+// Falls back from the container to the stream, the frame count and finally a packet scan
+// Kapsayıcıdan akışa, kare sayısına ve son olarak bir paket taramasına geri düşer
+func (a *App) resolveDuration(ctx context.Context, filePath string, result probe.Result, video probe.Stream) (float64, string) {
+	if seconds, _ := strconv.ParseFloat(result.Format.Duration, 64); plausibleDuration(seconds) {
+		return seconds, durationFromContainer
+	}
+	if seconds, _ := strconv.ParseFloat(video.Duration, 64); plausibleDuration(seconds) {
+		return seconds, durationFromStream
+	}
+	if frames, _ := strconv.Atoi(video.NbFrames); frames > 0 && video.FrameRate() > 0 {
+		if seconds := float64(frames) / video.FrameRate(); plausibleDuration(seconds) {
+			return seconds, durationFromFrames
+		}
+	}
+
+	// Growing files and live URLs can't be scanned to their end
+	// Büyüyen dosyalar ve canlı URL'ler sonuna kadar taranamaz
+	if isURLInput(filePath) {
+		return 0, durationUnknown
+	}
+	if seconds := a.scanDuration(ctx, filePath, video.FrameRate()); plausibleDuration(seconds) {
+		log.Printf("Estimated duration of %s from its packets: %s", filePath, formatSeconds(seconds))
+		return seconds, durationFromPackets
+	}
+	log.Printf("No usable duration for %s", filePath)
+	return 0, durationUnknown
+}
+
+// scanDuration measures the span of the video packets, plus the length of the last frame
+// Video paketlerinin aralığını ve son karenin süresini ölçer
+func (a *App) scanDuration(ctx context.Context, filePath string, frameRate float64) float64 {
+	if err := a.waitForEncodes(ctx); err != nil {
+		return 0
+	}
+	ctx, cancel := context.WithTimeout(ctx, durationScanTimeout)
+	defer cancel()
+
+	args := append(append([]string{}, probe.PacketArgs...), filePath)
+	out, err := a.runner.Output(ctx, a.ffprobePath, args...)
+	if err != nil {
+		log.Printf("Error scanning packets of %s: %v", filePath, err)
+		return 0
+	}
+	packets := probe.ParsePackets(out)
+	if len(packets) == 0 {
+		return 0
+	}
+	start, end := probe.PacketSpan(packets)
+	seconds := end - start
+	if frameRate > 0 {
+		seconds += 1 / frameRate
+	}
+	return seconds
+}
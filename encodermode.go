@@ -0,0 +1,207 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"strings"
+
+	"AV1-video-converter/internal/events"
+)
+
+// Defaults of the balanced encoder mode
+// Dengeli kodlayıcı modunun varsayılanları
+const (
+	defaultBalancedMinHeight   = 2160
+	defaultBalancedQueueLength = 10
+)
+
+// EncoderRoute struct
+// Represents the encoder the balanced mode picked for a job and why
+// Dengeli modun bir iş için seçtiği kodlayıcıyı ve nedenini temsil eder
+type EncoderRoute struct {
+	Path     string              `json:"path"`    // software or hardware / software veya hardware
+	Encoder  string              `json:"encoder"` // FFmpeg encoder used / Kullanılan FFmpeg kodlayıcısı
+	Reason   string              `json:"reason"`  // Why this path was taken / Bu yolun neden seçildiği
+	Software *ConversionSettings `json:"-"`       // SVT-AV1 settings to fall back to if hardware fails / Donanım başarısız olursa dönülecek SVT-AV1 ayarları
+}
+
+// validateEncoderMode checks the balanced encoder mode preferences
+// Dengeli kodlayıcı modu tercihlerini kontrol eder
+func validateEncoderMode(preferences AppPreferences) error {
+	if preferences.EncoderMode != "" && preferences.EncoderMode != "balanced" {
+		return fmt.Errorf("encoder mode must be empty or balanced")
+	}
+	if preferences.BalancedMinHeight < 0 || preferences.BalancedQueueLength < 0 {
+		return fmt.Errorf("balanced mode height and queue length must not be negative")
+	}
+	if preferences.BalancedQualityFloor < 0 || preferences.BalancedQualityFloor > encoderSpecs["libsvtav1"].qualityMax {
+		return fmt.Errorf("balanced mode quality floor must be between 0 and %d", encoderSpecs["libsvtav1"].qualityMax)
+	}
+	return nil
+}
+
+// applyEncoderMode picks SVT-AV1 or a hardware encoder for a job as it starts, in the balanced mode
+// The choice is made once, retries keep the path it got unless the hardware encode fails
+// Seçim bir kez yapılır, donanım kodlaması başarısız olmadıkça yeniden denemeler aldığı yolu korur
+func (a *App) applyEncoderMode(job Job) Job {
+	preferences := a.currentPreferences()
+	if preferences.EncoderMode != "balanced" || job.Route != nil || !balancedEligible(job) {
+		return job
+	}
+
+	route := EncoderRoute{Path: "software", Encoder: job.Settings.Encoder}
+	settings := job.Settings
+	encoder := a.balancedHardwareEncoder()
+	floor := preferences.BalancedQualityFloor
+	switch {
+	case encoder == "":
+		route.Reason = "no usable hardware AV1 encoder"
+	case floor > 0 && job.Settings.CRF < floor:
+		route.Reason = fmt.Sprintf("CRF %d asks for more quality than the floor of %d allows on hardware", job.Settings.CRF, floor)
+	default:
+		reasons := a.hardwareReasons(job, preferences)
+		if len(reasons) == 0 {
+			route.Reason = "no deadline pressure, short queue and a small source"
+			break
+		}
+		translated := hardwareSettings(job.Settings, encoder)
+		if errs := a.validateSettingsForBuild(translated); len(errs) > 0 {
+			route.Reason = fmt.Sprintf("the settings don't translate to %s: %v", encoder, errs)
+			break
+		}
+		software := job.Settings
+		settings = translated
+		route = EncoderRoute{Path: "hardware", Encoder: encoder, Reason: strings.Join(reasons, ", "), Software: &software}
+	}
+
+	job.Settings, job.Route = settings, &route
+	a.updateJob(job.ID, func(job *Job) {
+		job.Settings, job.Route = settings, &route
+	})
+	message := fmt.Sprintf("%s encode with %s: %s", route.Path, route.Encoder, route.Reason)
+	log.Printf("Job %s: %s", job.ID, message)
+	a.addJobEvent(job.ID, "encoder", message)
+	if route.Path == "hardware" {
+		a.emitJobEvent(events.JobWarning, job.ID, JobWarningEvent{Message: message})
+	}
+	return job
+}
+
+// onHardware reports whether a job took the hardware path of the balanced mode and can fall back
+// Bir işin dengeli modun donanım yolunu alıp almadığını ve geri dönebileceğini bildirir
+func onHardware(job Job) bool {
+	return job.Route != nil && job.Route.Path == "hardware" && job.Route.Software != nil
+}
+
+// fallBackToSoftware moves a job whose hardware encode failed back to the SVT-AV1 settings it had
+// Donanım kodlaması başarısız olan bir işi sahip olduğu SVT-AV1 ayarlarına geri taşır
+func (a *App) fallBackToSoftware(job Job, cause error) Job {
+	settings := *job.Route.Software
+	route := EncoderRoute{Path: "software", Encoder: settings.Encoder, Reason: fmt.Sprintf("%s failed: %v", job.Route.Encoder, cause)}
+
+	job.Settings, job.Route = settings, &route
+	a.updateJob(job.ID, func(job *Job) {
+		job.Settings, job.Route = settings, &route
+	})
+	message := fmt.Sprintf("falling back to %s, %s", route.Encoder, route.Reason)
+	log.Printf("Job %s: %s", job.ID, message)
+	a.addJobEvent(job.ID, "encoder", message)
+	a.emitJobEvent(events.JobWarning, job.ID, JobWarningEvent{Message: message})
+	return job
+}
+
+// balancedEligible reports whether the balanced mode may move a job to hardware
+// Renditions, zones and archival profiles are tuned for SVT-AV1 and stay on it
+// Sürümler, bölgeler ve arşiv profilleri SVT-AV1 için ayarlanmıştır ve onda kalır
+func balancedEligible(job Job) bool {
+	settings := job.Settings
+	return settings.Encoder == "libsvtav1" && !job.AudioOnly && len(job.Renditions) == 0 &&
+		settings.Archival == "" && len(settings.Zones) == 0 && !settings.AutoRelaxZones
+}
+
+// hardwareReasons lists what calls for a hardware encode of the job, none keeps it in software
+// İşin donanımla kodlanmasını gerektiren durumları listeler, hiçbiri yoksa yazılımda kalır
+func (a *App) hardwareReasons(job Job, preferences AppPreferences) []string {
+	var reasons []string
+	if deadline, ok := a.currentDeadline(); ok && !a.estimateDeadline(deadline).OnTime {
+		reasons = append(reasons, "the queue would miss its deadline")
+	}
+
+	queueLength := preferences.BalancedQueueLength
+	if queueLength == 0 {
+		queueLength = defaultBalancedQueueLength
+	}
+	queued := 0
+	for _, other := range a.GetJobs() {
+		if other.ID != job.ID && other.Status == "queued" {
+			queued++
+		}
+	}
+	if queued >= queueLength {
+		reasons = append(reasons, fmt.Sprintf("%d jobs are queued", queued))
+	}
+
+	minHeight := preferences.BalancedMinHeight
+	if minHeight == 0 {
+		minHeight = defaultBalancedMinHeight
+	}
+	if info, err := a.getVideoInfo(job.InputPath); err == nil && info.Height >= minHeight {
+		reasons = append(reasons, fmt.Sprintf("the source is %dp", info.Height))
+	}
+	return reasons
+}
+
+// balancedHardwareEncoder returns the first hardware AV1 encoder that passes a test encode
+// Detection runs once per session since it starts FFmpeg for every encoder
+// Algılama her kodlayıcı için FFmpeg başlattığından oturum başına bir kez çalışır
+func (a *App) balancedHardwareEncoder() string {
+	a.hardwareMu.Lock()
+	defer a.hardwareMu.Unlock()
+	if a.hardwareEncoder == nil {
+		name := ""
+		for _, encoder := range a.DetectHardware().Encoders {
+			if _, known := encoderSpecs[encoder.Name]; known && encoder.Usable {
+				name = encoder.Name
+				break
+			}
+		}
+		a.hardwareEncoder = &name
+	}
+	return *a.hardwareEncoder
+}
+
+// hardwareSettings translates SVT-AV1 settings to a hardware encoder
+// The quality value and preset keep their relative place in the range of the encoder
+// Kalite değeri ve ön ayar, kodlayıcının aralığındaki göreli yerlerini korur
+func hardwareSettings(settings ConversionSettings, encoder string) ConversionSettings {
+	software, spec := encoderSpecs[settings.Encoder], encoderSpecs[encoder]
+
+	position := float64(settings.CRF-software.qualityMin) / float64(software.qualityMax-software.qualityMin)
+	settings.CRF = spec.qualityMin + int(math.Round(position*float64(spec.qualityMax-spec.qualityMin)))
+
+	// Software presets are listed slowest first, hardware ones fastest first
+	// Yazılım ön ayarları en yavaştan, donanım ön ayarları en hızlıdan başlayarak listelenir
+	for i, preset := range software.presets {
+		if preset != settings.Preset {
+			continue
+		}
+		speed := float64(i) / float64(len(software.presets)-1)
+		if spec.presetsFastFirst {
+			speed = 1 - speed
+		}
+		settings.Preset = spec.presets[int(math.Round(speed*float64(len(spec.presets)-1)))]
+		break
+	}
+
+	switch settings.PixelFormat {
+	case "yuv420p10le":
+		settings.PixelFormat = "p010le"
+	case "yuv420p":
+		if !containsString(spec.pixelFormats, "yuv420p") {
+			settings.PixelFormat = "nv12"
+		}
+	}
+	settings.Encoder = encoder
+	return settings
+}
@@ -0,0 +1,234 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	goruntime "runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"AV1-video-converter/internal/runner"
+)
+
+// defaultCPUWatts is the TDP assumed when none is configured
+// Yapılandırılmadığında varsayılan TDP değeridir
+const defaultCPUWatts = 65
+
+// EnergyUsage struct
+// Represents the estimated energy an encode used
+// Bir kodlamanın kullandığı tahmini enerjiyi temsil eder
+type EnergyUsage struct {
+	Method       string  `json:"method"`       // rapl, powermetrics or cputime / Ölçüm yöntemi
+	WattHours    float64 `json:"wattHours"`    // Energy used in watt-hours / Watt saat cinsinden kullanılan enerji
+	AverageWatts float64 `json:"averageWatts"` // Average power draw / Ortalama güç tüketimi
+	Seconds      float64 `json:"seconds"`      // Measured time / Ölçülen süre
+}
+
+// energyMeter struct
+// Measures the energy of the CPU package while a job encodes
+// RAPL and powermetrics see the whole package, the CPU time fallback only FFmpeg
+// RAPL ve powermetrics tüm paketi görür, CPU süresi yedeği yalnızca FFmpeg'i
+type energyMeter struct {
+	started time.Time
+
+	raplZones []string // Package energy counters / Paket enerji sayaçları
+	raplStart []uint64 // Counter values at the start / Başlangıçtaki sayaç değerleri
+
+	powermetrics *exec.Cmd
+	powerDone    chan struct{}
+	powerMu      sync.Mutex
+	combinedMW   float64 // Sum of combined power samples / Birleşik güç örneklerinin toplamı
+	combinedN    int
+	cpuMW        float64 // Sum of CPU power samples / CPU güç örneklerinin toplamı
+	cpuN         int
+}
+
+// powermetricsPattern matches the power lines of a powermetrics sample
+// Bir powermetrics örneğinin güç satırlarıyla eşleşir
+var powermetricsPattern = regexp.MustCompile(`^(Combined Power \(CPU \+ GPU \+ ANE\)|CPU Power): (\d+) mW`)
+
+// startEnergyMeter starts measuring if energy estimation is enabled
+// Returns nil when it is disabled
+// Devre dışıysa nil döndürür
+func (a *App) startEnergyMeter(ctx context.Context) *energyMeter {
+	if !a.currentPreferences().EnergyEstimation {
+		return nil
+	}
+	meter := &energyMeter{started: time.Now()}
+	switch goruntime.GOOS {
+	case "linux":
+		meter.startRAPL()
+	case "darwin":
+		meter.startPowermetrics(ctx)
+	}
+	return meter
+}
+
+// startRAPL reads the package energy counters of the powercap interface
+// Newer kernels only let root read them, the CPU time fallback is used then
+// Yeni çekirdekler bunları yalnızca root'a okutur, o zaman CPU süresi yedeği kullanılır
+func (m *energyMeter) startRAPL() {
+	zones, _ := filepath.Glob("/sys/class/powercap/intel-rapl:[0-9]*")
+	for _, zone := range zones {
+		// Subzones like intel-rapl:0:0 are already counted by their package
+		// intel-rapl:0:0 gibi alt bölgeler zaten paketlerince sayılır
+		if strings.Count(filepath.Base(zone), ":") != 1 {
+			continue
+		}
+		value, err := readCounter(filepath.Join(zone, "energy_uj"))
+		if err != nil {
+			log.Printf("RAPL not readable, energy is estimated from CPU time: %v", err)
+			m.raplZones, m.raplStart = nil, nil
+			return
+		}
+		m.raplZones = append(m.raplZones, zone)
+		m.raplStart = append(m.raplStart, value)
+	}
+}
+
+// startPowermetrics samples the power draw every second, it needs root
+// Güç tüketimini her saniye örnekler, root gerektirir
+func (m *energyMeter) startPowermetrics(ctx context.Context) {
+	if os.Geteuid() != 0 {
+		log.Printf("powermetrics needs root, energy is estimated from CPU time")
+		return
+	}
+	cmd := exec.CommandContext(ctx, "powermetrics", "-i", "1000", "--samplers", "cpu_power")
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		log.Printf("Error starting powermetrics: %v", err)
+		return
+	}
+	if err := runner.Start(cmd); err != nil {
+		log.Printf("Error starting powermetrics: %v", err)
+		return
+	}
+	m.powermetrics, m.powerDone = cmd, make(chan struct{})
+
+	go func() {
+		defer close(m.powerDone)
+		scanner := bufio.NewScanner(stdout)
+		for scanner.Scan() {
+			match := powermetricsPattern.FindStringSubmatch(strings.TrimSpace(scanner.Text()))
+			if match == nil {
+				continue
+			}
+			milliwatts, _ := strconv.ParseFloat(match[2], 64)
+			m.powerMu.Lock()
+			if strings.HasPrefix(match[1], "Combined") {
+				m.combinedMW, m.combinedN = m.combinedMW+milliwatts, m.combinedN+1
+			} else {
+				m.cpuMW, m.cpuN = m.cpuMW+milliwatts, m.cpuN+1
+			}
+			m.powerMu.Unlock()
+		}
+		runner.Wait(cmd)
+	}()
+}
+
+// stop ends the measurement and returns the energy used
+// CPU time of FFmpeg times the TDP share of one core is used when nothing was measured
+// Hiçbir şey ölçülmediyse FFmpeg'in CPU süresi çarpı tek çekirdeğin TDP payı kullanılır
+func (m *energyMeter) stop(cpuSeconds float64, cpuWatts int) *EnergyUsage {
+	if m == nil {
+		return nil
+	}
+	usage := &EnergyUsage{Seconds: time.Since(m.started).Seconds()}
+	if usage.Seconds <= 0 {
+		return nil
+	}
+
+	joules, ok := m.stopRAPL()
+	if ok {
+		usage.Method = "rapl"
+	} else if watts, ok := m.stopPowermetrics(); ok {
+		usage.Method, joules = "powermetrics", watts*usage.Seconds
+	} else {
+		if cpuWatts <= 0 {
+			cpuWatts = defaultCPUWatts
+		}
+		usage.Method = "cputime"
+		joules = cpuSeconds / float64(goruntime.NumCPU()) * float64(cpuWatts)
+	}
+	usage.WattHours = joules / 3600
+	usage.AverageWatts = joules / usage.Seconds
+	return usage
+}
+
+// stopRAPL returns the joules counted since the start
+// Başlangıçtan beri sayılan jul değerini döndürür
+func (m *energyMeter) stopRAPL() (float64, bool) {
+	if len(m.raplZones) == 0 {
+		return 0, false
+	}
+	var microjoules float64
+	for i, zone := range m.raplZones {
+		value, err := readCounter(filepath.Join(zone, "energy_uj"))
+		if err != nil {
+			log.Printf("Error reading RAPL counter %s: %v", zone, err)
+			return 0, false
+		}
+		// The counter wraps around at max_energy_range_uj
+		// Sayaç max_energy_range_uj değerinde başa döner
+		if value < m.raplStart[i] {
+			maxRange, err := readCounter(filepath.Join(zone, "max_energy_range_uj"))
+			if err != nil {
+				return 0, false
+			}
+			value += maxRange
+		}
+		microjoules += float64(value - m.raplStart[i])
+	}
+	return microjoules / 1e6, true
+}
+
+// stopPowermetrics returns the average power in watts
+// Combined power includes the GPU and media engines hardware encoders use
+// Birleşik güç, donanım kodlayıcılarının kullandığı GPU ve medya motorlarını içerir
+func (m *energyMeter) stopPowermetrics() (float64, bool) {
+	if m.powermetrics == nil {
+		return 0, false
+	}
+	m.powermetrics.Process.Kill()
+	<-m.powerDone
+
+	m.powerMu.Lock()
+	defer m.powerMu.Unlock()
+	switch {
+	case m.combinedN > 0:
+		return m.combinedMW / float64(m.combinedN) / 1000, true
+	case m.cpuN > 0:
+		return m.cpuMW / float64(m.cpuN) / 1000, true
+	}
+	return 0, false
+}
+
+// readCounter reads an unsigned integer from a sysfs file
+// Bir sysfs dosyasından işaretsiz bir tam sayı okur
+func readCounter(path string) (uint64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	value, err := strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid counter %s: %v", path, err)
+	}
+	return value, nil
+}
+
+// addCPUTime adds the CPU time of a finished FFmpeg process to a job
+// Biten bir FFmpeg işleminin CPU süresini bir işe ekler
+func (a *App) addCPUTime(jobID string, cpu time.Duration) {
+	a.updateJob(jobID, func(job *Job) {
+		job.CPUSeconds += cpu.Seconds()
+	})
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// failureLogLines is the number of FFmpeg log lines quoted in a failure
+// Bir hata mesajında alıntılanan FFmpeg log satırı sayısıdır
+const failureLogLines = 3
+
+// ffmpegExitError describes a non-zero FFmpeg exit using the end of its log
+// The exit status alone rarely tells the user what went wrong
+// Çıkış durumu tek başına kullanıcıya neyin yanlış gittiğini nadiren söyler
+func ffmpegExitError(err error, logPath string) error {
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) {
+		return err
+	}
+	tail := logTail(logPath, failureLogLines)
+	if tail == "" {
+		return fmt.Errorf("FFmpeg exited with code %d: %w", exitErr.ExitCode(), err)
+	}
+	return fmt.Errorf("FFmpeg exited with code %d: %s: %w", exitErr.ExitCode(), tail, err)
+}
+
+// logTail returns the last non-empty lines of a log file joined by " | "
+// Bir log dosyasının boş olmayan son satırlarını " | " ile birleştirerek döndürür
+func logTail(logPath string, count int) string {
+	data, err := os.ReadFile(logPath)
+	if err != nil {
+		log.Printf("Error reading FFmpeg log %s: %v", logPath, err)
+		return ""
+	}
+
+	// Progress updates are separated by carriage returns
+	// İlerleme güncellemeleri satır başı karakterleriyle ayrılır
+	text := strings.ReplaceAll(string(data), "\r", "\n")
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if len(lines) > count {
+		lines = lines[len(lines)-count:]
+	}
+	return strings.Join(lines, " | ")
+}
+
+// discardPartialOutput removes the output of a failed conversion
+// The file is kept when the preferences ask for it
+// Tercihler istediğinde dosya korunur
+func (a *App) discardPartialOutput(outputPath string) {
+	if a.currentPreferences().KeepPartialOutput {
+		log.Printf("Keeping partial output %s", outputPath)
+		return
+	}
+	if err := os.Remove(outputPath); err != nil && !os.IsNotExist(err) {
+		log.Printf("Error removing partial output %s: %v", outputPath, err)
+		return
+	}
+	log.Printf("Removed partial output %s", outputPath)
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os/exec"
+	"strings"
+)
+
+// FFmpegInfo struct
+// Represents the capabilities of the local FFmpeg build
+// Yerel FFmpeg derlemesinin yeteneklerini temsil eder
+type FFmpegInfo struct {
+	FFmpegPath     string        `json:"ffmpegPath"`     // FFmpeg binary in use / Kullanılan FFmpeg ikili dosyası
+	FFprobePath    string        `json:"ffprobePath"`    // FFprobe binary in use / Kullanılan FFprobe ikili dosyası
+	FFmpegVersion  string        `json:"ffmpegVersion"`  // FFmpeg version / FFmpeg sürümü
+	FFprobeVersion string        `json:"ffprobeVersion"` // FFprobe version / FFprobe sürümü
+	Build          string        `json:"build"`          // Compiler line, e.g. "built with gcc 13.2" / Derleyici satırı
+	Configuration  []string      `json:"configuration"`  // configure flags / configure bayrakları
+	Encoders       []FFmpegCodec `json:"encoders"`       // Available encoders / Kullanılabilir kodlayıcılar
+	Filters        []string      `json:"filters"`        // Available filters / Kullanılabilir filtreler
+}
+
+// FFmpegCodec struct
+// Represents an encoder compiled into FFmpeg
+// FFmpeg içinde derlenmiş bir kodlayıcıyı temsil eder
+type FFmpegCodec struct {
+	Name        string `json:"name"`        // Encoder name / Kodlayıcı adı
+	Type        string `json:"type"`        // video, audio or subtitle / Kodlayıcı türü
+	Description string `json:"description"` // Long name / Uzun ad
+}
+
+// GetFFmpegInfo returns the versions, configuration, encoders and filters of FFmpeg
+// The result is gathered once and reused for the lifetime of the application
+// Sonuç bir kez toplanır ve uygulama süresince yeniden kullanılır
+func (a *App) GetFFmpegInfo() (FFmpegInfo, error) {
+	a.ffmpegInfoMu.Lock()
+	defer a.ffmpegInfoMu.Unlock()
+	if a.ffmpegInfo != nil {
+		return *a.ffmpegInfo, nil
+	}
+
+	info := FFmpegInfo{
+		FFmpegPath:     a.ffmpegPath,
+		FFprobePath:    a.ffprobePath,
+		FFmpegVersion:  a.ffmpegVersion,
+		FFprobeVersion: detectFFmpegVersion(a.ffprobePath),
+	}
+
+	versionOut, err := exec.Command(a.ffmpegPath, "-hide_banner", "-version").Output()
+	if err != nil {
+		log.Printf("Error getting FFmpeg build information: %v", err)
+		return FFmpegInfo{}, fmt.Errorf("failed to query FFmpeg: %v", err)
+	}
+	info.Build, info.Configuration = parseFFmpegBuild(string(versionOut))
+
+	encodersOut, err := exec.Command(a.ffmpegPath, "-hide_banner", "-encoders").Output()
+	if err != nil {
+		log.Printf("Error listing FFmpeg encoders: %v", err)
+		return FFmpegInfo{}, fmt.Errorf("failed to list FFmpeg encoders: %v", err)
+	}
+	info.Encoders = parseFFmpegEncoders(string(encodersOut))
+
+	filtersOut, err := exec.Command(a.ffmpegPath, "-hide_banner", "-filters").Output()
+	if err != nil {
+		log.Printf("Error listing FFmpeg filters: %v", err)
+		return FFmpegInfo{}, fmt.Errorf("failed to list FFmpeg filters: %v", err)
+	}
+	info.Filters = parseFFmpegFilters(string(filtersOut))
+
+	log.Printf("FFmpeg build: %d encoders, %d filters", len(info.Encoders), len(info.Filters))
+	a.ffmpegInfo = &info
+	return info, nil
+}
+
+// parseFFmpegBuild reads the compiler and configure lines of "ffmpeg -version"
+// "ffmpeg -version" çıktısının derleyici ve configure satırlarını okur
+func parseFFmpegBuild(output string) (build string, configuration []string) {
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(line, "built with "):
+			build = line
+		case strings.HasPrefix(line, "configuration:"):
+			configuration = strings.Fields(strings.TrimPrefix(line, "configuration:"))
+		}
+	}
+	return build, configuration
+}
+
+// parseFFmpegEncoders reads the encoder table of "ffmpeg -encoders"
+// Entries follow a "------" line and start with flags like "V....D"
+// Kayıtlar "------" satırından sonra gelir ve "V....D" gibi bayraklarla başlar
+func parseFFmpegEncoders(output string) []FFmpegCodec {
+	var encoders []FFmpegCodec
+	inTable := false
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if !inTable {
+			inTable = len(fields) == 1 && strings.HasPrefix(fields[0], "---")
+			continue
+		}
+		if len(fields) < 2 {
+			continue
+		}
+		codecType := ""
+		switch fields[0][0] {
+		case 'V':
+			codecType = "video"
+		case 'A':
+			codecType = "audio"
+		case 'S':
+			codecType = "subtitle"
+		}
+		encoders = append(encoders, FFmpegCodec{
+			Name:        fields[1],
+			Type:        codecType,
+			Description: strings.Join(fields[2:], " "),
+		})
+	}
+	return encoders
+}
+
+// parseFFmpegFilters reads the filter names of "ffmpeg -filters"
+// Lines look like " TSC scale  V->V  Scale the input video size."
+// Satırlar " TSC scale  V->V  Scale the input video size." biçimindedir
+func parseFFmpegFilters(output string) []string {
+	var filters []string
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 3 || !strings.Contains(fields[2], "->") {
+			continue
+		}
+		filters = append(filters, fields[1])
+	}
+	return filters
+}
+
+// hasEncoder reports whether the FFmpeg build includes an encoder
+// Reports true when the build can't be queried, so validation never blocks on it
+// Derleme sorgulanamazsa doğrulamanın buna takılmaması için true döndürür
+func (info FFmpegInfo) hasEncoder(name string) bool {
+	if len(info.Encoders) == 0 {
+		return true
+	}
+	for _, encoder := range info.Encoders {
+		if encoder.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// validateSettingsForBuild checks the settings and that FFmpeg has their encoders
+// Ayarları ve FFmpeg'in kodlayıcılarına sahip olduğunu kontrol eder
+func (a *App) validateSettingsForBuild(settings ConversionSettings) ValidationErrors {
+	errs := validateSettings(settings)
+	info, err := a.GetFFmpegInfo()
+	if err != nil {
+		return errs
+	}
+	if _, known := encoderSpecs[settings.Encoder]; known && !info.hasEncoder(settings.Encoder) {
+		errs = append(errs, ValidationError{
+			Field:   "encoder",
+			Value:   settings.Encoder,
+			Message: "not included in this FFmpeg build",
+		})
+	}
+	if settings.AudioCodec != "copy" && !info.hasEncoder(settings.AudioCodec) {
+		errs = append(errs, ValidationError{
+			Field:   "audioCodec",
+			Value:   settings.AudioCodec,
+			Message: "not included in this FFmpeg build",
+		})
+	}
+	return errs
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"os"
+	goruntime "runtime"
+)
+
+// preserveFileAttributes copies the source timestamps and permissions to the output
+// Keeps media libraries sorted by date in the same order after conversion
+// Tarihe göre sıralanan medya kütüphanelerinin dönüştürmeden sonra aynı sırada kalmasını sağlar
+func preserveFileAttributes(sourcePath, outputPath string) error {
+	info, err := os.Stat(sourcePath)
+	if err != nil {
+		return err
+	}
+
+	// Permissions only carry meaning on Unix
+	// İzinler yalnızca Unix'te anlam taşır
+	if goruntime.GOOS != "windows" {
+		if err := os.Chmod(outputPath, info.Mode().Perm()); err != nil {
+			return err
+		}
+	}
+
+	// Creation time first, as setting it may touch the modification time
+	// Önce oluşturma zamanı, çünkü ayarlanması değiştirme zamanına dokunabilir
+	if err := copyCreationTime(info, outputPath); err != nil {
+		return err
+	}
+	return os.Chtimes(outputPath, info.ModTime(), info.ModTime())
+}
@@ -0,0 +1,12 @@
+//go:build !windows
+
+package main
+
+import "os"
+
+// copyCreationTime is a no-op outside Windows
+// macOS moves the birth time back when an older modification time is set
+// macOS daha eski bir değiştirme zamanı ayarlandığında oluşturma zamanını geri alır
+func copyCreationTime(source os.FileInfo, outputPath string) error {
+	return nil
+}
@@ -0,0 +1,31 @@
+//go:build windows
+
+package main
+
+import (
+	"os"
+	"syscall"
+)
+
+// copyCreationTime sets the creation time of the output to the source's
+// Çıktının oluşturma zamanını kaynağınkine ayarlar
+func copyCreationTime(source os.FileInfo, outputPath string) error {
+	data, ok := source.Sys().(*syscall.Win32FileAttributeData)
+	if !ok {
+		return nil
+	}
+
+	path, err := syscall.UTF16PtrFromString(outputPath)
+	if err != nil {
+		return err
+	}
+	handle, err := syscall.CreateFile(path, syscall.FILE_WRITE_ATTRIBUTES,
+		syscall.FILE_SHARE_READ|syscall.FILE_SHARE_WRITE, nil,
+		syscall.OPEN_EXISTING, syscall.FILE_FLAG_BACKUP_SEMANTICS, 0)
+	if err != nil {
+		return err
+	}
+	defer syscall.CloseHandle(handle)
+
+	return syscall.SetFileTime(handle, &data.CreationTime, nil, nil)
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	goruntime "runtime"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// maxFileNameLength leaves room for the tag, rendition, counter and extension under the 255 limit of file systems
+// Dosya sistemlerinin 255 sınırının altında etiket, sürüm, sayaç ve uzantı için yer bırakır
+const maxFileNameLength = 200
+
+// windowsReservedNames are device names Windows refuses as a file name, with or without an extension
+// Windows'un uzantılı veya uzantısız dosya adı olarak reddettiği aygıt adlarıdır
+var windowsReservedNames = map[string]bool{
+	"CON": true, "PRN": true, "AUX": true, "NUL": true,
+	"COM1": true, "COM2": true, "COM3": true, "COM4": true, "COM5": true, "COM6": true, "COM7": true, "COM8": true, "COM9": true,
+	"LPT1": true, "LPT2": true, "LPT3": true, "LPT4": true, "LPT5": true, "LPT6": true, "LPT7": true, "LPT8": true, "LPT9": true,
+}
+
+// outputNamesMu makes picking and claiming an output name atomic across jobs
+// Bir çıktı adının seçilmesini ve sahiplenilmesini işler arasında atomik yapar
+var outputNamesMu sync.Mutex
+
+// sanitizeFileName removes or replaces invalid characters in a filename
+// Windows rules apply everywhere since outputs often end up on shares Windows reads,
+// the length limit follows the platform: UTF-16 units on Windows, bytes elsewhere
+// Windows kuralları her yerde uygulanır, uzunluk sınırı platformu izler: Windows'ta UTF-16 birimleri, diğerlerinde bayt
+func sanitizeFileName(fileName string) string {
+	// Replace characters Windows forbids and control characters
+	// Windows'un yasakladığı karakterleri ve kontrol karakterlerini değiştir
+	fileName = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`/\:*?"<>|`, r) || unicode.IsControl(r) || r == utf8.RuneError {
+			return '_'
+		}
+		return r
+	}, fileName)
+
+	// Truncate whole runes, never in the middle of one
+	// Bir rünün ortasında değil, tam rünler halinde kısalt
+	fileName = truncateFileName(fileName, maxFileNameLength)
+
+	// Windows drops trailing dots and spaces, leading spaces only cause confusion
+	// Windows sondaki nokta ve boşlukları atar, baştaki boşluklar yalnızca karışıklık yaratır
+	fileName = strings.TrimLeft(fileName, " ")
+	fileName = strings.TrimRight(fileName, ". ")
+
+	// Device names stay reserved whatever the extension, so CON.mkv is invalid too
+	// Aygıt adları uzantıdan bağımsız olarak ayrılmıştır, bu yüzden CON.mkv de geçersizdir
+	stem, rest, _ := strings.Cut(fileName, ".")
+	if windowsReservedNames[strings.ToUpper(strings.TrimRight(stem, " "))] {
+		fileName = stem + "_"
+		if rest != "" {
+			fileName += "." + rest
+		}
+	}
+	return fileName
+}
+
+// truncateFileName shortens a name to limit bytes, or UTF-16 units on Windows, on rune boundaries
+// A dangling zero width joiner or variation selector of a cut emoji is dropped too
+// Kesilen bir emojinin sarkan sıfır genişlikli birleştiricisi veya varyasyon seçicisi de atılır
+func truncateFileName(fileName string, limit int) string {
+	size := 0
+	for i, r := range fileName {
+		width := utf8.RuneLen(r)
+		if goruntime.GOOS == "windows" {
+			width = len(utf16.Encode([]rune{r}))
+		}
+		if size+width > limit {
+			return strings.TrimRight(fileName[:i], "\u200d\ufe0e\ufe0f")
+		}
+		size += width
+	}
+	return fileName
+}
+
+// claimOutputPaths makes the output paths of a job unique within their destination and records them on the job
+// Paths taken by an existing file, a running job or another output of the job get a counter, e.g. "name (2).mkv"
+// Var olan bir dosyanın, çalışan bir işin veya işin başka bir çıktısının aldığı yollara sayaç eklenir
+func (a *App) claimOutputPaths(jobID string, paths []string) []string {
+	outputNamesMu.Lock()
+	defer outputNamesMu.Unlock()
+
+	claimed := make(map[string]bool)
+	for _, job := range a.GetJobs() {
+		if job.ID != jobID && job.Status == "running" {
+			for _, path := range job.OutputPaths {
+				claimed[outputPathKey(path)] = true
+			}
+		}
+	}
+
+	unique := make([]string, len(paths))
+	for i, path := range paths {
+		unique[i] = uniqueOutputPath(path, func(candidate string) bool {
+			if claimed[outputPathKey(candidate)] {
+				return true
+			}
+			if isRemoteDestination(candidate) {
+				return false
+			}
+			_, err := os.Lstat(candidate)
+			return err == nil
+		})
+		claimed[outputPathKey(unique[i])] = true
+	}
+
+	a.updateJob(jobID, func(job *Job) {
+		job.OutputPath, job.OutputPaths = unique[0], unique
+	})
+	return unique
+}
+
+// uniqueOutputPath returns path, or path with the first free counter before its extension
+// path'i veya uzantısından önce ilk boş sayacı eklenmiş path'i döndürür
+func uniqueOutputPath(path string, taken func(string) bool) string {
+	if !taken(path) {
+		return path
+	}
+	extension := filepath.Ext(path)
+	base := strings.TrimSuffix(path, extension)
+	for n := 2; ; n++ {
+		if candidate := fmt.Sprintf("%s (%d)%s", base, n, extension); !taken(candidate) {
+			return candidate
+		}
+	}
+}
+
+// outputPathKey compares paths the way the file system does, case-insensitively on Windows and macOS
+// Yolları dosya sisteminin yaptığı gibi karşılaştırır, Windows ve macOS'ta büyük/küçük harf duyarsız
+func outputPathKey(path string) string {
+	if goruntime.GOOS == "windows" || goruntime.GOOS == "darwin" {
+		return strings.ToLower(path)
+	}
+	return path
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// defaultVideoExtensions lists the file extensions accepted when none are configured
+// Camera formats such as AVCHD (.mts), broadcast MXF and DVD VOB are included, FFmpeg reads them all
+// AVCHD (.mts), yayın MXF ve DVD VOB gibi kamera biçimleri dahildir, FFmpeg hepsini okur
+var defaultVideoExtensions = []string{
+	".mp4", ".mkv", ".avi", ".mov", ".m4v", ".wmv", ".webm", ".flv",
+	".ts", ".mts", ".m2ts", ".mpg", ".mpeg", ".vob", ".mxf", ".3gp",
+}
+
+// GetVideoExtensions returns the file extensions accepted as video inputs
+// Video girişi olarak kabul edilen dosya uzantılarını döndürür
+func (a *App) GetVideoExtensions() []string {
+	return a.videoExtensions()
+}
+
+// videoExtensions returns the configured extensions, or the defaults if none are set
+// Yapılandırılmış uzantıları, hiçbiri ayarlanmamışsa varsayılanları döndürür
+func (a *App) videoExtensions() []string {
+	if len(a.currentPreferences().VideoExtensions) == 0 {
+		return defaultVideoExtensions
+	}
+	return a.currentPreferences().VideoExtensions
+}
+
+// isVideoFile reports whether a file has one of the accepted extensions
+// Bir dosyanın kabul edilen uzantılardan birine sahip olup olmadığını bildirir
+func (a *App) isVideoFile(path string) bool {
+	return containsString(a.videoExtensions(), strings.ToLower(filepath.Ext(path)))
+}
+
+// videoFilePattern builds the file dialog pattern of the extensions
+// Both cases are listed since GTK matches patterns case-sensitively and cameras write .MTS
+// GTK desenleri büyük/küçük harfe duyarlı eşleştirdiği ve kameralar .MTS yazdığı için iki durum da listelenir
+func videoFilePattern(extensions []string) string {
+	patterns := make([]string, 0, len(extensions)*2)
+	for _, ext := range extensions {
+		patterns = append(patterns, "*"+ext, "*"+strings.ToUpper(ext))
+	}
+	return strings.Join(patterns, ";")
+}
+
+// fileFilter struct
+// Represents a file type choice of a file dialog
+// Bir dosya iletişim kutusunun dosya türü seçeneğini temsil eder
+type fileFilter struct {
+	name    string // Shown in the dialog / İletişim kutusunda gösterilir
+	pattern string // Semicolon separated globs / Noktalı virgülle ayrılmış desenler
+}
+
+// validateExtensions checks the configured video or audio extensions
+// Extensions are stored lowercase with their leading dot
+// Uzantılar baştaki noktalarıyla küçük harf olarak saklanır
+func validateExtensions(kind string, extensions []string) error {
+	for _, ext := range extensions {
+		if len(ext) < 2 || ext[0] != '.' || ext != strings.ToLower(ext) || strings.ContainsAny(ext, ";*?/\\ ") {
+			return fmt.Errorf("invalid %s extension %q, use a lowercase extension like .mts", kind, ext)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"os/exec"
+	"strconv"
+
+	"AV1-video-converter/internal/runner"
+)
+
+// FrameImage struct
+// Represents a single decoded frame
+// Çözülmüş tek bir kareyi temsil eder
+type FrameImage struct {
+	Time    float64 `json:"time"`    // Requested time in seconds / Saniye cinsinden istenen zaman
+	DataURL string  `json:"dataURL"` // PNG image as a data URL / Veri URL'si olarak PNG resim
+}
+
+// GetFrameAt decodes the frame shown at a timestamp of a file
+// Input seeking decodes from the previous keyframe, so the frame is exact rather than the nearest keyframe
+// Giriş araması önceki anahtar kareden çözer, böylece kare en yakın anahtar kare değil tam olarak o karedir
+func (a *App) GetFrameAt(path string, timestamp float64) (FrameImage, error) {
+	frame := FrameImage{Time: timestamp}
+	if timestamp < 0 {
+		return frame, fmt.Errorf("timestamp must not be negative")
+	}
+
+	cmd := exec.CommandContext(a.baseContext(), a.ffmpegPath,
+		"-hide_banner", "-nostats", "-loglevel", "error",
+		"-ss", strconv.FormatFloat(timestamp, 'f', 6, 64),
+		"-i", path,
+		"-map", "0:v:0",
+		"-frames:v", "1",
+		"-f", "image2pipe", "-c:v", "png",
+		"-")
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err := runner.Run(cmd); err != nil {
+		log.Printf("Error decoding frame of %s at %.3f: %v: %s", path, timestamp, err, stderr.String())
+		return frame, fmt.Errorf("failed to decode frame: %v", err)
+	}
+	if stdout.Len() == 0 {
+		return frame, fmt.Errorf("no frame at %.3f seconds", timestamp)
+	}
+
+	frame.DataURL = "data:image/png;base64," + base64.StdEncoding.EncodeToString(stdout.Bytes())
+	return frame, nil
+}
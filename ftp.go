@@ -0,0 +1,274 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"net/textproto"
+	"os"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ftpDialTimeout bounds connecting to an FTP server
+// Bir FTP sunucusuna bağlanmayı sınırlar
+const ftpDialTimeout = 30 * time.Second
+
+// pasvRegex matches the port of a PASV reply, e.g. "(192,168,1,2,195,80)"
+// Bir PASV yanıtının bağlantı noktasıyla eşleşir
+var pasvRegex = regexp.MustCompile(`\((\d+),(\d+),(\d+),(\d+),(\d+),(\d+)\)`)
+
+// ftpClient struct
+// Represents a control connection to an FTP or FTPS server
+// Bir FTP veya FTPS sunucusuna kontrol bağlantısını temsil eder
+type ftpClient struct {
+	conn      net.Conn
+	text      *textproto.Conn
+	host      string
+	tlsConfig *tls.Config // nil for plain FTP / Düz FTP için nil
+}
+
+// dialFTP connects and logs in, FTPS uses explicit TLS (AUTH TLS)
+// Bağlanır ve oturum açar, FTPS açık TLS (AUTH TLS) kullanır
+func dialFTP(ctx context.Context, remote RemoteConnection) (*ftpClient, error) {
+	port := remote.Port
+	if port == 0 {
+		port = 21
+	}
+	dialer := net.Dialer{Timeout: ftpDialTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(remote.Host, strconv.Itoa(port)))
+	if err != nil {
+		return nil, err
+	}
+
+	client := &ftpClient{conn: conn, text: textproto.NewConn(conn), host: remote.Host}
+	if _, _, err := client.text.ReadResponse(220); err != nil {
+		client.close()
+		return nil, err
+	}
+
+	if remote.Protocol == "ftps" {
+		if _, err := client.cmd(234, "AUTH TLS"); err != nil {
+			client.close()
+			return nil, err
+		}
+		client.tlsConfig = &tls.Config{ServerName: remote.Host, ClientSessionCache: tls.NewLRUClientSessionCache(4)}
+		tlsConn := tls.Client(conn, client.tlsConfig)
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			client.close()
+			return nil, err
+		}
+		client.conn, client.text = tlsConn, textproto.NewConn(tlsConn)
+		if _, err := client.cmd(200, "PBSZ 0"); err != nil {
+			client.close()
+			return nil, err
+		}
+		if _, err := client.cmd(200, "PROT P"); err != nil {
+			client.close()
+			return nil, err
+		}
+	}
+
+	code, err := client.cmd(0, "USER %s", remote.Username)
+	if err == nil && code == 331 {
+		_, err = client.cmd(230, "PASS %s", remote.Password)
+	} else if err == nil && code != 230 {
+		err = fmt.Errorf("unexpected reply %d to USER", code)
+	}
+	if err == nil {
+		_, err = client.cmd(200, "TYPE I")
+	}
+	if err != nil {
+		client.close()
+		return nil, fmt.Errorf("FTP login failed: %v", err)
+	}
+	return client, nil
+}
+
+// cmd sends a command and reads its reply, expect 0 accepts any code
+// Bir komut gönderir ve yanıtını okur, expect 0 her kodu kabul eder
+func (c *ftpClient) cmd(expect int, format string, args ...interface{}) (int, error) {
+	id, err := c.text.Cmd(format, args...)
+	if err != nil {
+		return 0, err
+	}
+	c.text.StartResponse(id)
+	defer c.text.EndResponse(id)
+	code, _, err := c.text.ReadResponse(expect)
+	return code, err
+}
+
+// size returns the size of a remote file, -1 if it doesn't exist
+// Uzak bir dosyanın boyutunu döndürür, yoksa -1
+func (c *ftpClient) size(name string) int64 {
+	id, err := c.text.Cmd("SIZE %s", name)
+	if err != nil {
+		return -1
+	}
+	c.text.StartResponse(id)
+	defer c.text.EndResponse(id)
+	_, message, err := c.text.ReadResponse(213)
+	if err != nil {
+		return -1
+	}
+	size, err := strconv.ParseInt(strings.TrimSpace(message), 10, 64)
+	if err != nil {
+		return -1
+	}
+	return size
+}
+
+// mkdirAll creates every folder of a remote path, existing ones are ignored
+// Uzak bir yolun her klasörünü oluşturur, var olanlar yok sayılır
+func (c *ftpClient) mkdirAll(dir string) {
+	current := ""
+	for _, part := range strings.Split(strings.Trim(dir, "/"), "/") {
+		if part == "" {
+			continue
+		}
+		current += "/" + part
+		c.cmd(0, "MKD %s", current)
+	}
+}
+
+// store uploads a local file, appending from offset when resuming
+// Yerel bir dosyayı yükler, devam ederken offset konumundan ekler
+func (c *ftpClient) store(ctx context.Context, localPath, remotePath string, offset int64, progress func(sent int64)) error {
+	file, err := os.Open(localPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+
+	data, err := c.openDataConn(ctx)
+	if err != nil {
+		return err
+	}
+	defer data.Close()
+
+	if offset > 0 {
+		if _, err := c.cmd(350, "REST %d", offset); err != nil {
+			return fmt.Errorf("server can't resume: %v", err)
+		}
+	}
+	if _, err := c.cmd(1, "STOR %s", remotePath); err != nil {
+		return err
+	}
+
+	sent := offset
+	for {
+		if err := ctx.Err(); err != nil {
+			return context.Cause(ctx)
+		}
+		n, err := io.CopyN(data, file, copyChunkSize)
+		sent += n
+		progress(sent)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	// Closing the data connection ends the transfer
+	// Veri bağlantısını kapatmak aktarımı bitirir
+	if err := data.Close(); err != nil {
+		return err
+	}
+	_, _, err = c.text.ReadResponse(2)
+	return err
+}
+
+// openDataConn opens a passive data connection
+// The host of the control connection is used, the PASV address is often wrong behind NAT
+// Kontrol bağlantısının sunucusu kullanılır, PASV adresi NAT arkasında çoğu zaman yanlıştır
+func (c *ftpClient) openDataConn(ctx context.Context) (net.Conn, error) {
+	id, err := c.text.Cmd("PASV")
+	if err != nil {
+		return nil, err
+	}
+	c.text.StartResponse(id)
+	_, message, err := c.text.ReadResponse(227)
+	c.text.EndResponse(id)
+	if err != nil {
+		return nil, err
+	}
+	match := pasvRegex.FindStringSubmatch(message)
+	if match == nil {
+		return nil, fmt.Errorf("unexpected PASV reply: %s", message)
+	}
+	high, _ := strconv.Atoi(match[5])
+	low, _ := strconv.Atoi(match[6])
+
+	dialer := net.Dialer{Timeout: ftpDialTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(c.host, strconv.Itoa(high*256+low)))
+	if err != nil {
+		return nil, err
+	}
+	if c.tlsConfig != nil {
+		return tls.Client(conn, c.tlsConfig), nil
+	}
+	return conn, nil
+}
+
+// rename replaces a remote file with another one
+// Uzak bir dosyayı başka biriyle değiştirir
+func (c *ftpClient) rename(from, to string) error {
+	c.cmd(0, "DELE %s", to)
+	if _, err := c.cmd(350, "RNFR %s", from); err != nil {
+		return err
+	}
+	_, err := c.cmd(250, "RNTO %s", to)
+	return err
+}
+
+// close ends the session
+// Oturumu sonlandırır
+func (c *ftpClient) close() {
+	c.cmd(0, "QUIT")
+	c.conn.Close()
+}
+
+// ftpUpload uploads a file over FTP, resuming a previous partial upload
+// The size of the result is compared with the local file
+// Sonucun boyutu yerel dosyayla karşılaştırılır
+func ftpUpload(ctx context.Context, remote RemoteConnection, localPath, remotePath string, progress func(sent, total int64)) error {
+	stat, err := os.Stat(localPath)
+	if err != nil {
+		return err
+	}
+
+	client, err := dialFTP(ctx, remote)
+	if err != nil {
+		return err
+	}
+	defer client.close()
+
+	// Unblock reads and writes when the job is cancelled
+	// İş iptal edildiğinde okuma ve yazmaların kilidini aç
+	stop := context.AfterFunc(ctx, func() { client.conn.Close() })
+	defer stop()
+
+	client.mkdirAll(path.Dir(remotePath))
+	partPath := remotePath + partialSuffix
+	offset := client.size(partPath)
+	if offset < 0 || offset > stat.Size() {
+		offset = 0
+	}
+	if err := client.store(ctx, localPath, partPath, offset, func(sent int64) { progress(sent, stat.Size()) }); err != nil {
+		return err
+	}
+	if size := client.size(partPath); size >= 0 && size != stat.Size() {
+		return fmt.Errorf("size mismatch after upload: %d of %d bytes", size, stat.Size())
+	}
+	return client.rename(partPath, remotePath)
+}
@@ -0,0 +1,349 @@
+package main
+
+//go:generate protoc -I proto --go_out=. --go_opt=module=AV1-video-converter --go-grpc_out=. --go-grpc_opt=module=AV1-video-converter converter.proto
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"time"
+
+	"AV1-video-converter/internal/converterpb"
+	"AV1-video-converter/internal/events"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// defaultGRPCAddress keeps the gRPC service reachable from this machine only
+// gRPC hizmetini yalnızca bu makineden erişilebilir tutar
+const defaultGRPCAddress = "127.0.0.1:8766"
+
+// grpcAPIKeyHeader is the metadata key every gRPC call sends the API key in
+// Her gRPC çağrısının API anahtarını gönderdiği meta veri anahtarıdır
+const grpcAPIKeyHeader = "x-api-key"
+
+// converterService struct
+// Serves the Converter gRPC service of proto/converter.proto on the job queue of the app
+// proto/converter.proto dosyasındaki Converter gRPC hizmetini uygulamanın iş kuyruğu üzerinde sunar
+type converterService struct {
+	converterpb.UnimplementedConverterServer
+	app *App
+}
+
+// startGRPC serves the gRPC service next to the HTTP API, an empty address leaves it off
+// Boş bir adres onu kapalı bırakır
+func (a *App) startGRPC(preferences AppPreferences) error {
+	if preferences.GRPCAddress == "" {
+		return nil
+	}
+
+	listener, err := net.Listen("tcp", preferences.GRPCAddress)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %v", preferences.GRPCAddress, err)
+	}
+	server := grpc.NewServer(
+		grpc.UnaryInterceptor(a.requireGRPCKey),
+		grpc.StreamInterceptor(a.requireGRPCStreamKey),
+	)
+	converterpb.RegisterConverterServer(server, &converterService{app: a})
+
+	a.apiMu.Lock()
+	a.grpc = server
+	a.apiMu.Unlock()
+
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			log.Printf("gRPC server error: %v", err)
+		}
+	}()
+	log.Printf("gRPC service listening on %s", listener.Addr())
+	return nil
+}
+
+// stopGRPC stops the gRPC service if it is running
+// Watch streams never end on their own, they are cut off after apiShutdownTimeout
+// İzleme akışları kendiliğinden bitmez, apiShutdownTimeout sonunda kesilirler
+func (a *App) stopGRPC() {
+	a.apiMu.Lock()
+	server := a.grpc
+	a.grpc = nil
+	a.apiMu.Unlock()
+	if server == nil {
+		return
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(apiShutdownTimeout):
+		server.Stop()
+	}
+}
+
+// requireGRPCKey rejects unary calls without the configured API key
+// Yapılandırılmış API anahtarı olmayan tekli çağrıları reddeder
+func (a *App) requireGRPCKey(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := a.checkGRPCKey(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, request)
+}
+
+// requireGRPCStreamKey rejects streams without the configured API key
+// Yapılandırılmış API anahtarı olmayan akışları reddeder
+func (a *App) requireGRPCStreamKey(server interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := a.checkGRPCKey(stream.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(server, stream)
+}
+
+// checkGRPCKey compares the x-api-key metadata of a call with the API key of the preferences
+// Bir çağrının x-api-key meta verisini tercihlerdeki API anahtarıyla karşılaştırır
+func (a *App) checkGRPCKey(ctx context.Context, method string) error {
+	key := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(grpcAPIKeyHeader); len(values) > 0 {
+			key = values[0]
+		}
+	}
+	if subtle.ConstantTimeCompare([]byte(key), []byte(a.currentPreferences().APIKey)) != 1 {
+		log.Printf("Rejected gRPC call to %s", method)
+		return status.Error(codes.Unauthenticated, "invalid API key")
+	}
+	return nil
+}
+
+// Enqueue probes a local file and queues it like POST /api/jobs
+// POST /api/jobs gibi yerel bir dosyayı inceler ve kuyruğa ekler
+func (s *converterService) Enqueue(ctx context.Context, request *converterpb.EnqueueRequest) (*converterpb.Job, error) {
+	job, err := s.app.enqueue(enqueueRequest{
+		InputPath:    request.GetInputPath(),
+		OutputFolder: request.GetOutputFolder(),
+		Profile:      request.GetProfile(),
+	})
+	if errors.Is(err, errInvalidEnqueue) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return jobToProto(*job), nil
+}
+
+// Watch streams the timeline events like GET /api/watch
+// GET /api/watch gibi zaman çizelgesi olaylarını akıtır
+func (s *converterService) Watch(request *converterpb.WatchRequest, stream converterpb.Converter_WatchServer) error {
+	jobID := request.GetJobId()
+	if jobID != "" {
+		if _, ok := s.app.getJob(jobID); !ok {
+			return status.Errorf(codes.NotFound, "unknown job: %s", jobID)
+		}
+	}
+
+	envelopes, unsubscribe := s.app.watchers.Subscribe(watchBufferSize)
+	defer unsubscribe()
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case envelope := <-envelopes:
+			if jobID != "" && envelope.JobID != jobID {
+				continue
+			}
+			if err := stream.Send(envelopeToProto(envelope)); err != nil {
+				return err
+			}
+			if jobID != "" && (envelope.Type == events.JobCompleted || envelope.Type == events.JobFailed) {
+				return nil
+			}
+		}
+	}
+}
+
+// Cancel stops a running job like POST /api/jobs/{id}/cancel
+// POST /api/jobs/{id}/cancel gibi çalışan bir işi durdurur
+func (s *converterService) Cancel(ctx context.Context, request *converterpb.CancelRequest) (*converterpb.CancelResponse, error) {
+	jobID := request.GetJobId()
+	if _, ok := s.app.getJob(jobID); !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown job: %s", jobID)
+	}
+	if err := s.app.CancelConversion(jobID); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return &converterpb.CancelResponse{Status: "cancelling"}, nil
+}
+
+// GetHistory returns the conversion history like GET /api/history
+// GET /api/history gibi dönüştürme geçmişini döndürür
+func (s *converterService) GetHistory(ctx context.Context, request *converterpb.HistoryRequest) (*converterpb.HistoryResponse, error) {
+	history, err := s.app.SearchHistory(HistoryQuery{
+		Text:   request.GetText(),
+		Tag:    request.GetTag(),
+		Status: request.GetStatus(),
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	response := &converterpb.HistoryResponse{Entries: make([]*converterpb.HistoryEntry, 0, len(history))}
+	for _, entry := range history {
+		response.Entries = append(response.Entries, historyToProto(entry))
+	}
+	return response, nil
+}
+
+// jobToProto converts a job to its gRPC message
+// Bir işi gRPC mesajına dönüştürür
+func jobToProto(job Job) *converterpb.Job {
+	return &converterpb.Job{
+		Id:           job.ID,
+		InputPath:    job.InputPath,
+		OutputFolder: job.OutputFolder,
+		OutputPath:   job.OutputPath,
+		OutputPaths:  job.OutputPaths,
+		Status:       job.Status,
+		Error:        job.Error,
+		TotalFrames:  int64(job.TotalFrames),
+		Settings:     settingsToProto(job.Settings),
+		CreatedAt:    timestampToProto(job.CreatedAt),
+		Notes:        job.Notes,
+		Tags:         job.Tags,
+	}
+}
+
+// settingsToProto converts the settings a client sees to their gRPC message
+// İstemcinin gördüğü ayarları gRPC mesajına dönüştürür
+func settingsToProto(settings ConversionSettings) *converterpb.Settings {
+	return &converterpb.Settings{
+		Encoder:   settings.Encoder,
+		Crf:       int32(settings.CRF),
+		Preset:    settings.Preset,
+		Container: settings.Container,
+	}
+}
+
+// historyToProto converts a history entry to its gRPC message, an unmeasured VMAF stays unset
+// Bir geçmiş kaydını gRPC mesajına dönüştürür, ölçülmemiş bir VMAF boş kalır
+func historyToProto(entry HistoryEntry) *converterpb.HistoryEntry {
+	message := &converterpb.HistoryEntry{
+		JobId:      entry.JobID,
+		InputPath:  entry.InputPath,
+		OutputPath: entry.OutputPath,
+		Status:     entry.Status,
+		Error:      entry.Error,
+		Settings:   settingsToProto(entry.Settings),
+		InputSize:  entry.InputSize,
+		OutputSize: entry.OutputSize,
+		Duration:   entry.Duration,
+		StartedAt:  timestampToProto(entry.StartedAt),
+		FinishedAt: timestampToProto(entry.FinishedAt),
+		Notes:      entry.Notes,
+		Tags:       entry.Tags,
+	}
+	if entry.VMAF != 0 {
+		message.Vmaf = proto.Float64(entry.VMAF)
+	}
+	return message
+}
+
+// envelopeToProto converts a timeline event to its gRPC message with the typed payload
+// Bir zaman çizelgesi olayını türlü yüküyle gRPC mesajına dönüştürür
+func envelopeToProto(envelope events.Envelope) *converterpb.Event {
+	event := &converterpb.Event{
+		Type:    envelope.Type,
+		JobId:   envelope.JobID,
+		Time:    timestampToProto(envelope.Time),
+		Version: int32(envelope.Version),
+	}
+	switch data := envelope.Data.(type) {
+	case JobAddedEvent:
+		event.Payload = &converterpb.Event_Added{Added: jobToProto(data.Job)}
+	case JobStartedEvent:
+		event.Payload = &converterpb.Event_Started_{Started: &converterpb.Event_Started{InputPath: data.InputPath}}
+	case JobProgressEvent:
+		event.Payload = &converterpb.Event_Progress_{Progress: &converterpb.Event_Progress{
+			Phase: data.Phase, Percent: data.Percent, Speed: data.Speed,
+		}}
+	case JobPhaseEvent:
+		event.Payload = &converterpb.Event_Phase_{Phase: &converterpb.Event_Phase{Phase: data.Phase, Seconds: data.Seconds}}
+	case JobLogEvent:
+		event.Payload = &converterpb.Event_Log_{Log: &converterpb.Event_Log{Stage: data.Stage, Message: data.Message}}
+	case JobWarningEvent:
+		event.Payload = &converterpb.Event_Warning_{Warning: &converterpb.Event_Warning{Message: data.Message}}
+	case JobStalledEvent:
+		event.Payload = &converterpb.Event_Stalled_{Stalled: &converterpb.Event_Stalled{
+			Percent: data.Percent, Seconds: int32(data.Seconds), Recover: data.Recover,
+		}}
+	case JobRetryEvent:
+		event.Payload = &converterpb.Event_Retry_{Retry: &converterpb.Event_Retry{Attempt: int32(data.Attempt), Reason: data.Reason}}
+	case JobCompletedEvent:
+		event.Payload = &converterpb.Event_Completed_{Completed: &converterpb.Event_Completed{
+			OutputPath: data.OutputPath, OutputPaths: data.OutputPaths,
+		}}
+	case JobFailedEvent:
+		event.Payload = &converterpb.Event_Failed_{Failed: &converterpb.Event_Failed{Error: data.Error}}
+	case JobPausedEvent:
+		event.Payload = &converterpb.Event_Paused_{Paused: &converterpb.Event_Paused{
+			Reason: data.Reason, Message: data.Message, Temperature: data.Temperature, ResumeAt: data.ResumeAt,
+			Folder: data.Folder, FreeGb: data.FreeGB, MinFreeGb: int32(data.MinFreeGB),
+		}}
+	case JobResumedEvent:
+		event.Payload = &converterpb.Event_Resumed_{Resumed: &converterpb.Event_Resumed{Reason: data.Reason, Message: data.Message}}
+	case UploadProgressEvent:
+		event.Payload = &converterpb.Event_UploadProgress_{UploadProgress: &converterpb.Event_UploadProgress{
+			Path: data.Path, Percent: data.Percent,
+		}}
+	case UploadCompletedEvent:
+		event.Payload = &converterpb.Event_UploadCompleted_{UploadCompleted: &converterpb.Event_UploadCompleted{
+			Path: data.Path, Bucket: data.Bucket, Key: data.Key,
+		}}
+	case UploadFailedEvent:
+		event.Payload = &converterpb.Event_UploadFailed_{UploadFailed: &converterpb.Event_UploadFailed{Path: data.Path, Error: data.Error}}
+	case QueueSortedEvent:
+		event.Payload = &converterpb.Event_QueueOrder_{QueueOrder: &converterpb.Event_QueueOrder{
+			Key: data.Key, Descending: data.Descending, JobIds: data.JobIDs,
+		}}
+	case QueueUpdatedEvent:
+		event.Payload = &converterpb.Event_Queue{Queue: &converterpb.Event_QueueCounts{
+			Queued: int32(data.Queued), Running: int32(data.Running), Completed: int32(data.Completed), Failed: int32(data.Failed),
+		}}
+	}
+	return event
+}
+
+// timestampToProto converts a time, the zero time stays unset
+// Bir zamanı dönüştürür, sıfır zaman boş kalır
+func timestampToProto(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// validGRPCAddress checks the gRPC address of the preferences, empty turns the service off
+// Tercihlerdeki gRPC adresini kontrol eder, boş olması hizmeti kapatır
+func validGRPCAddress(preferences AppPreferences) error {
+	if preferences.GRPCAddress == "" {
+		return nil
+	}
+	if _, _, err := net.SplitHostPort(preferences.GRPCAddress); err != nil {
+		return fmt.Errorf("gRPC address must be host:port: %v", err)
+	}
+	if strings.EqualFold(preferences.GRPCAddress, preferences.APIAddress) {
+		return fmt.Errorf("gRPC address must differ from the API address")
+	}
+	return nil
+}
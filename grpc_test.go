@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"AV1-video-converter/internal/converterpb"
+	"AV1-video-converter/internal/events"
+	"AV1-video-converter/internal/runner"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// testGRPCKey is the API key of the gRPC tests
+// gRPC testlerinin API anahtarıdır
+const testGRPCKey = "0123456789abcdef"
+
+// newGRPCClient serves the converter service of a test app in memory and returns a client for it
+// Bir test uygulamasının dönüştürücü hizmetini bellekte sunar ve ona bir istemci döndürür
+func newGRPCClient(t *testing.T, a *App) converterpb.ConverterClient {
+	t.Helper()
+	a.updatePreferences(func(preferences *AppPreferences) { preferences.APIKey = testGRPCKey })
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(
+		grpc.UnaryInterceptor(a.requireGRPCKey),
+		grpc.StreamInterceptor(a.requireGRPCStreamKey),
+	)
+	converterpb.RegisterConverterServer(server, &converterService{app: a})
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return converterpb.NewConverterClient(conn)
+}
+
+// TestGRPCService queues, watches and finds a job in the history through the gRPC service
+// gRPC hizmeti üzerinden bir işi kuyruğa ekler, izler ve geçmişte bulur
+func TestGRPCService(t *testing.T) {
+	a := newTestApp(t, &runner.Fake{Statuses: frames(50, 100), Interval: 50 * time.Millisecond})
+	a.events = events.Tee(a.recorder, a.watchers)
+	client := newGRPCClient(t, a.App)
+	source, _ := a.getJob(a.jobID)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if _, err := client.GetHistory(ctx, &converterpb.HistoryRequest{}); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("GetHistory without a key = %v, want Unauthenticated", err)
+	}
+	ctx = metadata.AppendToOutgoingContext(ctx, grpcAPIKeyHeader, testGRPCKey)
+
+	if _, err := client.Enqueue(ctx, &converterpb.EnqueueRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Enqueue without an input = %v, want InvalidArgument", err)
+	}
+	job, err := client.Enqueue(ctx, &converterpb.EnqueueRequest{InputPath: source.InputPath, OutputFolder: source.OutputFolder})
+	if err != nil {
+		t.Fatalf("Enqueue: %v", err)
+	}
+	if job.GetStatus() != "queued" || job.GetTotalFrames() != 100 {
+		t.Errorf("Enqueue = %+v, want a queued job of 100 frames", job)
+	}
+
+	stream, err := client.Watch(ctx, &converterpb.WatchRequest{})
+	if err != nil {
+		t.Fatalf("Watch: %v", err)
+	}
+	// The stream subscribes on the server side, announce the queue until the first event arrives
+	// Akış sunucu tarafında abone olur, ilk olay gelene kadar kuyruğu duyur
+	subscribed := make(chan struct{})
+	go func() {
+		for {
+			a.emitQueueUpdated()
+			select {
+			case <-subscribed:
+				return
+			case <-time.After(20 * time.Millisecond):
+			}
+		}
+	}()
+	if _, err := stream.Recv(); err != nil {
+		t.Fatalf("Recv: %v", err)
+	}
+	close(subscribed)
+
+	go a.ConvertVideo(job.GetId())
+	progressed := false
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("Recv: %v", err)
+		}
+		if event.GetJobId() != job.GetId() {
+			continue
+		}
+		if event.GetProgress() != nil {
+			progressed = true
+		}
+		if event.GetType() == events.JobFailed {
+			t.Fatalf("job failed: %s", event.GetFailed().GetError())
+		}
+		if event.GetType() == events.JobCompleted {
+			if event.GetCompleted().GetOutputPath() == "" {
+				t.Errorf("completed event without an output: %+v", event)
+			}
+			break
+		}
+	}
+	if !progressed {
+		t.Error("no progress event was streamed")
+	}
+
+	if _, err := client.Cancel(ctx, &converterpb.CancelRequest{JobId: "missing"}); status.Code(err) != codes.NotFound {
+		t.Errorf("Cancel of an unknown job = %v, want NotFound", err)
+	}
+	history, err := client.GetHistory(ctx, &converterpb.HistoryRequest{Status: "completed"})
+	if err != nil {
+		t.Fatalf("GetHistory: %v", err)
+	}
+	if entries := history.GetEntries(); len(entries) != 1 || entries[0].GetJobId() != job.GetId() || entries[0].Vmaf != nil {
+		t.Errorf("GetHistory = %+v, want the completed job without a VMAF", entries)
+	}
+}
@@ -0,0 +1,185 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"os/exec"
+	goruntime "runtime"
+	"strings"
+)
+
+// GPUInfo struct
+// Represents a graphics adapter found on the system
+// Sistemde bulunan bir grafik bağdaştırıcısını temsil eder
+type GPUInfo struct {
+	Vendor string `json:"vendor"` // GPU vendor (nvidia, intel, amd, apple) / GPU üreticisi
+	Model  string `json:"model"`  // GPU model name / GPU model adı
+}
+
+// HardwareEncoder struct
+// Represents a hardware AV1 encoder and whether it can be used
+// Bir donanım AV1 kodlayıcısını ve kullanılabilir olup olmadığını temsil eder
+type HardwareEncoder struct {
+	Name      string `json:"name"`      // FFmpeg encoder name / FFmpeg kodlayıcı adı
+	Vendor    string `json:"vendor"`    // Vendor the encoder belongs to / Kodlayıcının ait olduğu üretici
+	Available bool   `json:"available"` // Encoder is compiled into FFmpeg / Kodlayıcı FFmpeg içinde derlenmiş
+	Usable    bool   `json:"usable"`    // Test encode succeeded / Test kodlaması başarılı
+	Reason    string `json:"reason"`    // Why the encoder is not usable / Kodlayıcının neden kullanılamadığı
+}
+
+// HardwareInfo struct
+// Represents the detected GPUs and hardware AV1 encoders
+// Algılanan GPU'ları ve donanım AV1 kodlayıcılarını temsil eder
+type HardwareInfo struct {
+	GPUs     []GPUInfo         `json:"gpus"`     // Detected graphics adapters / Algılanan grafik bağdaştırıcıları
+	Encoders []HardwareEncoder `json:"encoders"` // Hardware AV1 encoders / Donanım AV1 kodlayıcıları
+}
+
+// hardwareEncoders lists the hardware AV1 encoders known to FFmpeg
+// NVENC requires an RTX 40-series GPU, QSV an Intel Arc GPU, AMF an RDNA3 GPU
+// NVENC RTX 40 serisi, QSV Intel Arc, AMF ise RDNA3 GPU gerektirir
+var hardwareEncoders = []HardwareEncoder{
+	{Name: "av1_nvenc", Vendor: "nvidia"},
+	{Name: "av1_qsv", Vendor: "intel"},
+	{Name: "av1_amf", Vendor: "amd"},
+	{Name: "av1_vaapi", Vendor: "vaapi"},
+}
+
+// DetectHardware reports the GPUs and hardware AV1 encoders of the system
+// Checks which hardware encoders are compiled into FFmpeg and actually work
+// Hangi donanım kodlayıcılarının FFmpeg'de bulunduğunu ve gerçekten çalıştığını kontrol eder
+func (a *App) DetectHardware() HardwareInfo {
+	info := HardwareInfo{
+		GPUs: detectGPUs(),
+	}
+
+	// Get the encoder list of the bundled FFmpeg
+	// Paketlenmiş FFmpeg'in kodlayıcı listesini al
+	encoderList, err := exec.Command(a.ffmpegPath, "-hide_banner", "-encoders").Output()
+	if err != nil {
+		log.Printf("Error listing FFmpeg encoders: %v", err)
+	}
+
+	for _, encoder := range hardwareEncoders {
+		encoder.Available = strings.Contains(string(encoderList), " "+encoder.Name+" ")
+		if !encoder.Available {
+			encoder.Reason = "not included in this FFmpeg build"
+		} else if err := a.testEncoder(encoder.Name); err != nil {
+			encoder.Reason = err.Error()
+		} else {
+			encoder.Usable = true
+		}
+		log.Printf("Hardware encoder %s: available=%t usable=%t %s", encoder.Name, encoder.Available, encoder.Usable, encoder.Reason)
+		info.Encoders = append(info.Encoders, encoder)
+	}
+
+	// Return the hardware information to the frontend
+	// Donanım bilgilerini Frontend'e gönder
+	return info
+}
+
+// testEncoder runs a tiny test encode with the given encoder
+// A compiled-in encoder may still fail without a supported GPU or driver
+// Derlenmiş bir kodlayıcı, desteklenen GPU veya sürücü olmadan yine de başarısız olabilir
+func (a *App) testEncoder(name string) error {
+	args := []string{"-hide_banner", "-loglevel", "error"}
+	if name == "av1_vaapi" {
+		args = append(args, "-vaapi_device", "/dev/dri/renderD128")
+	}
+	args = append(args, "-f", "lavfi", "-i", "color=black:s=256x256:d=0.1")
+	if name == "av1_vaapi" {
+		args = append(args, "-vf", "format=nv12,hwupload")
+	}
+	args = append(args, "-frames:v", "1", "-c:v", name, "-f", "null", "-")
+
+	cmd := exec.Command(a.ffmpegPath, args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if line := firstLine(stderr.String()); line != "" {
+			return fmt.Errorf("test encode failed: %s", line)
+		}
+		return fmt.Errorf("test encode failed: %v", err)
+	}
+	return nil
+}
+
+// detectGPUs lists the graphics adapters using platform tools
+// Platform araçlarını kullanarak grafik bağdaştırıcılarını listeler
+func detectGPUs() []GPUInfo {
+	var names []string
+	switch goruntime.GOOS {
+	case "darwin":
+		out, err := exec.Command("system_profiler", "SPDisplaysDataType").Output()
+		if err != nil {
+			log.Printf("Error running system_profiler: %v", err)
+			return nil
+		}
+		for _, line := range strings.Split(string(out), "\n") {
+			line = strings.TrimSpace(line)
+			if strings.HasPrefix(line, "Chipset Model:") {
+				names = append(names, strings.TrimSpace(strings.TrimPrefix(line, "Chipset Model:")))
+			}
+		}
+	case "windows":
+		out, err := exec.Command("powershell", "-NoProfile", "-Command",
+			"Get-CimInstance Win32_VideoController | Select-Object -ExpandProperty Name").Output()
+		if err != nil {
+			log.Printf("Error querying video controllers: %v", err)
+			return nil
+		}
+		for _, line := range strings.Split(string(out), "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				names = append(names, line)
+			}
+		}
+	default:
+		out, err := exec.Command("lspci").Output()
+		if err != nil {
+			log.Printf("Error running lspci: %v", err)
+			return nil
+		}
+		for _, line := range strings.Split(string(out), "\n") {
+			if strings.Contains(line, "VGA compatible controller") || strings.Contains(line, "3D controller") || strings.Contains(line, "Display controller") {
+				if idx := strings.Index(line, ": "); idx >= 0 {
+					names = append(names, strings.TrimSpace(line[idx+2:]))
+				}
+			}
+		}
+	}
+
+	var gpus []GPUInfo
+	for _, name := range names {
+		gpus = append(gpus, GPUInfo{Vendor: gpuVendor(name), Model: name})
+	}
+	return gpus
+}
+
+// gpuVendor guesses the vendor from a GPU model name
+// GPU model adından üreticiyi tahmin eder
+func gpuVendor(model string) string {
+	lower := strings.ToLower(model)
+	switch {
+	case strings.Contains(lower, "nvidia") || strings.Contains(lower, "geforce") || strings.Contains(lower, "quadro"):
+		return "nvidia"
+	case strings.Contains(lower, "intel"):
+		return "intel"
+	case strings.Contains(lower, "amd") || strings.Contains(lower, "radeon") || strings.Contains(lower, "ati "):
+		return "amd"
+	case strings.Contains(lower, "apple"):
+		return "apple"
+	}
+	return "unknown"
+}
+
+// firstLine returns the first non-empty line of a text
+// Bir metnin boş olmayan ilk satırını döndürür
+func firstLine(text string) string {
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			return line
+		}
+	}
+	return ""
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"AV1-video-converter/internal/events"
+	"AV1-video-converter/internal/probe"
+	"AV1-video-converter/internal/runner"
+)
+
+// dynamicHDRModes lists how Dolby Vision and HDR10+ sources are handled
+// static warns and keeps the HDR10 base layer, extract also saves the metadata next to the output, fail refuses
+// static uyarır ve HDR10 temel katmanını korur, extract meta veriyi çıktının yanına da kaydeder, fail reddeder
+var dynamicHDRModes = []string{"static", "extract", "fail"}
+
+// detectHDR10Plus checks the first frames of a PQ source for HDR10+ metadata
+// PQ bir kaynağın ilk karelerinde HDR10+ meta verisi olup olmadığını kontrol eder
+func (a *App) detectHDR10Plus(path string) bool {
+	ctx, cancel := context.WithTimeout(a.baseContext(), time.Duration(a.currentPreferences().ProbeTimeout)*time.Second)
+	defer cancel()
+	args := append(append([]string{}, probe.HDR10PlusArgs...), path)
+	out, err := a.runner.Output(ctx, a.ffprobePath, args...)
+	if err != nil {
+		log.Printf("Error reading frame side data of %s: %v", path, err)
+		return false
+	}
+	return probe.HasHDR10Plus(out)
+}
+
+// handleDynamicHDR deals with dynamic HDR metadata the AV1 encoders can't carry
+// The loss is reported instead of silently stripping the metadata
+// Meta veri sessizce atılmak yerine kayıp bildirilir
+func (a *App) handleDynamicHDR(ctx context.Context, jobID string, plan conversionPlan, remote bool) error {
+	video := plan.Video
+	var kinds []string
+	if video.DolbyVision > 0 {
+		kinds = append(kinds, fmt.Sprintf("Dolby Vision profile %d", video.DolbyVision))
+	}
+	if video.HDR10Plus {
+		kinds = append(kinds, "HDR10+")
+	}
+	if len(kinds) == 0 {
+		return nil
+	}
+	found := strings.Join(kinds, " and ")
+
+	mode := plan.Outputs[0].Settings.DynamicHDR
+	if mode == "fail" {
+		return fmt.Errorf("source has %s metadata the AV1 output can't carry, not converting", found)
+	}
+
+	// Profile 5 has no HDR10 base layer, its colors are only right with the RPU applied
+	// Profil 5'in HDR10 temel katmanı yoktur, renkleri yalnızca RPU uygulanınca doğrudur
+	message := fmt.Sprintf("%s metadata is not carried into the AV1 output, it falls back to static HDR10", found)
+	if video.DolbyVision == 5 {
+		message = "Dolby Vision profile 5 has no HDR10 base layer, the AV1 output will have wrong colors"
+	}
+	log.Printf("Job %s: %s", jobID, message)
+	a.addJobEvent(jobID, "hdr", message)
+	a.emitJobEvent(events.JobWarning, jobID, JobWarningEvent{Message: message})
+
+	if mode != "extract" || remote {
+		return nil
+	}
+	if video.Codec != "hevc" {
+		log.Printf("Job %s: dynamic metadata can only be extracted from HEVC sources, not %s", jobID, video.Codec)
+		return nil
+	}
+
+	// Save the metadata next to each output so it can be injected again once tooling allows
+	// Araçlar izin verdiğinde yeniden eklenebilmesi için meta veriyi her çıktının yanına kaydet
+	for _, output := range plan.Outputs {
+		base := strings.TrimSuffix(output.finalPath(), filepath.Ext(output.finalPath()))
+		if video.DolbyVision > 0 {
+			rpuPath := base + ".rpu.bin"
+			if err := a.extractHEVCMetadata(ctx, plan.InputPath, "dovi_tool", "extract-rpu", "-", "-o", rpuPath); err != nil {
+				log.Printf("Error extracting the Dolby Vision RPU of %s: %v", plan.InputPath, err)
+			} else {
+				a.addJobEvent(jobID, "hdr", "saved the Dolby Vision RPU to "+rpuPath)
+			}
+		}
+		if video.HDR10Plus {
+			jsonPath := base + ".hdr10plus.json"
+			if err := a.extractHEVCMetadata(ctx, plan.InputPath, "hdr10plus_tool", "extract", "-o", jsonPath, "-"); err != nil {
+				log.Printf("Error extracting the HDR10+ metadata of %s: %v", plan.InputPath, err)
+			} else {
+				a.addJobEvent(jobID, "hdr", "saved the HDR10+ metadata to "+jsonPath)
+			}
+		}
+	}
+	return nil
+}
+
+// extractHEVCMetadata pipes the raw HEVC stream of a file into dovi_tool or hdr10plus_tool
+// Bir dosyanın ham HEVC akışını dovi_tool veya hdr10plus_tool'a aktarır
+func (a *App) extractHEVCMetadata(ctx context.Context, inputPath, tool string, args ...string) error {
+	toolPath := a.findExecutable(tool)
+	if toolPath == "" {
+		return fmt.Errorf("%s not found", tool)
+	}
+
+	demux := exec.CommandContext(ctx, a.ffmpegPath,
+		"-hide_banner", "-nostats", "-loglevel", "error",
+		"-i", inputPath,
+		"-map", "0:v:0", "-c:v", "copy",
+		"-bsf:v", "hevc_mp4toannexb",
+		"-f", "hevc", "-")
+	extract := exec.CommandContext(ctx, toolPath, args...)
+	stream, err := demux.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	extract.Stdin = stream
+	var stderr bytes.Buffer
+	extract.Stdout, extract.Stderr = &stderr, &stderr
+
+	if err := runner.Start(demux); err != nil {
+		return fmt.Errorf("failed to start FFmpeg: %v", err)
+	}
+	if err := runner.Run(extract); err != nil {
+		demux.Process.Kill()
+		runner.Wait(demux)
+		return fmt.Errorf("%s failed: %v: %s", tool, err, strings.TrimSpace(stderr.String()))
+	}
+	if err := runner.Wait(demux); err != nil {
+		return fmt.Errorf("failed to demux the HEVC stream: %v", err)
+	}
+	return nil
+}
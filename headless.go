@@ -0,0 +1,194 @@
+//go:build headless
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"runtime/debug"
+	"syscall"
+
+	"AV1-video-converter/internal/events"
+	"AV1-video-converter/internal/runner"
+)
+
+// errNoDesktop is returned by the calls that need a window
+// Pencere gerektiren çağrıların döndürdüğü hatadır
+var errNoDesktop = errors.New("not available in the server build")
+
+// main runs the converter without Wails, driven by the HTTP API and the watch folders
+// Build it with -tags headless, the data folder holds the config, history and logs
+// -tags headless ile derleyin, veri klasörü yapılandırmayı, geçmişi ve logları tutar
+func main() {
+	// Answer the password prompt of an SFTP transfer instead of starting the server
+	// Sunucuyu başlatmak yerine bir SFTP aktarımının parola istemini yanıtla
+	if answerAskpass() {
+		return
+	}
+
+	dataDir := flag.String("data", os.Getenv("AV1_DATA_DIR"), "folder for the config, history and logs, defaults to the executable's folder")
+	apiAddress := flag.String("listen", os.Getenv("AV1_API_ADDRESS"), "address the API listens on, overrides the saved preferences")
+	grpcAddress := flag.String("grpc-listen", os.Getenv("AV1_GRPC_ADDRESS"), "address the gRPC service listens on, overrides the saved preferences")
+	apiKey := flag.String("api-key", os.Getenv("AV1_API_KEY"), "API key, enables the API when set")
+	flag.Parse()
+
+	app := NewApp()
+	app.appDir = *dataDir
+	if app.appDir != "" {
+		if err := os.MkdirAll(app.appDir, 0755); err != nil {
+			log.Fatalf("Error creating data folder: %v", err)
+		}
+	}
+
+	// Record a crash report before a panic takes the server down
+	// Bir panik sunucuyu çökertmeden önce bir çökme raporu kaydet
+	defer func() {
+		if r := recover(); r != nil {
+			app.writeCrashReport("main", r, debug.Stack())
+			runner.KillAll()
+			panic(r)
+		}
+	}()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	app.startup(ctx)
+
+	// Containers collect logs from the standard error stream
+	// Konteynerler logları standart hata akışından toplar
+	log.SetOutput(io.MultiWriter(os.Stderr, app.logFile))
+
+	if *apiAddress != "" || *grpcAddress != "" || *apiKey != "" {
+		app.overrideAPI(*apiAddress, *grpcAddress, *apiKey)
+	}
+	if !app.currentPreferences().APIEnabled {
+		log.Printf("API is disabled, only watch folders queue jobs")
+	}
+
+	app.runServerQueue(ctx)
+	log.Printf("Shutting down")
+	app.shutdown(context.Background())
+}
+
+// overrideAPI applies the API and gRPC addresses and the API key given on the command line
+// They are not saved, the config keeps what the desktop app set
+// Kaydedilmezler, yapılandırma masaüstü uygulamasının ayarladığını korur
+func (a *App) overrideAPI(address, grpcAddress, key string) {
+	preferences := a.currentPreferences()
+	if address != "" {
+		preferences.APIAddress = address
+	}
+	if grpcAddress != "" {
+		preferences.GRPCAddress = grpcAddress
+	}
+	if key != "" {
+		preferences.APIEnabled, preferences.APIKey = true, key
+	}
+	if err := validatePreferences(preferences); err != nil {
+		log.Fatalf("Invalid API settings: %v", err)
+	}
+	a.updatePreferences(func(current *AppPreferences) { *current = preferences })
+
+	a.stopAPI()
+	if err := a.startAPI(); err != nil {
+		log.Fatalf("Error starting API: %v", err)
+	}
+}
+
+// runServerQueue converts the queued jobs, as many at once as the parallel job limit allows, until the context ends
+// The frontend drives the queue on the desktop, here the server does it
+// Masaüstünde kuyruğu ön yüz yürütür, burada sunucu yürütür
+func (a *App) runServerQueue(ctx context.Context) {
+	wake, unsubscribe := a.watchers.Subscribe(16)
+	defer unsubscribe()
+
+	// Jobs ConvertVideo refused to start are not picked again
+	// ConvertVideo'nun başlatmayı reddettiği işler yeniden seçilmez
+	refused := make(map[string]bool)
+	started := make(map[string]bool)
+	done := make(chan string)
+	for ctx.Err() == nil {
+		next := ""
+		if len(started) < a.parallelJobs() {
+			for _, job := range a.GetJobs() {
+				if job.Status == "queued" && !refused[job.ID] && !started[job.ID] {
+					next = job.ID
+					break
+				}
+			}
+		}
+		if next == "" {
+			select {
+			case <-ctx.Done():
+			case <-wake:
+			case jobID := <-done:
+				delete(started, jobID)
+				if job, ok := a.getJob(jobID); ok && job.Status == "queued" {
+					refused[jobID] = true
+				}
+			}
+			continue
+		}
+
+		started[next] = true
+		go func(jobID string) {
+			if err := a.ConvertVideo(jobID); err != nil {
+				log.Printf("Job %s failed: %v", jobID, err)
+			}
+			done <- jobID
+		}(next)
+	}
+
+	// Shutdown cancels the running jobs, wait for them to finish
+	// Kapanış çalışan işleri iptal eder, bitmelerini bekle
+	for range started {
+		<-done
+	}
+}
+
+// frontendSink drops the events, there is no frontend to receive them
+// Olayları yok sayar, onları alacak bir ön yüz yoktur
+func frontendSink(ctx context.Context) events.Sink {
+	return events.Discard
+}
+
+// openFilesDialog is not available without a window
+// Pencere olmadan kullanılamaz
+func (a *App) openFilesDialog(title string, filters ...fileFilter) ([]string, error) {
+	return nil, errNoDesktop
+}
+
+// openFileDialog is not available without a window
+// Pencere olmadan kullanılamaz
+func (a *App) openFileDialog(title string, filters ...fileFilter) (string, error) {
+	return "", errNoDesktop
+}
+
+// openDirectoryDialog is not available without a window
+// Pencere olmadan kullanılamaz
+func (a *App) openDirectoryDialog(title string) (string, error) {
+	return "", errNoDesktop
+}
+
+// saveFileDialog is not available without a window
+// Pencere olmadan kullanılamaz
+func (a *App) saveFileDialog(title, defaultName string, filters ...fileFilter) (string, error) {
+	return "", errNoDesktop
+}
+
+// clipboardText is not available without a window
+// Pencere olmadan kullanılamaz
+func (a *App) clipboardText() (string, error) {
+	return "", errNoDesktop
+}
+
+// openBrowser is not available without a window
+// Pencere olmadan kullanılamaz
+func (a *App) openBrowser(url string) error {
+	return errNoDesktop
+}
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// HistoryEntry struct
+// Represents a finished conversion
+// Tamamlanmış bir dönüştürmeyi temsil eder
+type HistoryEntry struct {
+	InputPath  string             `json:"inputPath"`         // Source file / Kaynak dosya
+	OutputPath string             `json:"outputPath"`        // Converted file / Dönüştürülmüş dosya
+	Status     string             `json:"status"`            // completed or failed / completed veya failed
+	Error      string             `json:"error"`             // Failure reason / Hata nedeni
+	Settings   ConversionSettings `json:"settings"`          // Settings used / Kullanılan ayarlar
+	Record     ConversionRecord   `json:"record"`            // Metadata written to the output / Çıktıya yazılan meta veri
+	InputSize  int64              `json:"inputSize"`         // Source size in bytes / Bayt cinsinden kaynak boyutu
+	OutputSize int64              `json:"outputSize"`        // Output size in bytes / Bayt cinsinden çıktı boyutu
+	StartedAt  time.Time          `json:"startedAt"`         // Conversion start / Dönüştürme başlangıcı
+	FinishedAt time.Time          `json:"finishedAt"`        // Conversion end / Dönüştürme bitişi
+	Duration   float64            `json:"duration"`          // Source duration in seconds / Saniye cinsinden kaynak süresi
+	VMAF       float64            `json:"vmaf,omitempty"`    // VMAF score measured by the archival verification / Arşiv doğrulamasının ölçtüğü VMAF puanı
+	JobID      string             `json:"jobId"`             // Job that produced the entry / Kaydı üreten iş
+	Phases     []JobPhase         `json:"phases"`            // Time spent in each phase / Her aşamada geçen süre
+	Energy     *EnergyUsage       `json:"energy,omitempty"`  // Estimated energy of the encode / Kodlamanın tahmini enerjisi
+	LogPath    string             `json:"logPath,omitempty"` // FFmpeg log of the job, see GetJobArtifacts for the rest / İşin FFmpeg logu, gerisi için GetJobArtifacts
+	Notes      string             `json:"notes,omitempty"`   // Free text of the user, carried over from the job / Kullanıcının serbest metni, işten aktarılır
+	Tags       []string           `json:"tags,omitempty"`    // Labels of the user, carried over from the job / Kullanıcının etiketleri, işten aktarılır
+	Route      *EncoderRoute      `json:"route,omitempty"`   // Software or hardware path of the balanced mode / Dengeli modun yazılım veya donanım yolu
+}
+
+// errHistoryBackedUp means the history file couldn't be parsed and was moved aside
+// Geçmiş dosyasının ayrıştırılamadığı ve kenara taşındığı anlamına gelir
+var errHistoryBackedUp = errors.New("history file was unreadable and has been backed up")
+
+// GetHistory returns all recorded conversions
+// Retrieves the conversion history, oldest first
+// Dönüştürme geçmişini en eskiden başlayarak alır
+func (a *App) GetHistory() ([]HistoryEntry, error) {
+	a.historyMu.Lock()
+	defer a.historyMu.Unlock()
+	return a.loadHistory()
+}
+
+// addHistoryEntry appends an entry to the history file
+// Geçmiş dosyasına bir kayıt ekler
+func (a *App) addHistoryEntry(entry HistoryEntry) {
+	if job, ok := a.getJob(entry.JobID); ok {
+		if entry.Phases == nil {
+			entry.Phases = job.Phases
+		}
+		if entry.Energy == nil {
+			entry.Energy = job.Energy
+		}
+		if entry.Route == nil {
+			entry.Route = job.Route
+		}
+		if entry.Notes == "" && entry.Tags == nil {
+			entry.Notes, entry.Tags = job.Notes, job.Tags
+		}
+	}
+	if entry.InputSize == 0 {
+		entry.InputSize = fileSize(entry.InputPath)
+	}
+	if entry.Status == "completed" {
+		entry.OutputSize = fileSize(entry.OutputPath)
+	}
+
+	a.historyMu.Lock()
+	defer a.historyMu.Unlock()
+
+	// Start a new history only once a broken file is safely backed up, never write over it
+	// Bozuk bir dosya güvenle yedeklendikten sonra yeni bir geçmiş başlat, asla üzerine yazma
+	entries, err := a.loadHistory()
+	if err != nil && !errors.Is(err, errHistoryBackedUp) {
+		log.Printf("Not recording history of job %s: %v", entry.JobID, err)
+		return
+	}
+	a.writeHistory(append(entries, entry))
+}
+
+// writeHistory replaces the history file, the caller must hold historyMu
+// The entries go to a temporary file in the same folder that is renamed over the old one, so a crash never leaves half a file
+// Girdiler aynı klasördeki geçici bir dosyaya yazılıp eskisinin üzerine taşınır, böylece bir çökme asla yarım dosya bırakmaz
+func (a *App) writeHistory(entries []HistoryEntry) error {
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		log.Printf("Error marshalling history: %v", err)
+		return err
+	}
+
+	temp, err := os.CreateTemp(filepath.Dir(a.historyPath), "history-*.tmp")
+	if err != nil {
+		log.Printf("Error creating temporary history file: %v", err)
+		return err
+	}
+	tempPath := temp.Name()
+	if _, err = temp.Write(data); err == nil {
+		err = temp.Sync()
+	}
+	if closeErr := temp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tempPath, 0644)
+	}
+	if err == nil {
+		err = os.Rename(tempPath, a.historyPath)
+	}
+	if err != nil {
+		os.Remove(tempPath)
+		log.Printf("Error writing history file: %v", err)
+		return err
+	}
+	return nil
+}
+
+// loadHistory reads the history file, the caller must hold historyMu
+// A file that can't be parsed is moved to history.json.<time>.bad and errHistoryBackedUp is returned
+// Ayrıştırılamayan bir dosya history.json.<zaman>.bad olarak taşınır ve errHistoryBackedUp döndürülür
+func (a *App) loadHistory() ([]HistoryEntry, error) {
+	data, err := os.ReadFile(a.historyPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		log.Printf("Error reading history file: %v", err)
+		return nil, fmt.Errorf("failed to read history: %v", err)
+	}
+
+	var entries []HistoryEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		log.Printf("Error unmarshalling history: %v", err)
+		// Timestamped so a later broken file doesn't replace an earlier backup
+		// Sonraki bozuk bir dosya önceki yedeğin yerini almasın diye zaman damgalıdır
+		backupPath := fmt.Sprintf("%s.%s.bad", a.historyPath, time.Now().Format("20060102-150405.000"))
+		if renameErr := os.Rename(a.historyPath, backupPath); renameErr != nil {
+			log.Printf("Error backing up history file: %v", renameErr)
+			return nil, fmt.Errorf("history file is unreadable (%v) and couldn't be backed up: %v", err, renameErr)
+		}
+		log.Printf("Backed up unreadable history file to %s", backupPath)
+		return nil, fmt.Errorf("%w to %s: %v", errHistoryBackedUp, backupPath, err)
+	}
+	return entries, nil
+}
+
+// fileSize returns the size of a file, 0 if it can't be read
+// Bir dosyanın boyutunu döndürür, okunamazsa 0
+func fileSize(filePath string) int64 {
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return 0
+	}
+	return info.Size()
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestHistoryRoundTrip writes entries through a temporary file and reads them back
+// Girdileri geçici bir dosya üzerinden yazar ve geri okur
+func TestHistoryRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	a := NewApp()
+	a.historyPath = filepath.Join(dir, "history.json")
+
+	a.addHistoryEntry(HistoryEntry{JobID: "one", Status: "failed"})
+	a.addHistoryEntry(HistoryEntry{JobID: "two", Status: "failed"})
+	history, err := a.GetHistory()
+	if err != nil || len(history) != 2 || history[1].JobID != "two" {
+		t.Fatalf("GetHistory = %+v, %v", history, err)
+	}
+	files, _ := os.ReadDir(dir)
+	if len(files) != 1 {
+		t.Errorf("left %d files behind, want only history.json", len(files))
+	}
+}
+
+// TestHistoryBackup moves a broken history file aside instead of writing over it
+// Bozuk bir geçmiş dosyasının üzerine yazmak yerine onu kenara taşır
+func TestHistoryBackup(t *testing.T) {
+	a := NewApp()
+	a.historyPath = filepath.Join(t.TempDir(), "history.json")
+	broken := []byte(`[{"jobId": "old", "status": "comp`)
+	if err := os.WriteFile(a.historyPath, broken, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := a.GetHistory(); !errors.Is(err, errHistoryBackedUp) {
+		t.Fatalf("GetHistory = %v, want errHistoryBackedUp", err)
+	}
+	backups, _ := filepath.Glob(a.historyPath + ".*.bad")
+	if len(backups) != 1 {
+		t.Fatalf("backups = %v, want one", backups)
+	}
+	if data, err := os.ReadFile(backups[0]); err != nil || string(data) != string(broken) {
+		t.Errorf("backup = %q, %v, want the broken file", data, err)
+	}
+
+	a.addHistoryEntry(HistoryEntry{JobID: "new", Status: "failed"})
+	history, err := a.GetHistory()
+	if err != nil || len(history) != 1 || history[0].JobID != "new" {
+		t.Errorf("GetHistory after backup = %+v, %v", history, err)
+	}
+}
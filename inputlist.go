@@ -0,0 +1,162 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// maxInputListSize is the largest playlist or file list accepted
+// Kabul edilen en büyük oynatma listesi veya dosya listesidir
+const maxInputListSize = 10 * 1024 * 1024
+
+// InputImportResult struct
+// Represents the videos loaded from a list of paths and links
+// Bir yol ve bağlantı listesinden yüklenen videoları temsil eder
+type InputImportResult struct {
+	Videos  []VideoInfo `json:"videos"`  // Probed videos ready to queue, in list order / Liste sırasıyla kuyruğa hazır videolar
+	Skipped []string    `json:"skipped"` // Entries that couldn't be loaded and why / Yüklenemeyen girişler ve nedeni
+}
+
+// ImportFromClipboard queues the file paths and links found in the clipboard
+// Accepts one entry per line, quoted paths and file:// URLs included
+// Satır başına bir giriş kabul eder, tırnaklı yollar ve file:// adresleri dahil
+func (a *App) ImportFromClipboard() (InputImportResult, error) {
+	text, err := a.clipboardText()
+	if err != nil {
+		log.Printf("Error reading clipboard: %v", err)
+		return InputImportResult{}, fmt.Errorf("failed to read clipboard: %v", err)
+	}
+	result := a.importInputs(strings.Split(text, "\n"), "")
+	log.Printf("Clipboard import: %d videos, %d skipped", len(result.Videos), len(result.Skipped))
+	return result, nil
+}
+
+// ImportPlaylist queues the entries of a .m3u, .m3u8 or .txt list chosen by the user
+// Relative entries are resolved against the folder of the list
+// Göreli girişler listenin klasörüne göre çözülür
+func (a *App) ImportPlaylist() (InputImportResult, error) {
+	listPath, err := a.openFileDialog("Import Playlist or File List",
+		fileFilter{name: "Playlists and File Lists", pattern: "*.m3u;*.m3u8;*.txt"})
+	if err != nil || listPath == "" {
+		return InputImportResult{}, err
+	}
+
+	stat, err := os.Stat(listPath)
+	if err != nil {
+		return InputImportResult{}, err
+	}
+	if stat.Size() > maxInputListSize {
+		return InputImportResult{}, fmt.Errorf("list is larger than %d MB", maxInputListSize/1024/1024)
+	}
+	data, err := os.ReadFile(listPath)
+	if err != nil {
+		log.Printf("Error reading list %s: %v", listPath, err)
+		return InputImportResult{}, fmt.Errorf("failed to read list: %v", err)
+	}
+
+	// #EXTM3U and #EXTINF directives are skipped as comments
+	// #EXTM3U ve #EXTINF yönergeleri yorum olarak atlanır
+	lines := strings.Split(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n")
+	result := a.importInputs(lines, filepath.Dir(listPath))
+	log.Printf("Imported %s: %d videos, %d skipped", listPath, len(result.Videos), len(result.Skipped))
+	return result, nil
+}
+
+// importInputs resolves, deduplicates, checks and probes a list of entries
+// Relative paths are resolved against baseDir, they are rejected without one
+// Göreli yollar baseDir'e göre çözülür, baseDir yoksa reddedilir
+func (a *App) importInputs(lines []string, baseDir string) InputImportResult {
+	var result InputImportResult
+	var inputs, localFiles []string
+	seen := make(map[string]bool)
+	for _, line := range lines {
+		input, err := parseInputLine(line, baseDir)
+		if err != nil {
+			result.Skipped = append(result.Skipped, fmt.Sprintf("%s: %v", strings.TrimSpace(line), err))
+			continue
+		}
+		key := input
+		if !isURLInput(input) {
+			key = normalizePath(input)
+		}
+		if input == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		if !isURLInput(input) {
+			stat, err := a.statWithTimeout(input)
+			if err != nil {
+				result.Skipped = append(result.Skipped, fmt.Sprintf("%s: %v", input, err))
+				continue
+			}
+			if !stat.Mode().IsRegular() {
+				result.Skipped = append(result.Skipped, fmt.Sprintf("%s: not a file", input))
+				continue
+			}
+			localFiles = append(localFiles, input)
+		}
+		inputs = append(inputs, input)
+	}
+
+	// Probe local files in parallel, links one by one
+	// Yerel dosyaları paralel, bağlantıları tek tek incele
+	probed := make(map[string]VideoInfo)
+	for _, info := range a.probeFiles(localFiles) {
+		probed[info.FullPath] = info
+	}
+	for _, input := range inputs {
+		if isURLInput(input) {
+			info, err := a.AddURL(input)
+			if err != nil {
+				result.Skipped = append(result.Skipped, fmt.Sprintf("%s: %v", input, err))
+				continue
+			}
+			result.Videos = append(result.Videos, info)
+		} else if info, ok := probed[input]; ok {
+			result.Videos = append(result.Videos, info)
+		} else {
+			result.Skipped = append(result.Skipped, fmt.Sprintf("%s: not a readable video", input))
+		}
+	}
+	return result
+}
+
+// parseInputLine turns a line into a path or link, empty lines and comments give ""
+// Bir satırı yola veya bağlantıya dönüştürür, boş satırlar ve yorumlar "" verir
+func parseInputLine(line, baseDir string) (string, error) {
+	line = strings.TrimSpace(strings.TrimPrefix(line, "\ufeff"))
+	line = strings.Trim(line, `"'`)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return "", nil
+	}
+	if isURLInput(line) {
+		return line, nil
+	}
+
+	// file:// URLs come from file managers and playlists
+	// file:// adresleri dosya yöneticilerinden ve oynatma listelerinden gelir
+	if strings.HasPrefix(strings.ToLower(line), "file://") {
+		parsed, err := url.Parse(line)
+		if err != nil {
+			return "", err
+		}
+		line = parsed.Path
+		if len(line) > 2 && line[0] == '/' && line[2] == ':' {
+			line = line[1:]
+		}
+		line = filepath.FromSlash(line)
+	}
+
+	if !filepath.IsAbs(line) {
+		if baseDir == "" {
+			return "", fmt.Errorf("path must be absolute")
+		}
+		line = filepath.Join(baseDir, line)
+	}
+	return filepath.Clean(line), nil
+}
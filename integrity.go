@@ -0,0 +1,153 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+
+	"AV1-video-converter/internal/events"
+	"AV1-video-converter/internal/probe"
+)
+
+// OutputMismatch struct
+// Represents a difference between an output and its source found after encoding
+// Kodlamadan sonra bulunan, bir çıktı ile kaynağı arasındaki farkı temsil eder
+type OutputMismatch struct {
+	OutputPath string  `json:"outputPath"` // Checked file / Kontrol edilen dosya
+	Kind       string  `json:"kind"`       // duration, frames or audio / duration, frames veya audio
+	Expected   float64 `json:"expected"`   // Value derived from the source / Kaynaktan çıkarılan değer
+	Actual     float64 `json:"actual"`     // Value measured in the output / Çıktıda ölçülen değer
+	Message    string  `json:"message"`    // Human readable description / Okunabilir açıklama
+}
+
+// checkOutputIntegrity compares the duration, frame count and audio of an output to the source
+// The packets are counted, container headers alone can hide a truncated encode
+// Paketler sayılır, yalnızca kapsayıcı başlıkları yarıda kalmış bir kodlamayı gizleyebilir
+func (a *App) checkOutputIntegrity(ctx context.Context, plan conversionPlan, output planOutput) ([]OutputMismatch, error) {
+	streams, err := a.probeStreams(output.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to probe output: %v", err)
+	}
+	args := append(append([]string{}, probe.TrackPacketArgs...), output.Path)
+	out, err := a.runner.Output(ctx, a.ffprobePath, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count packets: %v", err)
+	}
+	tracks := probe.ParseTrackStats(out)
+
+	var mismatches []OutputMismatch
+	flag := func(kind string, expected, actual float64, format string, args ...interface{}) {
+		mismatches = append(mismatches, OutputMismatch{
+			OutputPath: output.Path,
+			Kind:       kind,
+			Expected:   expected,
+			Actual:     actual,
+			Message:    fmt.Sprintf(format, args...),
+		})
+	}
+
+	// Allow a second or 1% of drift from container rounding, plus any audio delay
+	// Kapsayıcı yuvarlamasından kaynaklı bir saniye veya %1 sapmaya ve ses gecikmesine izin ver
+	source := plan.Video
+	tolerance := math.Max(1, source.DurationSeconds*0.01)
+	audioTolerance := tolerance + math.Abs(float64(output.Settings.AudioOffset))/1000
+
+	video, audio := integrityStreams(streams, tracks)
+	mediaSeconds := 0.0
+	if video != nil {
+		mediaSeconds = video.Seconds()
+		if plausibleDuration(source.DurationSeconds) && math.Abs(mediaSeconds-source.DurationSeconds) > tolerance {
+			flag("duration", source.DurationSeconds, mediaSeconds, "video lasts %s but the source lasts %s",
+				formatSeconds(mediaSeconds), formatSeconds(source.DurationSeconds))
+		}
+
+		// Inverse telecine drops one frame in five, nothing else changes the rate
+		// Ters telesine beş kareden birini atar, hızı başka hiçbir şey değiştirmez
+		rate := source.FrameRate
+		if output.Settings.InverseTelecine {
+			rate = rate * 4 / 5
+		}
+		if expected := source.DurationSeconds * rate; plausibleDuration(source.DurationSeconds) && expected > 0 {
+			frames := float64(video.Packets)
+			if math.Abs(frames-expected) > math.Max(rate, expected*0.01) {
+				flag("frames", math.Round(expected), frames, "output has %d frames, %d expected at %s fps",
+					video.Packets, int(math.Round(expected)), formatSeconds(rate))
+			}
+		}
+	} else if len(audio) > 0 {
+		mediaSeconds = audio[0].Seconds()
+		if plan.AudioOnly && plausibleDuration(source.DurationSeconds) && math.Abs(mediaSeconds-source.DurationSeconds) > tolerance {
+			flag("duration", source.DurationSeconds, mediaSeconds, "audio lasts %s but the source lasts %s",
+				formatSeconds(mediaSeconds), formatSeconds(source.DurationSeconds))
+		}
+	}
+
+	expectedAudio := 0
+	if _, kept, _ := selectStreams(output.Settings, plan.Streams); len(kept) > 0 {
+		expectedAudio = len(kept)
+		if plan.AudioOnly {
+			expectedAudio = 1
+		}
+	}
+	if len(audio) < expectedAudio {
+		flag("audio", float64(expectedAudio), float64(len(audio)), "output has %d of %d audio tracks", len(audio), expectedAudio)
+	}
+	for i, track := range audio {
+		if seconds := track.Seconds(); video != nil && mediaSeconds-seconds > audioTolerance {
+			flag("audio", mediaSeconds, seconds, "audio track %d ends %s before the video", i+1, formatSeconds(mediaSeconds-seconds))
+		}
+	}
+	return mismatches, nil
+}
+
+// integrityStreams picks the packet totals of the main video and the audio tracks of an output
+// Bir çıktının ana videosunun ve ses parçalarının paket toplamlarını seçer
+func integrityStreams(streams []StreamInfo, tracks map[int]*probe.TrackStats) (video *probe.TrackStats, audio []*probe.TrackStats) {
+	for _, stream := range streams {
+		track, ok := tracks[stream.Index]
+		if !ok {
+			track = &probe.TrackStats{}
+		}
+		switch {
+		case stream.Type == "video" && !stream.AttachedPic && video == nil:
+			video = track
+		case stream.Type == "audio":
+			audio = append(audio, track)
+		}
+	}
+	return video, audio
+}
+
+// verifyOutputIntegrity checks every output of a job and flags the mismatches found
+// Mismatches are warnings, the outputs are kept for the user to judge
+// Uyumsuzluklar uyarıdır, çıktılar kullanıcının değerlendirmesi için tutulur
+func (a *App) verifyOutputIntegrity(ctx context.Context, jobID string, plan conversionPlan) {
+	endPhase := a.startPhase(jobID, "verification")
+	defer endPhase()
+
+	var found []OutputMismatch
+	for _, output := range plan.Outputs {
+		mismatches, err := a.checkOutputIntegrity(ctx, plan, output)
+		if err != nil {
+			log.Printf("Skipping integrity check of %s: %v", output.Path, err)
+			continue
+		}
+		var checkErr error
+		if len(mismatches) > 0 {
+			checkErr = fmt.Errorf("%s", mismatches[0].Message)
+		}
+		a.recordVerification(jobID, "integrity", output.finalPath(), checkErr)
+		for _, mismatch := range mismatches {
+			log.Printf("Job %s: %s: %s", jobID, output.Path, mismatch.Message)
+			a.addJobEvent(jobID, "verification", mismatch.Message)
+			a.emitJobEvent(events.JobWarning, jobID, JobWarningEvent{Message: mismatch.Message})
+		}
+		found = append(found, mismatches...)
+	}
+	if len(found) > 0 {
+		a.updateJob(jobID, func(job *Job) {
+			job.Mismatches = found
+		})
+	}
+}
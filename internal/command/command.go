@@ -0,0 +1,151 @@
+// Package command assembles FFmpeg arguments from plain options, it knows nothing of the app settings
+// Düz seçeneklerden FFmpeg argümanları oluşturur, uygulama ayarlarından habersizdir
+package command
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Input struct
+// Represents the inputs of a conversion
+// Bir dönüştürmenin girdilerini temsil eder
+type Input struct {
+	Args        []string // Options reading the source, e.g. -i path / Kaynağı okuyan seçenekler, örn. -i yol
+	ZoneVideo   string   // Concat list of zone encoded video, if any / Varsa bölge kodlu videonun concat listesi
+	AudioOffset int      // Audio delay in milliseconds, negative advances it / Milisaniye cinsinden ses gecikmesi, negatif öne alır
+}
+
+// AudioInput returns the input the audio is mapped from
+// An audio offset reads the source a second time, shifted by -itsoffset
+// Bir ses kayması kaynağı -itsoffset ile kaydırılmış olarak ikinci kez okur
+func (input Input) AudioInput() int {
+	switch {
+	case input.AudioOffset == 0:
+		return 0
+	case input.ZoneVideo != "":
+		return 2
+	}
+	return 1
+}
+
+// Build assembles the FFmpeg arguments of a conversion
+// The source is decoded once and every output brings its own options
+// Kaynak bir kez çözülür ve her çıktı kendi seçeneklerini getirir
+func Build(input Input, outputs [][]string) []string {
+	args := append([]string{"-y"}, input.Args...)
+	if input.ZoneVideo != "" {
+		args = append(args, "-f", "concat", "-safe", "0", "-i", input.ZoneVideo)
+	}
+	if input.AudioOffset != 0 {
+		args = append(args, "-itsoffset", strconv.FormatFloat(float64(input.AudioOffset)/1000, 'f', 3, 64))
+		args = append(args, input.Args...)
+	}
+	for _, output := range outputs {
+		args = append(args, output...)
+	}
+	return args
+}
+
+// Output struct
+// Represents the options of a single video output
+// Tek bir video çıktısının seçeneklerini temsil eder
+type Output struct {
+	Path      string   // File FFmpeg writes / FFmpeg'in yazdığı dosya
+	Container string   // mkv, mp4, mov or webm / Kapsayıcı
+	Streams   []string // Map and codec options of the kept streams, see StreamArgs / Tutulan akışların eşleme ve kodek seçenekleri, bkz. StreamArgs
+	CopyVideo bool     // Mux an already encoded video instead of encoding / Kodlamak yerine önceden kodlanmış bir videoyu birleştir
+	Encoder   []string // Video encoder options, see EncoderArgs / Video kodlayıcı seçenekleri, bkz. EncoderArgs
+	Color     []string // Color tags of the output / Çıktının renk etiketleri
+	Filters   []string // Video filter chain / Video filtre zinciri
+	MaxRate   int      // Video bitrate cap in kbps, 0 for none / kbps cinsinden video bit hızı sınırı, 0 yok
+	Metadata  []string // Metadata options / Meta veri seçenekleri
+}
+
+// OutputArgs assembles the options of a single video output
+// Combines stream, video and container options for the output file
+// Çıktı dosyası için akış, video ve kapsayıcı seçeneklerini birleştirir
+func OutputArgs(output Output) []string {
+	args := append([]string(nil), output.Streams...)
+
+	// Zone encoded video only needs to be muxed
+	// Bölge kodlu videonun yalnızca birleştirilmesi gerekir
+	if output.CopyVideo {
+		args = append(args, "-c:v", "copy")
+		if output.Container == "mp4" {
+			args = append(args, "-movflags", "+faststart")
+		}
+		args = append(args, output.Metadata...)
+		return append(args, output.Path)
+	}
+
+	// Video encoder and filters
+	// Video kodlayıcı ve filtreler
+	args = append(args, output.Encoder...)
+	args = append(args, output.Color...)
+	if len(output.Filters) > 0 {
+		args = append(args, "-vf", strings.Join(output.Filters, ","))
+	}
+	if output.MaxRate > 0 {
+		args = append(args,
+			"-maxrate", strconv.Itoa(output.MaxRate)+"k",
+			"-bufsize", strconv.Itoa(output.MaxRate*2)+"k")
+	}
+
+	// Let MP4 playback start before the whole file is downloaded
+	// MP4 oynatmanın tüm dosya indirilmeden başlamasını sağla
+	if output.Container == "mp4" || output.Container == "mov" {
+		args = append(args, "-movflags", "+faststart")
+	}
+
+	// Record how the file was produced
+	// Dosyanın nasıl üretildiğini kaydet
+	args = append(args, output.Metadata...)
+	return append(args, output.Path)
+}
+
+// ScaleFilter returns a filter that keeps the video within the size limits, empty without limits
+// Videoyu boyut sınırları içinde tutan bir filtre döndürür, sınır yoksa boş
+func ScaleFilter(maxWidth, maxHeight int) string {
+	if maxWidth <= 0 && maxHeight <= 0 {
+		return ""
+	}
+	width, height := "iw", "ih"
+	if maxWidth > 0 {
+		width = fmt.Sprintf("'min(iw,%d)'", maxWidth)
+	}
+	if maxHeight > 0 {
+		height = fmt.Sprintf("'min(ih,%d)'", maxHeight)
+	}
+	return fmt.Sprintf("scale=w=%s:h=%s:force_original_aspect_ratio=decrease:force_divisible_by=2", width, height)
+}
+
+// BitrateLimit struct
+// Represents the limits the video bitrate is capped by
+// Video bit hızının sınırlandığı limitleri temsil eder
+type BitrateLimit struct {
+	MaxBitrate   int // Video bitrate cap in kbps, 0 for none / kbps cinsinden video bit hızı sınırı, 0 yok
+	MaxFileSize  int // File size target in MB, 0 for none / MB cinsinden dosya boyutu hedefi, 0 yok
+	AudioBitrate int // Audio bitrate in kbps taken from the size target / Boyut hedefinden düşülen kbps cinsinden ses bit hızı
+}
+
+// BitrateCap returns the maximum video bitrate in kbps, 0 for none
+// The file size target is converted to a bitrate using the duration
+// Dosya boyutu hedefi süre kullanılarak bit hızına dönüştürülür
+func BitrateCap(limit BitrateLimit, durationSeconds float64) int {
+	maxRate := limit.MaxBitrate
+	if limit.MaxFileSize > 0 && durationSeconds > 0 {
+		// Keep 5% headroom for container overhead
+		// Kapsayıcı ek yükü için %5 pay bırak
+		totalKbps := float64(limit.MaxFileSize) * 8 * 1024 * 0.95 / durationSeconds
+		sizeRate := int(totalKbps) - limit.AudioBitrate
+		if sizeRate < 1 {
+			sizeRate = 1
+		}
+		if maxRate == 0 || sizeRate < maxRate {
+			maxRate = sizeRate
+		}
+	}
+	return maxRate
+}
@@ -0,0 +1,79 @@
+package command
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+// TestBuildAudioOffset reads the source a second time behind -itsoffset and maps audio from it
+// Kaynağı -itsoffset arkasında ikinci kez okur ve sesi oradan eşler
+func TestBuildAudioOffset(t *testing.T) {
+	input := Input{Args: []string{"-i", "in.mkv"}, ZoneVideo: "zones.txt", AudioOffset: -250}
+	args := Build(input, [][]string{{"out.mkv"}})
+	want := []string{"-y", "-i", "in.mkv", "-f", "concat", "-safe", "0", "-i", "zones.txt", "-itsoffset", "-0.250", "-i", "in.mkv", "out.mkv"}
+	if !slices.Equal(args, want) {
+		t.Errorf("Build = %v, want %v", args, want)
+	}
+	if got := input.AudioInput(); got != 2 {
+		t.Errorf("AudioInput = %d, want 2", got)
+	}
+}
+
+// TestEncoderArgs passes the level inside -svtav1-params and the pixel format last
+// Seviyeyi -svtav1-params içinde, piksel biçimini en sonda geçirir
+func TestEncoderArgs(t *testing.T) {
+	args := EncoderArgs(Encoder{
+		Codec: "libsvtav1", QualityFlag: "-crf", Quality: 30, PresetFlag: "-preset", Preset: "6",
+		Level: "5.1", PixelFormat: "yuv420p10le", Lossless: true,
+	})
+	want := []string{"-c:v", "libsvtav1", "-crf", "30", "-preset", "6", "-svtav1-params", "tune=0:level=51", "-pix_fmt", "yuv420p10le"}
+	if !slices.Equal(args, want) {
+		t.Errorf("EncoderArgs = %v, want %v", args, want)
+	}
+}
+
+// TestStreamArgsLanguages keeps the chosen languages and flags the default track
+// Seçilen dilleri tutar ve varsayılan parçayı işaretler
+func TestStreamArgsLanguages(t *testing.T) {
+	streams := []Stream{
+		{Index: 0, Type: "video", Codec: "h264"},
+		{Index: 1, Type: "audio", Codec: "aac", Language: "eng", Default: true},
+		{Index: 2, Type: "audio", Codec: "aac", Language: "und"},
+		{Index: 3, Type: "subtitle", Codec: "subrip", Language: "eng"},
+	}
+	options := StreamOptions{
+		Container: "mkv", AudioCodec: "copy",
+		KeepAudioLanguages: []string{"tur"}, DefaultAudioLanguage: "tur",
+		LanguageTags: map[int]string{2: "TUR"},
+	}
+	args := strings.Join(StreamArgs(options, streams, "", 0), " ")
+	for _, part := range []string{"-map 0:0", "-map 0:2", "-map 0:3", "-disposition:a:0 default", "-metadata:s:a:0 language=tur"} {
+		if !strings.Contains(args, part) {
+			t.Errorf("StreamArgs = %q, missing %q", args, part)
+		}
+	}
+	if strings.Contains(args, "-map 0:1") {
+		t.Errorf("StreamArgs = %q, kept the English audio", args)
+	}
+}
+
+// TestBitrateCap takes the lower of the bitrate cap and the size target
+// Bit hızı sınırı ile boyut hedefinin düşüğünü alır
+func TestBitrateCap(t *testing.T) {
+	tests := []struct {
+		limit    BitrateLimit
+		duration float64
+		want     int
+	}{
+		{BitrateLimit{}, 60, 0},
+		{BitrateLimit{MaxBitrate: 4000}, 60, 4000},
+		{BitrateLimit{MaxBitrate: 4000, MaxFileSize: 10, AudioBitrate: 128}, 60, 1169},
+		{BitrateLimit{MaxFileSize: 10}, 0, 0},
+	}
+	for _, test := range tests {
+		if got := BitrateCap(test.limit, test.duration); got != test.want {
+			t.Errorf("BitrateCap(%+v, %v) = %d, want %d", test.limit, test.duration, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package command
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Encoder struct
+// Represents the video encoder of an output
+// Bir çıktının video kodlayıcısını temsil eder
+type Encoder struct {
+	Codec       string // FFmpeg encoder, e.g. libsvtav1 / FFmpeg kodlayıcısı, örn. libsvtav1
+	QualityFlag string // Option taking the quality, empty if the encoder has none / Kaliteyi alan seçenek, kodlayıcıda yoksa boş
+	Quality     int    // CRF or CQ value / CRF veya CQ değeri
+	PresetFlag  string // Option taking the preset / Ön ayarı alan seçenek
+	Preset      string // Speed preset or profile / Hız ön ayarı veya profil
+	Level       string // Codec level, e.g. 5.1, empty for automatic / Kodek seviyesi, örn. 5.1, boşsa otomatik
+	PixelFormat string // Output pixel format, empty to keep the source's / Çıktı piksel biçimi, boşsa kaynağınki
+	Lossless    bool   // Encode losslessly, only libaom-av1 takes it / Kayıpsız kodla, yalnızca libaom-av1 alır
+}
+
+// EncoderArgs builds the FFmpeg video encoder arguments
+// FFmpeg video kodlayıcı argümanlarını oluşturur
+func EncoderArgs(encoder Encoder) []string {
+	args := []string{"-c:v", encoder.Codec}
+	if encoder.QualityFlag != "" {
+		args = append(args, encoder.QualityFlag, strconv.Itoa(encoder.Quality))
+	}
+	args = append(args, encoder.PresetFlag, encoder.Preset)
+
+	switch encoder.Codec {
+	case "libsvtav1":
+		params := []string{"tune=0"}
+		if encoder.Level != "" {
+			params = append(params, "level="+strings.Replace(encoder.Level, ".", "", 1))
+		}
+		args = append(args, "-svtav1-params", strings.Join(params, ":"))
+	case "av1_amf":
+		args = append(args, "-rc", "cqp", "-qp_p", strconv.Itoa(encoder.Quality))
+	default:
+		if encoder.Level != "" {
+			args = append(args, "-level", encoder.Level)
+		}
+	}
+
+	if encoder.Lossless && encoder.Codec == "libaom-av1" {
+		args = append(args, "-aom-params", "lossless=1")
+	}
+	if encoder.PixelFormat != "" {
+		args = append(args, "-pix_fmt", encoder.PixelFormat)
+	}
+	return args
+}
@@ -0,0 +1,311 @@
+package command
+
+import (
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+)
+
+// Stream struct
+// Represents a single stream of a media file
+// Bir medya dosyasının tek bir akışını temsil eder
+type Stream struct {
+	Index       int    `json:"index"`       // Stream index in the file / Dosyadaki akış dizini
+	Type        string `json:"type"`        // video, audio, subtitle, attachment / Akış türü
+	Codec       string `json:"codec"`       // Codec name / Kodek adı
+	Language    string `json:"language"`    // Language tag, "und" if missing / Dil etiketi, yoksa "und"
+	Title       string `json:"title"`       // Stream title / Akış başlığı
+	Channels    int    `json:"channels"`    // Audio channel count / Ses kanalı sayısı
+	Default     bool   `json:"default"`     // Default disposition / Varsayılan işareti
+	Forced      bool   `json:"forced"`      // Forced disposition / Zorunlu işareti
+	AttachedPic bool   `json:"attachedPic"` // Cover art stored as a video stream / Video akışı olarak saklanan kapak görseli
+}
+
+// StreamOptions struct
+// Represents the rules deciding which streams an output keeps and how
+// Bir çıktının hangi akışları nasıl tuttuğuna karar veren kuralları temsil eder
+type StreamOptions struct {
+	Container               string         // mkv, mp4, mov or webm / Kapsayıcı
+	AudioCodec              string         // Audio encoder or copy / Ses kodlayıcısı veya copy
+	AudioBitrate            int            // Audio bitrate in kbps, 0 for the encoder default / kbps cinsinden ses bit hızı, 0 kodlayıcı varsayılanı
+	AudioFallbackCodec      string         // Encoder of tracks MP4 can't copy / MP4'ün kopyalayamadığı parçaların kodlayıcısı
+	AudioFallbackBitrate    int            // Bitrate of the fallback in kbps, 0 by channel count / Yedeğin kbps cinsinden bit hızı, 0 kanal sayısına göre
+	KeepAudioLanguages      []string       // Audio languages to keep, empty for all / Tutulacak ses dilleri, boşsa hepsi
+	KeepSubtitleLanguages   []string       // Subtitle languages to keep, empty for all / Tutulacak altyazı dilleri, boşsa hepsi
+	DefaultAudioLanguage    string         // Language of the default audio track / Varsayılan ses parçasının dili
+	DefaultSubtitleLanguage string         // Language of the default subtitle, "none" for no default / Varsayılan altyazının dili, "none" varsayılan yok
+	ForcedSubtitleLanguage  string         // Language of the forced subtitle / Zorunlu altyazının dili
+	LanguageTags            map[int]string // Language written to a stream index / Bir akış dizinine yazılan dil
+}
+
+// textSubtitleCodecs lists subtitle codecs that can be converted to text formats
+// Metin biçimlerine dönüştürülebilen altyazı kodeklerini listeler
+var textSubtitleCodecs = []string{"subrip", "srt", "ass", "ssa", "mov_text", "webvtt", "text"}
+
+// SelectStreams picks the streams to keep according to the language rules
+// Dil kurallarına göre tutulacak akışları seçer
+func SelectStreams(options StreamOptions, streams []Stream) (video *Stream, audio, subtitles []Stream) {
+	for i, stream := range streams {
+		switch stream.Type {
+		case "video":
+			if video == nil && !stream.AttachedPic {
+				video = &streams[i]
+			}
+		case "audio":
+			if matchesLanguage(options.KeepAudioLanguages, stream.Language) {
+				audio = append(audio, stream)
+			}
+		case "subtitle":
+			if matchesLanguage(options.KeepSubtitleLanguages, stream.Language) && subtitleSupported(options.Container, stream.Codec) {
+				subtitles = append(subtitles, stream)
+			}
+		}
+	}
+
+	// Never produce a silent file because no track matched the rules
+	// Hiçbir parça kurallara uymadığı için asla sessiz bir dosya üretme
+	if len(audio) == 0 && len(options.KeepAudioLanguages) > 0 {
+		log.Printf("No audio track matches %v, keeping all audio tracks", options.KeepAudioLanguages)
+		for _, stream := range streams {
+			if stream.Type == "audio" {
+				audio = append(audio, stream)
+			}
+		}
+	}
+	return video, audio, subtitles
+}
+
+// StreamArgs builds the -map and codec arguments for the kept streams
+// videoInput maps an already encoded video instead of the source video, audioInput is the input audio is read from
+// videoInput, kaynak video yerine önceden kodlanmış bir videoyu eşler, audioInput sesin okunduğu giriştir
+func StreamArgs(options StreamOptions, streams []Stream, videoInput string, audioInput int) []string {
+	streams = RetagStreams(options, streams)
+	video, audio, subtitles := SelectStreams(options, streams)
+
+	var args []string
+	if videoInput != "" {
+		args = append(args, "-map", videoInput)
+	} else if video != nil {
+		args = append(args, "-map", fmt.Sprintf("0:%d", video.Index))
+	} else {
+		args = append(args, "-map", "0:v:0")
+	}
+	for _, stream := range audio {
+		args = append(args, "-map", fmt.Sprintf("%d:%d", audioInput, stream.Index))
+	}
+	for _, stream := range subtitles {
+		args = append(args, "-map", fmt.Sprintf("0:%d", stream.Index))
+	}
+
+	// Audio codec
+	// Ses kodeki
+	args = append(args, "-c:a", options.AudioCodec)
+	if options.AudioCodec != "copy" && options.AudioBitrate > 0 {
+		args = append(args, "-b:a", fmt.Sprintf("%dk", options.AudioBitrate))
+	}
+	args = append(args, audioFixArgs(options, audio)...)
+
+	// Subtitles must be converted to the container's text format
+	// Altyazılar kapsayıcının metin biçimine dönüştürülmelidir
+	if len(subtitles) > 0 {
+		switch options.Container {
+		case "mp4", "mov":
+			args = append(args, "-c:s", "mov_text")
+		case "webm":
+			args = append(args, "-c:s", "webvtt")
+		default:
+			args = append(args, "-c:s", "copy")
+		}
+	}
+
+	// Default and forced flags
+	// Varsayılan ve zorunlu işaretleri
+	args = append(args, dispositionArgs(options, audio, subtitles)...)
+
+	// Corrected language tags
+	// Düzeltilmiş dil etiketleri
+	args = append(args, languageTagArgs(options, audio, "a")...)
+	args = append(args, languageTagArgs(options, subtitles, "s")...)
+
+	// Matroska keeps fonts needed by ASS subtitles
+	// Matroska, ASS altyazılarının ihtiyaç duyduğu yazı tiplerini korur
+	if options.Container == "mkv" {
+		args = append(args, "-map", "0:t?", "-c:t", "copy")
+	}
+	return args
+}
+
+// RetagStreams applies the user's language tags before the language rules see the streams
+// An untagged track re-tagged as "tur" is then kept by a "tur" rule
+// Yeniden "tur" olarak etiketlenen etiketsiz bir parça böylece "tur" kuralıyla tutulur
+func RetagStreams(options StreamOptions, streams []Stream) []Stream {
+	if len(options.LanguageTags) == 0 {
+		return streams
+	}
+	retagged := make([]Stream, len(streams))
+	copy(retagged, streams)
+	for i, stream := range retagged {
+		if code, ok := options.LanguageTags[stream.Index]; ok {
+			retagged[i].Language = strings.ToLower(strings.TrimSpace(code))
+		}
+	}
+	return retagged
+}
+
+// languageTagArgs writes the corrected language of the kept streams of one type
+// Tek türdeki tutulan akışların düzeltilmiş dilini yazar
+func languageTagArgs(options StreamOptions, streams []Stream, specifier string) []string {
+	var args []string
+	for i, stream := range streams {
+		if _, ok := options.LanguageTags[stream.Index]; ok {
+			args = append(args, fmt.Sprintf("-metadata:s:%s:%d", specifier, i), "language="+stream.Language)
+		}
+	}
+	return args
+}
+
+// audioFixArgs transcodes the audio tracks MP4 can't hold when copying
+// Compatible tracks stay copied, only the offending ones are re-encoded
+// Uyumlu parçalar kopyalanır, yalnızca sorunlu olanlar yeniden kodlanır
+func audioFixArgs(options StreamOptions, audio []Stream) []string {
+	if options.Container != "mp4" || options.AudioCodec != "copy" {
+		return nil
+	}
+
+	var args []string
+	for i, stream := range audio {
+		if !mp4IncompatibleAudio(stream.Codec) {
+			continue
+		}
+		bitrate := options.AudioFallbackBitrate
+		if bitrate == 0 {
+			bitrate = FallbackAudioBitrate(stream.Channels)
+		}
+		log.Printf("Audio track %d (%s) is not MP4 compatible, transcoding to %s at %dk", stream.Index, stream.Codec, options.AudioFallbackCodec, bitrate)
+		args = append(args,
+			fmt.Sprintf("-c:a:%d", i), options.AudioFallbackCodec,
+			fmt.Sprintf("-b:a:%d", i), fmt.Sprintf("%dk", bitrate))
+	}
+	return args
+}
+
+// mp4IncompatibleAudio reports whether an audio codec can't be copied into MP4
+// Bir ses kodekinin MP4'e kopyalanıp kopyalanamayacağını bildirir
+func mp4IncompatibleAudio(codec string) bool {
+	switch codec {
+	case "dts", "truehd", "mlp", "flac", "vorbis", "cook", "ra_144", "wmav1", "wmav2", "wmapro":
+		return true
+	}
+	return strings.HasPrefix(codec, "pcm_")
+}
+
+// FallbackAudioBitrate picks a bitrate in kbps for the channel count
+// Kanal sayısı için kbps cinsinden bir bit hızı seçer
+func FallbackAudioBitrate(channels int) int {
+	switch {
+	case channels <= 2:
+		return 192
+	case channels <= 6:
+		return 384
+	}
+	return 512
+}
+
+// dispositionArgs builds the -disposition arguments for the kept streams
+// Flags of the source are kept unless a rule overrides them
+// Kaynağın işaretleri, bir kural geçersiz kılmadıkça korunur
+func dispositionArgs(options StreamOptions, audio, subtitles []Stream) []string {
+	if options.DefaultAudioLanguage == "" && options.DefaultSubtitleLanguage == "" && options.ForcedSubtitleLanguage == "" {
+		return nil
+	}
+
+	var args []string
+
+	// Audio: only the first track of the chosen language is default
+	// Ses: yalnızca seçilen dilin ilk parçası varsayılandır
+	defaultAudio := pickStream(audio, options.DefaultAudioLanguage, false)
+	for i, stream := range audio {
+		isDefault := stream.Default
+		if options.DefaultAudioLanguage != "" {
+			isDefault = i == defaultAudio
+		}
+		args = append(args, fmt.Sprintf("-disposition:a:%d", i), dispositionValue(isDefault, false))
+	}
+
+	// Subtitles: "none" clears the default flag on every track
+	// Altyazılar: "none" tüm parçalardaki varsayılan işaretini kaldırır
+	defaultSubtitle := pickStream(subtitles, options.DefaultSubtitleLanguage, false)
+	forcedSubtitle := pickStream(subtitles, options.ForcedSubtitleLanguage, true)
+	for i, stream := range subtitles {
+		isDefault, isForced := stream.Default, stream.Forced
+		if options.DefaultSubtitleLanguage != "" {
+			isDefault = i == defaultSubtitle
+		}
+		if options.ForcedSubtitleLanguage != "" {
+			isForced = i == forcedSubtitle
+		}
+		args = append(args, fmt.Sprintf("-disposition:s:%d", i), dispositionValue(isDefault, isForced))
+	}
+	return args
+}
+
+// pickStream returns the output index of the stream to flag, -1 for none
+// Forced tracks are recognised by their flag or a "forced" title
+// Zorunlu parçalar işaretlerinden veya "forced" başlığından tanınır
+func pickStream(streams []Stream, language string, forced bool) int {
+	if language == "" || language == "none" {
+		return -1
+	}
+	first := -1
+	for i, stream := range streams {
+		if !strings.EqualFold(stream.Language, language) {
+			continue
+		}
+		if first < 0 {
+			first = i
+		}
+		if forced && (stream.Forced || strings.Contains(strings.ToLower(stream.Title), "forced")) {
+			return i
+		}
+	}
+	return first
+}
+
+// dispositionValue formats the flags for -disposition
+// -disposition için işaretleri biçimlendirir
+func dispositionValue(isDefault, isForced bool) string {
+	switch {
+	case isDefault && isForced:
+		return "default+forced"
+	case isDefault:
+		return "default"
+	case isForced:
+		return "forced"
+	}
+	return "0"
+}
+
+// matchesLanguage reports whether a language passes a keep list
+// An empty list keeps every language
+// Boş bir liste tüm dilleri tutar
+func matchesLanguage(keep []string, language string) bool {
+	if len(keep) == 0 {
+		return true
+	}
+	for _, code := range keep {
+		if strings.EqualFold(strings.TrimSpace(code), language) {
+			return true
+		}
+	}
+	return false
+}
+
+// subtitleSupported reports whether a subtitle codec can be written to the container
+// Bir altyazı kodekinin kapsayıcıya yazılıp yazılamayacağını bildirir
+func subtitleSupported(container, codec string) bool {
+	if container == "mkv" {
+		return true
+	}
+	return slices.Contains(textSubtitleCodecs, codec)
+}
@@ -0,0 +1,2673 @@
+// gRPC interface of the converter, served next to the HTTP API on the gRPC address of the preferences
+// Every call must send the API key in the x-api-key metadata
+// Dönüştürücünün gRPC arayüzü, tercihlerdeki gRPC adresinde HTTP API'sinin yanında sunulur
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: converter.proto
+
+package converterpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EnqueueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InputPath    string `protobuf:"bytes,1,opt,name=input_path,json=inputPath,proto3" json:"input_path,omitempty"`          // Local source file / Yerel kaynak dosya
+	OutputFolder string `protobuf:"bytes,2,opt,name=output_folder,json=outputFolder,proto3" json:"output_folder,omitempty"` // Empty for next to the source / Kaynağın yanı için boş
+	Profile      string `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`                               // Platform profile or archive-<mode>, empty for the current settings / Platform profili veya archive-<mode>, geçerli ayarlar için boş
+}
+
+func (x *EnqueueRequest) Reset() {
+	*x = EnqueueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_converter_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnqueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnqueueRequest) ProtoMessage() {}
+
+func (x *EnqueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_converter_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnqueueRequest.ProtoReflect.Descriptor instead.
+func (*EnqueueRequest) Descriptor() ([]byte, []int) {
+	return file_converter_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EnqueueRequest) GetInputPath() string {
+	if x != nil {
+		return x.InputPath
+	}
+	return ""
+}
+
+func (x *EnqueueRequest) GetOutputFolder() string {
+	if x != nil {
+		return x.OutputFolder
+	}
+	return ""
+}
+
+func (x *EnqueueRequest) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+type Job struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                         // Unique job identifier / Benzersiz iş tanımlayıcısı
+	InputPath    string                 `protobuf:"bytes,2,opt,name=input_path,json=inputPath,proto3" json:"input_path,omitempty"`          // Source file / Kaynak dosya
+	OutputFolder string                 `protobuf:"bytes,3,opt,name=output_folder,json=outputFolder,proto3" json:"output_folder,omitempty"` // Destination folder / Hedef klasör
+	OutputPath   string                 `protobuf:"bytes,4,opt,name=output_path,json=outputPath,proto3" json:"output_path,omitempty"`       // Output file once known / Bilindiğinde çıktı dosyası
+	OutputPaths  []string               `protobuf:"bytes,5,rep,name=output_paths,json=outputPaths,proto3" json:"output_paths,omitempty"`    // Output file of every rendition / Her sürümün çıktı dosyası
+	Status       string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`                                 // queued, running, completed, failed / İş durumu
+	Error        string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`                                   // Failure reason / Hata nedeni
+	TotalFrames  int64                  `protobuf:"varint,8,opt,name=total_frames,json=totalFrames,proto3" json:"total_frames,omitempty"`   // Expected frame count / Beklenen kare sayısı
+	Settings     *Settings              `protobuf:"bytes,9,opt,name=settings,proto3" json:"settings,omitempty"`                             // Settings snapshot / Ayarların anlık görüntüsü
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`         // Creation time / Oluşturulma zamanı
+	Notes        string                 `protobuf:"bytes,11,opt,name=notes,proto3" json:"notes,omitempty"`                                  // Free text of the user / Kullanıcının serbest metni
+	Tags         []string               `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty"`                                    // Labels of the user / Kullanıcının etiketleri
+}
+
+func (x *Job) Reset() {
+	*x = Job{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_converter_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Job) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Job) ProtoMessage() {}
+
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_converter_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Job.ProtoReflect.Descriptor instead.
+func (*Job) Descriptor() ([]byte, []int) {
+	return file_converter_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Job) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Job) GetInputPath() string {
+	if x != nil {
+		return x.InputPath
+	}
+	return ""
+}
+
+func (x *Job) GetOutputFolder() string {
+	if x != nil {
+		return x.OutputFolder
+	}
+	return ""
+}
+
+func (x *Job) GetOutputPath() string {
+	if x != nil {
+		return x.OutputPath
+	}
+	return ""
+}
+
+func (x *Job) GetOutputPaths() []string {
+	if x != nil {
+		return x.OutputPaths
+	}
+	return nil
+}
+
+func (x *Job) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Job) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *Job) GetTotalFrames() int64 {
+	if x != nil {
+		return x.TotalFrames
+	}
+	return 0
+}
+
+func (x *Job) GetSettings() *Settings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *Job) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Job) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Job) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type Settings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Encoder   string `protobuf:"bytes,1,opt,name=encoder,proto3" json:"encoder,omitempty"`     // Video encoder / Video kodlayıcı
+	Crf       int32  `protobuf:"varint,2,opt,name=crf,proto3" json:"crf,omitempty"`            // Quality value / Kalite değeri
+	Preset    string `protobuf:"bytes,3,opt,name=preset,proto3" json:"preset,omitempty"`       // Encoder preset / Kodlayıcı ön ayarı
+	Container string `protobuf:"bytes,4,opt,name=container,proto3" json:"container,omitempty"` // Output container / Çıktı kapsayıcısı
+}
+
+func (x *Settings) Reset() {
+	*x = Settings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_converter_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Settings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Settings) ProtoMessage() {}
+
+func (x *Settings) ProtoReflect() protoreflect.Message {
+	mi := &file_converter_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Settings.ProtoReflect.Descriptor instead.
+func (*Settings) Descriptor() ([]byte, []int) {
+	return file_converter_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Settings) GetEncoder() string {
+	if x != nil {
+		return x.Encoder
+	}
+	return ""
+}
+
+func (x *Settings) GetCrf() int32 {
+	if x != nil {
+		return x.Crf
+	}
+	return 0
+}
+
+func (x *Settings) GetPreset() string {
+	if x != nil {
+		return x.Preset
+	}
+	return ""
+}
+
+func (x *Settings) GetContainer() string {
+	if x != nil {
+		return x.Container
+	}
+	return ""
+}
+
+type WatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"` // Job to follow, empty for every event / İzlenecek iş, tüm olaylar için boş
+}
+
+func (x *WatchRequest) Reset() {
+	*x = WatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_converter_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchRequest) ProtoMessage() {}
+
+func (x *WatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_converter_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchRequest.ProtoReflect.Descriptor instead.
+func (*WatchRequest) Descriptor() ([]byte, []int) {
+	return file_converter_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WatchRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type    string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`                // Event name such as job.progress / job.progress gibi olay adı
+	JobId   string                 `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"` // Job the event is about, empty for queue events / Olayın ilgili olduğu iş, kuyruk olaylarında boş
+	Time    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`                // When the event happened / Olayın gerçekleştiği zaman
+	Version int32                  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`         // Schema version of the event / Olayın şema sürümü
+	// Types that are assignable to Payload:
+	//	*Event_Added
+	//	*Event_Started_
+	//	*Event_Progress_
+	//	*Event_Phase_
+	//	*Event_Log_
+	//	*Event_Warning_
+	//	*Event_Stalled_
+	//	*Event_Retry_
+	//	*Event_Completed_
+	//	*Event_Failed_
+	//	*Event_Queue
+	//	*Event_Paused_
+	//	*Event_Resumed_
+	//	*Event_UploadProgress_
+	//	*Event_UploadCompleted_
+	//	*Event_UploadFailed_
+	//	*Event_QueueOrder_
+	Payload isEvent_Payload `protobuf_oneof:"payload"`
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_converter_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_converter_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_converter_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Event) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Event) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *Event) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *Event) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (m *Event) GetPayload() isEvent_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (x *Event) GetAdded() *Job {
+	if x, ok := x.GetPayload().(*Event_Added); ok {
+		return x.Added
+	}
+	return nil
+}
+
+func (x *Event) GetStarted() *Event_Started {
+	if x, ok := x.GetPayload().(*Event_Started_); ok {
+		return x.Started
+	}
+	return nil
+}
+
+func (x *Event) GetProgress() *Event_Progress {
+	if x, ok := x.GetPayload().(*Event_Progress_); ok {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *Event) GetPhase() *Event_Phase {
+	if x, ok := x.GetPayload().(*Event_Phase_); ok {
+		return x.Phase
+	}
+	return nil
+}
+
+func (x *Event) GetLog() *Event_Log {
+	if x, ok := x.GetPayload().(*Event_Log_); ok {
+		return x.Log
+	}
+	return nil
+}
+
+func (x *Event) GetWarning() *Event_Warning {
+	if x, ok := x.GetPayload().(*Event_Warning_); ok {
+		return x.Warning
+	}
+	return nil
+}
+
+func (x *Event) GetStalled() *Event_Stalled {
+	if x, ok := x.GetPayload().(*Event_Stalled_); ok {
+		return x.Stalled
+	}
+	return nil
+}
+
+func (x *Event) GetRetry() *Event_Retry {
+	if x, ok := x.GetPayload().(*Event_Retry_); ok {
+		return x.Retry
+	}
+	return nil
+}
+
+func (x *Event) GetCompleted() *Event_Completed {
+	if x, ok := x.GetPayload().(*Event_Completed_); ok {
+		return x.Completed
+	}
+	return nil
+}
+
+func (x *Event) GetFailed() *Event_Failed {
+	if x, ok := x.GetPayload().(*Event_Failed_); ok {
+		return x.Failed
+	}
+	return nil
+}
+
+func (x *Event) GetQueue() *Event_QueueCounts {
+	if x, ok := x.GetPayload().(*Event_Queue); ok {
+		return x.Queue
+	}
+	return nil
+}
+
+func (x *Event) GetPaused() *Event_Paused {
+	if x, ok := x.GetPayload().(*Event_Paused_); ok {
+		return x.Paused
+	}
+	return nil
+}
+
+func (x *Event) GetResumed() *Event_Resumed {
+	if x, ok := x.GetPayload().(*Event_Resumed_); ok {
+		return x.Resumed
+	}
+	return nil
+}
+
+func (x *Event) GetUploadProgress() *Event_UploadProgress {
+	if x, ok := x.GetPayload().(*Event_UploadProgress_); ok {
+		return x.UploadProgress
+	}
+	return nil
+}
+
+func (x *Event) GetUploadCompleted() *Event_UploadCompleted {
+	if x, ok := x.GetPayload().(*Event_UploadCompleted_); ok {
+		return x.UploadCompleted
+	}
+	return nil
+}
+
+func (x *Event) GetUploadFailed() *Event_UploadFailed {
+	if x, ok := x.GetPayload().(*Event_UploadFailed_); ok {
+		return x.UploadFailed
+	}
+	return nil
+}
+
+func (x *Event) GetQueueOrder() *Event_QueueOrder {
+	if x, ok := x.GetPayload().(*Event_QueueOrder_); ok {
+		return x.QueueOrder
+	}
+	return nil
+}
+
+type isEvent_Payload interface {
+	isEvent_Payload()
+}
+
+type Event_Added struct {
+	Added *Job `protobuf:"bytes,10,opt,name=added,proto3,oneof"` // job.added
+}
+
+type Event_Started_ struct {
+	Started *Event_Started `protobuf:"bytes,11,opt,name=started,proto3,oneof"` // job.started
+}
+
+type Event_Progress_ struct {
+	Progress *Event_Progress `protobuf:"bytes,12,opt,name=progress,proto3,oneof"` // job.progress
+}
+
+type Event_Phase_ struct {
+	Phase *Event_Phase `protobuf:"bytes,13,opt,name=phase,proto3,oneof"` // job.phase
+}
+
+type Event_Log_ struct {
+	Log *Event_Log `protobuf:"bytes,14,opt,name=log,proto3,oneof"` // job.log
+}
+
+type Event_Warning_ struct {
+	Warning *Event_Warning `protobuf:"bytes,15,opt,name=warning,proto3,oneof"` // job.warning
+}
+
+type Event_Stalled_ struct {
+	Stalled *Event_Stalled `protobuf:"bytes,16,opt,name=stalled,proto3,oneof"` // job.stalled
+}
+
+type Event_Retry_ struct {
+	Retry *Event_Retry `protobuf:"bytes,17,opt,name=retry,proto3,oneof"` // job.retry
+}
+
+type Event_Completed_ struct {
+	Completed *Event_Completed `protobuf:"bytes,18,opt,name=completed,proto3,oneof"` // job.completed
+}
+
+type Event_Failed_ struct {
+	Failed *Event_Failed `protobuf:"bytes,19,opt,name=failed,proto3,oneof"` // job.failed
+}
+
+type Event_Queue struct {
+	Queue *Event_QueueCounts `protobuf:"bytes,20,opt,name=queue,proto3,oneof"` // queue.updated
+}
+
+type Event_Paused_ struct {
+	Paused *Event_Paused `protobuf:"bytes,21,opt,name=paused,proto3,oneof"` // job.paused
+}
+
+type Event_Resumed_ struct {
+	Resumed *Event_Resumed `protobuf:"bytes,22,opt,name=resumed,proto3,oneof"` // job.resumed
+}
+
+type Event_UploadProgress_ struct {
+	UploadProgress *Event_UploadProgress `protobuf:"bytes,23,opt,name=upload_progress,json=uploadProgress,proto3,oneof"` // upload.progress
+}
+
+type Event_UploadCompleted_ struct {
+	UploadCompleted *Event_UploadCompleted `protobuf:"bytes,24,opt,name=upload_completed,json=uploadCompleted,proto3,oneof"` // upload.completed
+}
+
+type Event_UploadFailed_ struct {
+	UploadFailed *Event_UploadFailed `protobuf:"bytes,25,opt,name=upload_failed,json=uploadFailed,proto3,oneof"` // upload.failed
+}
+
+type Event_QueueOrder_ struct {
+	QueueOrder *Event_QueueOrder `protobuf:"bytes,26,opt,name=queue_order,json=queueOrder,proto3,oneof"` // queue.sorted
+}
+
+func (*Event_Added) isEvent_Payload() {}
+
+func (*Event_Started_) isEvent_Payload() {}
+
+func (*Event_Progress_) isEvent_Payload() {}
+
+func (*Event_Phase_) isEvent_Payload() {}
+
+func (*Event_Log_) isEvent_Payload() {}
+
+func (*Event_Warning_) isEvent_Payload() {}
+
+func (*Event_Stalled_) isEvent_Payload() {}
+
+func (*Event_Retry_) isEvent_Payload() {}
+
+func (*Event_Completed_) isEvent_Payload() {}
+
+func (*Event_Failed_) isEvent_Payload() {}
+
+func (*Event_Queue) isEvent_Payload() {}
+
+func (*Event_Paused_) isEvent_Payload() {}
+
+func (*Event_Resumed_) isEvent_Payload() {}
+
+func (*Event_UploadProgress_) isEvent_Payload() {}
+
+func (*Event_UploadCompleted_) isEvent_Payload() {}
+
+func (*Event_UploadFailed_) isEvent_Payload() {}
+
+func (*Event_QueueOrder_) isEvent_Payload() {}
+
+type CancelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"` // Job to cancel / İptal edilecek iş
+}
+
+func (x *CancelRequest) Reset() {
+	*x = CancelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_converter_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRequest) ProtoMessage() {}
+
+func (x *CancelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_converter_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRequest.ProtoReflect.Descriptor instead.
+func (*CancelRequest) Descriptor() ([]byte, []int) {
+	return file_converter_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CancelRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type CancelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // cancelling / cancelling
+}
+
+func (x *CancelResponse) Reset() {
+	*x = CancelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_converter_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelResponse) ProtoMessage() {}
+
+func (x *CancelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_converter_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelResponse.ProtoReflect.Descriptor instead.
+func (*CancelResponse) Descriptor() ([]byte, []int) {
+	return file_converter_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CancelResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type HistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text   string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`     // Part of the paths or notes, case-insensitive / Yolların veya notların bir parçası, büyük/küçük harf duyarsız
+	Tag    string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`       // Tag the entry must carry / Kaydın taşıması gereken etiket
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // completed or failed / completed veya failed
+}
+
+func (x *HistoryRequest) Reset() {
+	*x = HistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_converter_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryRequest) ProtoMessage() {}
+
+func (x *HistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_converter_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryRequest.ProtoReflect.Descriptor instead.
+func (*HistoryRequest) Descriptor() ([]byte, []int) {
+	return file_converter_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *HistoryRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *HistoryRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *HistoryRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type HistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*HistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"` // Oldest first / En eskisi önce
+}
+
+func (x *HistoryResponse) Reset() {
+	*x = HistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_converter_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryResponse) ProtoMessage() {}
+
+func (x *HistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_converter_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryResponse.ProtoReflect.Descriptor instead.
+func (*HistoryResponse) Descriptor() ([]byte, []int) {
+	return file_converter_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *HistoryResponse) GetEntries() []*HistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type HistoryEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId      string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                 // Job that produced the entry / Kaydı üreten iş
+	InputPath  string                 `protobuf:"bytes,2,opt,name=input_path,json=inputPath,proto3" json:"input_path,omitempty"`     // Source file / Kaynak dosya
+	OutputPath string                 `protobuf:"bytes,3,opt,name=output_path,json=outputPath,proto3" json:"output_path,omitempty"`  // Converted file / Dönüştürülmüş dosya
+	Status     string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                            // completed or failed / completed veya failed
+	Error      string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`                              // Failure reason / Hata nedeni
+	Settings   *Settings              `protobuf:"bytes,6,opt,name=settings,proto3" json:"settings,omitempty"`                        // Settings used / Kullanılan ayarlar
+	InputSize  int64                  `protobuf:"varint,7,opt,name=input_size,json=inputSize,proto3" json:"input_size,omitempty"`    // Source size in bytes / Bayt cinsinden kaynak boyutu
+	OutputSize int64                  `protobuf:"varint,8,opt,name=output_size,json=outputSize,proto3" json:"output_size,omitempty"` // Output size in bytes / Bayt cinsinden çıktı boyutu
+	Duration   float64                `protobuf:"fixed64,9,opt,name=duration,proto3" json:"duration,omitempty"`                      // Source duration in seconds / Saniye cinsinden kaynak süresi
+	Vmaf       *float64               `protobuf:"fixed64,10,opt,name=vmaf,proto3,oneof" json:"vmaf,omitempty"`                       // VMAF score, unset when not measured / VMAF puanı, ölçülmediyse boş
+	StartedAt  *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`    // Conversion start / Dönüştürme başlangıcı
+	FinishedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"` // Conversion end / Dönüştürme bitişi
+	Notes      string                 `protobuf:"bytes,13,opt,name=notes,proto3" json:"notes,omitempty"`                             // Free text of the user / Kullanıcının serbest metni
+	Tags       []string               `protobuf:"bytes,14,rep,name=tags,proto3" json:"tags,omitempty"`                               // Labels of the user / Kullanıcının etiketleri
+}
+
+func (x *HistoryEntry) Reset() {
+	*x = HistoryEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_converter_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryEntry) ProtoMessage() {}
+
+func (x *HistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_converter_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryEntry.ProtoReflect.Descriptor instead.
+func (*HistoryEntry) Descriptor() ([]byte, []int) {
+	return file_converter_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *HistoryEntry) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *HistoryEntry) GetInputPath() string {
+	if x != nil {
+		return x.InputPath
+	}
+	return ""
+}
+
+func (x *HistoryEntry) GetOutputPath() string {
+	if x != nil {
+		return x.OutputPath
+	}
+	return ""
+}
+
+func (x *HistoryEntry) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *HistoryEntry) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *HistoryEntry) GetSettings() *Settings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *HistoryEntry) GetInputSize() int64 {
+	if x != nil {
+		return x.InputSize
+	}
+	return 0
+}
+
+func (x *HistoryEntry) GetOutputSize() int64 {
+	if x != nil {
+		return x.OutputSize
+	}
+	return 0
+}
+
+func (x *HistoryEntry) GetDuration() float64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *HistoryEntry) GetVmaf() float64 {
+	if x != nil && x.Vmaf != nil {
+		return *x.Vmaf
+	}
+	return 0
+}
+
+func (x *HistoryEntry) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *HistoryEntry) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *HistoryEntry) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *HistoryEntry) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type Event_Started struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InputPath string `protobuf:"bytes,1,opt,name=input_path,json=inputPath,proto3" json:"input_path,omitempty"` // Source file / Kaynak dosya
+}
+
+func (x *Event_Started) Reset() {
+	*x = Event_Started{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_converter_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_Started) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_Started) ProtoMessage() {}
+
+func (x *Event_Started) ProtoReflect() protoreflect.Message {
+	mi := &file_converter_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_Started.ProtoReflect.Descriptor instead.
+func (*Event_Started) Descriptor() ([]byte, []int) {
+	return file_converter_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *Event_Started) GetInputPath() string {
+	if x != nil {
+		return x.InputPath
+	}
+	return ""
+}
+
+type Event_Progress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Phase   string  `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`       // download, encode, copy or transfer / Aşama
+	Percent float64 `protobuf:"fixed64,2,opt,name=percent,proto3" json:"percent,omitempty"` // Progress of the phase, 0-100 / Aşamanın ilerlemesi, 0-100
+	Speed   string  `protobuf:"bytes,3,opt,name=speed,proto3" json:"speed,omitempty"`       // Encode speed or transfer rate, may be empty / Kodlama hızı veya aktarım hızı, boş olabilir
+}
+
+func (x *Event_Progress) Reset() {
+	*x = Event_Progress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_converter_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_Progress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_Progress) ProtoMessage() {}
+
+func (x *Event_Progress) ProtoReflect() protoreflect.Message {
+	mi := &file_converter_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_Progress.ProtoReflect.Descriptor instead.
+func (*Event_Progress) Descriptor() ([]byte, []int) {
+	return file_converter_proto_rawDescGZIP(), []int{4, 1}
+}
+
+func (x *Event_Progress) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *Event_Progress) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *Event_Progress) GetSpeed() string {
+	if x != nil {
+		return x.Speed
+	}
+	return ""
+}
+
+type Event_Phase struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Phase   string  `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`       // Phase name / Aşama adı
+	Seconds float64 `protobuf:"fixed64,2,opt,name=seconds,proto3" json:"seconds,omitempty"` // Time spent in the phase / Aşamada geçen süre
+}
+
+func (x *Event_Phase) Reset() {
+	*x = Event_Phase{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_converter_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_Phase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_Phase) ProtoMessage() {}
+
+func (x *Event_Phase) ProtoReflect() protoreflect.Message {
+	mi := &file_converter_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_Phase.ProtoReflect.Descriptor instead.
+func (*Event_Phase) Descriptor() ([]byte, []int) {
+	return file_converter_proto_rawDescGZIP(), []int{4, 2}
+}
+
+func (x *Event_Phase) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *Event_Phase) GetSeconds() float64 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
+
+type Event_Log struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stage   string `protobuf:"bytes,1,opt,name=stage,proto3" json:"stage,omitempty"`     // Stage of the timeline entry / Zaman çizelgesi kaydının aşaması
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // What happened / Ne olduğu
+}
+
+func (x *Event_Log) Reset() {
+	*x = Event_Log{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_converter_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_Log) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_Log) ProtoMessage() {}
+
+func (x *Event_Log) ProtoReflect() protoreflect.Message {
+	mi := &file_converter_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_Log.ProtoReflect.Descriptor instead.
+func (*Event_Log) Descriptor() ([]byte, []int) {
+	return file_converter_proto_rawDescGZIP(), []int{4, 3}
+}
+
+func (x *Event_Log) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *Event_Log) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type Event_Warning struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"` // Warning shown to the user / Kullanıcıya gösterilen uyarı
+}
+
+func (x *Event_Warning) Reset() {
+	*x = Event_Warning{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_converter_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_Warning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_Warning) ProtoMessage() {}
+
+func (x *Event_Warning) ProtoReflect() protoreflect.Message {
+	mi := &file_converter_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_Warning.ProtoReflect.Descriptor instead.
+func (*Event_Warning) Descriptor() ([]byte, []int) {
+	return file_converter_proto_rawDescGZIP(), []int{4, 4}
+}
+
+func (x *Event_Warning) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type Event_Stalled struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Percent float64 `protobuf:"fixed64,1,opt,name=percent,proto3" json:"percent,omitempty"` // Progress when it stalled / Takıldığı andaki ilerleme
+	Seconds int32   `protobuf:"varint,2,opt,name=seconds,proto3" json:"seconds,omitempty"`  // Seconds without progress / İlerlemesiz geçen saniye
+	Recover bool    `protobuf:"varint,3,opt,name=recover,proto3" json:"recover,omitempty"`  // The stalled attempt is retried / Takılan deneme yeniden denenir
+}
+
+func (x *Event_Stalled) Reset() {
+	*x = Event_Stalled{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_converter_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_Stalled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_Stalled) ProtoMessage() {}
+
+func (x *Event_Stalled) ProtoReflect() protoreflect.Message {
+	mi := &file_converter_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_Stalled.ProtoReflect.Descriptor instead.
+func (*Event_Stalled) Descriptor() ([]byte, []int) {
+	return file_converter_proto_rawDescGZIP(), []int{4, 5}
+}
+
+func (x *Event_Stalled) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *Event_Stalled) GetSeconds() int32 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
+
+func (x *Event_Stalled) GetRecover() bool {
+	if x != nil {
+		return x.Recover
+	}
+	return false
+}
+
+type Event_Retry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attempt int32  `protobuf:"varint,1,opt,name=attempt,proto3" json:"attempt,omitempty"` // Retry number, starting at 1 / 1'den başlayan yeniden deneme numarası
+	Reason  string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`    // stalled, sleep or hardware / stalled, sleep veya hardware
+}
+
+func (x *Event_Retry) Reset() {
+	*x = Event_Retry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_converter_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_Retry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_Retry) ProtoMessage() {}
+
+func (x *Event_Retry) ProtoReflect() protoreflect.Message {
+	mi := &file_converter_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_Retry.ProtoReflect.Descriptor instead.
+func (*Event_Retry) Descriptor() ([]byte, []int) {
+	return file_converter_proto_rawDescGZIP(), []int{4, 6}
+}
+
+func (x *Event_Retry) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *Event_Retry) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type Event_Completed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OutputPath  string   `protobuf:"bytes,1,opt,name=output_path,json=outputPath,proto3" json:"output_path,omitempty"`    // First output / İlk çıktı
+	OutputPaths []string `protobuf:"bytes,2,rep,name=output_paths,json=outputPaths,proto3" json:"output_paths,omitempty"` // Every output, one per rendition / Her çıktı, yorum başına bir
+}
+
+func (x *Event_Completed) Reset() {
+	*x = Event_Completed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_converter_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_Completed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_Completed) ProtoMessage() {}
+
+func (x *Event_Completed) ProtoReflect() protoreflect.Message {
+	mi := &file_converter_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_Completed.ProtoReflect.Descriptor instead.
+func (*Event_Completed) Descriptor() ([]byte, []int) {
+	return file_converter_proto_rawDescGZIP(), []int{4, 7}
+}
+
+func (x *Event_Completed) GetOutputPath() string {
+	if x != nil {
+		return x.OutputPath
+	}
+	return ""
+}
+
+func (x *Event_Completed) GetOutputPaths() []string {
+	if x != nil {
+		return x.OutputPaths
+	}
+	return nil
+}
+
+type Event_Failed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"` // Failure reason / Hata nedeni
+}
+
+func (x *Event_Failed) Reset() {
+	*x = Event_Failed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_converter_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_Failed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_Failed) ProtoMessage() {}
+
+func (x *Event_Failed) ProtoReflect() protoreflect.Message {
+	mi := &file_converter_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_Failed.ProtoReflect.Descriptor instead.
+func (*Event_Failed) Descriptor() ([]byte, []int) {
+	return file_converter_proto_rawDescGZIP(), []int{4, 8}
+}
+
+func (x *Event_Failed) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type Event_QueueCounts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Queued    int32 `protobuf:"varint,1,opt,name=queued,proto3" json:"queued,omitempty"`       // Jobs waiting / Bekleyen işler
+	Running   int32 `protobuf:"varint,2,opt,name=running,proto3" json:"running,omitempty"`     // Jobs converting / Dönüştürülen işler
+	Completed int32 `protobuf:"varint,3,opt,name=completed,proto3" json:"completed,omitempty"` // Jobs finished successfully / Başarıyla biten işler
+	Failed    int32 `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`       // Jobs that failed / Başarısız olan işler
+}
+
+func (x *Event_QueueCounts) Reset() {
+	*x = Event_QueueCounts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_converter_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_QueueCounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_QueueCounts) ProtoMessage() {}
+
+func (x *Event_QueueCounts) ProtoReflect() protoreflect.Message {
+	mi := &file_converter_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_QueueCounts.ProtoReflect.Descriptor instead.
+func (*Event_QueueCounts) Descriptor() ([]byte, []int) {
+	return file_converter_proto_rawDescGZIP(), []int{4, 9}
+}
+
+func (x *Event_QueueCounts) GetQueued() int32 {
+	if x != nil {
+		return x.Queued
+	}
+	return 0
+}
+
+func (x *Event_QueueCounts) GetRunning() int32 {
+	if x != nil {
+		return x.Running
+	}
+	return 0
+}
+
+func (x *Event_QueueCounts) GetCompleted() int32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *Event_QueueCounts) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type Event_Paused struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason      string  `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`                           // thermal or space / thermal veya space
+	Message     string  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`                         // Why the job waits / İşin neden beklediği
+	Temperature float64 `protobuf:"fixed64,3,opt,name=temperature,proto3" json:"temperature,omitempty"`               // CPU temperature of a thermal pause / Isıl duraklamanın CPU sıcaklığı
+	ResumeAt    float64 `protobuf:"fixed64,4,opt,name=resume_at,json=resumeAt,proto3" json:"resume_at,omitempty"`     // Temperature the job resumes at / İşin devam edeceği sıcaklık
+	Folder      string  `protobuf:"bytes,5,opt,name=folder,proto3" json:"folder,omitempty"`                           // Destination of a space pause / Alan duraklamasının hedefi
+	FreeGb      float64 `protobuf:"fixed64,6,opt,name=free_gb,json=freeGb,proto3" json:"free_gb,omitempty"`           // Free space of the destination / Hedefin boş alanı
+	MinFreeGb   int32   `protobuf:"varint,7,opt,name=min_free_gb,json=minFreeGb,proto3" json:"min_free_gb,omitempty"` // Watermark the job waits for / İşin beklediği eşik
+}
+
+func (x *Event_Paused) Reset() {
+	*x = Event_Paused{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_converter_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_Paused) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_Paused) ProtoMessage() {}
+
+func (x *Event_Paused) ProtoReflect() protoreflect.Message {
+	mi := &file_converter_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_Paused.ProtoReflect.Descriptor instead.
+func (*Event_Paused) Descriptor() ([]byte, []int) {
+	return file_converter_proto_rawDescGZIP(), []int{4, 10}
+}
+
+func (x *Event_Paused) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Event_Paused) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Event_Paused) GetTemperature() float64 {
+	if x != nil {
+		return x.Temperature
+	}
+	return 0
+}
+
+func (x *Event_Paused) GetResumeAt() float64 {
+	if x != nil {
+		return x.ResumeAt
+	}
+	return 0
+}
+
+func (x *Event_Paused) GetFolder() string {
+	if x != nil {
+		return x.Folder
+	}
+	return ""
+}
+
+func (x *Event_Paused) GetFreeGb() float64 {
+	if x != nil {
+		return x.FreeGb
+	}
+	return 0
+}
+
+func (x *Event_Paused) GetMinFreeGb() int32 {
+	if x != nil {
+		return x.MinFreeGb
+	}
+	return 0
+}
+
+type Event_Resumed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason  string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`   // Reason of the pause that ended / Biten duraklamanın nedeni
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // What let the job go on / İşin devam etmesini sağlayan şey
+}
+
+func (x *Event_Resumed) Reset() {
+	*x = Event_Resumed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_converter_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_Resumed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_Resumed) ProtoMessage() {}
+
+func (x *Event_Resumed) ProtoReflect() protoreflect.Message {
+	mi := &file_converter_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_Resumed.ProtoReflect.Descriptor instead.
+func (*Event_Resumed) Descriptor() ([]byte, []int) {
+	return file_converter_proto_rawDescGZIP(), []int{4, 11}
+}
+
+func (x *Event_Resumed) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Event_Resumed) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type Event_UploadProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path    string  `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`         // Output being uploaded / Yüklenen çıktı
+	Percent float64 `protobuf:"fixed64,2,opt,name=percent,proto3" json:"percent,omitempty"` // Parts uploaded, 0-100 / Yüklenen parçalar, 0-100
+}
+
+func (x *Event_UploadProgress) Reset() {
+	*x = Event_UploadProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_converter_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_UploadProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_UploadProgress) ProtoMessage() {}
+
+func (x *Event_UploadProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_converter_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_UploadProgress.ProtoReflect.Descriptor instead.
+func (*Event_UploadProgress) Descriptor() ([]byte, []int) {
+	return file_converter_proto_rawDescGZIP(), []int{4, 12}
+}
+
+func (x *Event_UploadProgress) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Event_UploadProgress) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+type Event_UploadCompleted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path   string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`     // Uploaded output / Yüklenen çıktı
+	Bucket string `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"` // Bucket it went to / Gittiği depo
+	Key    string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`       // Key in the bucket / Depodaki anahtar
+}
+
+func (x *Event_UploadCompleted) Reset() {
+	*x = Event_UploadCompleted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_converter_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_UploadCompleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_UploadCompleted) ProtoMessage() {}
+
+func (x *Event_UploadCompleted) ProtoReflect() protoreflect.Message {
+	mi := &file_converter_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_UploadCompleted.ProtoReflect.Descriptor instead.
+func (*Event_UploadCompleted) Descriptor() ([]byte, []int) {
+	return file_converter_proto_rawDescGZIP(), []int{4, 13}
+}
+
+func (x *Event_UploadCompleted) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Event_UploadCompleted) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *Event_UploadCompleted) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type Event_UploadFailed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path  string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`   // Output that wasn't uploaded / Yüklenemeyen çıktı
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"` // Failure reason / Hata nedeni
+}
+
+func (x *Event_UploadFailed) Reset() {
+	*x = Event_UploadFailed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_converter_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_UploadFailed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_UploadFailed) ProtoMessage() {}
+
+func (x *Event_UploadFailed) ProtoReflect() protoreflect.Message {
+	mi := &file_converter_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_UploadFailed.ProtoReflect.Descriptor instead.
+func (*Event_UploadFailed) Descriptor() ([]byte, []int) {
+	return file_converter_proto_rawDescGZIP(), []int{4, 14}
+}
+
+func (x *Event_UploadFailed) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Event_UploadFailed) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type Event_QueueOrder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key        string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`                     // Sort key / Sıralama anahtarı
+	Descending bool     `protobuf:"varint,2,opt,name=descending,proto3" json:"descending,omitempty"`      // Largest first / Büyükten küçüğe
+	JobIds     []string `protobuf:"bytes,3,rep,name=job_ids,json=jobIds,proto3" json:"job_ids,omitempty"` // Queued jobs in their new order / Yeni sıralarıyla kuyruktaki işler
+}
+
+func (x *Event_QueueOrder) Reset() {
+	*x = Event_QueueOrder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_converter_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_QueueOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_QueueOrder) ProtoMessage() {}
+
+func (x *Event_QueueOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_converter_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_QueueOrder.ProtoReflect.Descriptor instead.
+func (*Event_QueueOrder) Descriptor() ([]byte, []int) {
+	return file_converter_proto_rawDescGZIP(), []int{4, 15}
+}
+
+func (x *Event_QueueOrder) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Event_QueueOrder) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *Event_QueueOrder) GetJobIds() []string {
+	if x != nil {
+		return x.JobIds
+	}
+	return nil
+}
+
+var File_converter_proto protoreflect.FileDescriptor
+
+var file_converter_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0f, 0x61, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x6e, 0x0a, 0x0e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x50, 0x61, 0x74, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x66,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x22, 0x8a, 0x03, 0x0a, 0x03, 0x4a, 0x6f, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x5f, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x61,
+	0x74, 0x68, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x61, 0x6d, 0x65,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x72,
+	0x61, 0x6d, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x22, 0x6c, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x72, 0x66, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x03, 0x63, 0x72, 0x66, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x73,
+	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x22, 0x25,
+	0x0a, 0x0c, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15,
+	0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0xe4, 0x12, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x48, 0x00, 0x52, 0x05, 0x61, 0x64, 0x64,
+	0x65, 0x64, 0x12, 0x3a, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x3d,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x61, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x48, 0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x34, 0x0a,
+	0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61,
+	0x76, 0x31, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x48, 0x00, 0x52, 0x05, 0x70, 0x68,
+	0x61, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x61, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x6f, 0x67, 0x48, 0x00, 0x52, 0x03,
+	0x6c, 0x6f, 0x67, 0x12, 0x3a, 0x0a, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x57, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12,
+	0x3a, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x61, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
+	0x48, 0x00, 0x52, 0x07, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x05, 0x72,
+	0x65, 0x74, 0x72, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x76, 0x31,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x48, 0x00, 0x52, 0x05, 0x72, 0x65, 0x74, 0x72,
+	0x79, 0x12, 0x40, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x12, 0x37, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x46, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x48, 0x00, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x05,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x76,
+	0x31, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x48,
+	0x00, 0x52, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73,
+	0x65, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x76, 0x31, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x48, 0x00, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65,
+	0x64, 0x12, 0x3a, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x18, 0x16, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x64, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x12, 0x50, 0x0a,
+	0x0f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52,
+	0x0e, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x53, 0x0a, 0x10, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x76, 0x31, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x48, 0x00, 0x52, 0x0f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x12, 0x4a, 0x0a, 0x0d, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x76,
+	0x31, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x48, 0x00, 0x52, 0x0c, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x12, 0x44, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18,
+	0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x1a, 0x28, 0x0a, 0x07, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x50, 0x61, 0x74, 0x68,
+	0x1a, 0x50, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x61,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x70, 0x65,
+	0x65, 0x64, 0x1a, 0x37, 0x0a, 0x05, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x68, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x07, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x1a, 0x35, 0x0a, 0x03, 0x4c,
+	0x6f, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x1a, 0x23, 0x0a, 0x07, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x57, 0x0a, 0x07, 0x53, 0x74, 0x61, 0x6c, 0x6c,
+	0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x1a, 0x39, 0x0a, 0x05, 0x52, 0x65, 0x74, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x1a, 0x4f, 0x0a, 0x09, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x61, 0x74, 0x68, 0x73, 0x1a, 0x1e, 0x0a, 0x06,
+	0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x75, 0x0a, 0x0b,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x1a, 0xca, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x61, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x41, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x72, 0x65, 0x65, 0x5f,
+	0x67, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x66, 0x72, 0x65, 0x65, 0x47, 0x62,
+	0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x66, 0x72, 0x65, 0x65, 0x5f, 0x67, 0x62, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x46, 0x72, 0x65, 0x65, 0x47, 0x62,
+	0x1a, 0x3b, 0x0a, 0x07, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x3e, 0x0a,
+	0x0e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x1a, 0x4f, 0x0a,
+	0x0f, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x1a, 0x38,
+	0x0a, 0x0c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x57, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x73, 0x63,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65,
+	0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x6a, 0x6f, 0x62, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6a, 0x6f, 0x62, 0x49, 0x64,
+	0x73, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x26, 0x0a, 0x0d,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a,
+	0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a,
+	0x6f, 0x62, 0x49, 0x64, 0x22, 0x28, 0x0a, 0x0e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x4e,
+	0x0a, 0x0e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x4a,
+	0x0a, 0x0f, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x37, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0xea, 0x03, 0x0a, 0x0c, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x15, 0x0a, 0x06, 0x6a,
+	0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x50, 0x61, 0x74,
+	0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x35, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x04, 0x76, 0x6d, 0x61, 0x66, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x01, 0x48, 0x00, 0x52, 0x04, 0x76, 0x6d, 0x61, 0x66, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x76, 0x6d, 0x61, 0x66, 0x32, 0xab, 0x02, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x07, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x12, 0x1f, 0x2e, 0x61, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x61, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x12, 0x40, 0x0a, 0x05, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x1d, 0x2e, 0x61, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x61, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x49, 0x0a, 0x06, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x12, 0x1e, 0x2e, 0x61, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x1f, 0x2e, 0x61, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2a, 0x5a, 0x28, 0x41, 0x56, 0x31, 0x2d, 0x76, 0x69, 0x64,
+	0x65, 0x6f, 0x2d, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x2f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_converter_proto_rawDescOnce sync.Once
+	file_converter_proto_rawDescData = file_converter_proto_rawDesc
+)
+
+func file_converter_proto_rawDescGZIP() []byte {
+	file_converter_proto_rawDescOnce.Do(func() {
+		file_converter_proto_rawDescData = protoimpl.X.CompressGZIP(file_converter_proto_rawDescData)
+	})
+	return file_converter_proto_rawDescData
+}
+
+var file_converter_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_converter_proto_goTypes = []any{
+	(*EnqueueRequest)(nil),        // 0: av1converter.v1.EnqueueRequest
+	(*Job)(nil),                   // 1: av1converter.v1.Job
+	(*Settings)(nil),              // 2: av1converter.v1.Settings
+	(*WatchRequest)(nil),          // 3: av1converter.v1.WatchRequest
+	(*Event)(nil),                 // 4: av1converter.v1.Event
+	(*CancelRequest)(nil),         // 5: av1converter.v1.CancelRequest
+	(*CancelResponse)(nil),        // 6: av1converter.v1.CancelResponse
+	(*HistoryRequest)(nil),        // 7: av1converter.v1.HistoryRequest
+	(*HistoryResponse)(nil),       // 8: av1converter.v1.HistoryResponse
+	(*HistoryEntry)(nil),          // 9: av1converter.v1.HistoryEntry
+	(*Event_Started)(nil),         // 10: av1converter.v1.Event.Started
+	(*Event_Progress)(nil),        // 11: av1converter.v1.Event.Progress
+	(*Event_Phase)(nil),           // 12: av1converter.v1.Event.Phase
+	(*Event_Log)(nil),             // 13: av1converter.v1.Event.Log
+	(*Event_Warning)(nil),         // 14: av1converter.v1.Event.Warning
+	(*Event_Stalled)(nil),         // 15: av1converter.v1.Event.Stalled
+	(*Event_Retry)(nil),           // 16: av1converter.v1.Event.Retry
+	(*Event_Completed)(nil),       // 17: av1converter.v1.Event.Completed
+	(*Event_Failed)(nil),          // 18: av1converter.v1.Event.Failed
+	(*Event_QueueCounts)(nil),     // 19: av1converter.v1.Event.QueueCounts
+	(*Event_Paused)(nil),          // 20: av1converter.v1.Event.Paused
+	(*Event_Resumed)(nil),         // 21: av1converter.v1.Event.Resumed
+	(*Event_UploadProgress)(nil),  // 22: av1converter.v1.Event.UploadProgress
+	(*Event_UploadCompleted)(nil), // 23: av1converter.v1.Event.UploadCompleted
+	(*Event_UploadFailed)(nil),    // 24: av1converter.v1.Event.UploadFailed
+	(*Event_QueueOrder)(nil),      // 25: av1converter.v1.Event.QueueOrder
+	(*timestamppb.Timestamp)(nil), // 26: google.protobuf.Timestamp
+}
+var file_converter_proto_depIdxs = []int32{
+	2,  // 0: av1converter.v1.Job.settings:type_name -> av1converter.v1.Settings
+	26, // 1: av1converter.v1.Job.created_at:type_name -> google.protobuf.Timestamp
+	26, // 2: av1converter.v1.Event.time:type_name -> google.protobuf.Timestamp
+	1,  // 3: av1converter.v1.Event.added:type_name -> av1converter.v1.Job
+	10, // 4: av1converter.v1.Event.started:type_name -> av1converter.v1.Event.Started
+	11, // 5: av1converter.v1.Event.progress:type_name -> av1converter.v1.Event.Progress
+	12, // 6: av1converter.v1.Event.phase:type_name -> av1converter.v1.Event.Phase
+	13, // 7: av1converter.v1.Event.log:type_name -> av1converter.v1.Event.Log
+	14, // 8: av1converter.v1.Event.warning:type_name -> av1converter.v1.Event.Warning
+	15, // 9: av1converter.v1.Event.stalled:type_name -> av1converter.v1.Event.Stalled
+	16, // 10: av1converter.v1.Event.retry:type_name -> av1converter.v1.Event.Retry
+	17, // 11: av1converter.v1.Event.completed:type_name -> av1converter.v1.Event.Completed
+	18, // 12: av1converter.v1.Event.failed:type_name -> av1converter.v1.Event.Failed
+	19, // 13: av1converter.v1.Event.queue:type_name -> av1converter.v1.Event.QueueCounts
+	20, // 14: av1converter.v1.Event.paused:type_name -> av1converter.v1.Event.Paused
+	21, // 15: av1converter.v1.Event.resumed:type_name -> av1converter.v1.Event.Resumed
+	22, // 16: av1converter.v1.Event.upload_progress:type_name -> av1converter.v1.Event.UploadProgress
+	23, // 17: av1converter.v1.Event.upload_completed:type_name -> av1converter.v1.Event.UploadCompleted
+	24, // 18: av1converter.v1.Event.upload_failed:type_name -> av1converter.v1.Event.UploadFailed
+	25, // 19: av1converter.v1.Event.queue_order:type_name -> av1converter.v1.Event.QueueOrder
+	9,  // 20: av1converter.v1.HistoryResponse.entries:type_name -> av1converter.v1.HistoryEntry
+	2,  // 21: av1converter.v1.HistoryEntry.settings:type_name -> av1converter.v1.Settings
+	26, // 22: av1converter.v1.HistoryEntry.started_at:type_name -> google.protobuf.Timestamp
+	26, // 23: av1converter.v1.HistoryEntry.finished_at:type_name -> google.protobuf.Timestamp
+	0,  // 24: av1converter.v1.Converter.Enqueue:input_type -> av1converter.v1.EnqueueRequest
+	3,  // 25: av1converter.v1.Converter.Watch:input_type -> av1converter.v1.WatchRequest
+	5,  // 26: av1converter.v1.Converter.Cancel:input_type -> av1converter.v1.CancelRequest
+	7,  // 27: av1converter.v1.Converter.GetHistory:input_type -> av1converter.v1.HistoryRequest
+	1,  // 28: av1converter.v1.Converter.Enqueue:output_type -> av1converter.v1.Job
+	4,  // 29: av1converter.v1.Converter.Watch:output_type -> av1converter.v1.Event
+	6,  // 30: av1converter.v1.Converter.Cancel:output_type -> av1converter.v1.CancelResponse
+	8,  // 31: av1converter.v1.Converter.GetHistory:output_type -> av1converter.v1.HistoryResponse
+	28, // [28:32] is the sub-list for method output_type
+	24, // [24:28] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
+}
+
+func init() { file_converter_proto_init() }
+func file_converter_proto_init() {
+	if File_converter_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_converter_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*EnqueueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_converter_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*Job); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_converter_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*Settings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_converter_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*WatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_converter_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_converter_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_converter_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_converter_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*HistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_converter_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*HistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_converter_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*HistoryEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_converter_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*Event_Started); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_converter_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*Event_Progress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_converter_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*Event_Phase); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_converter_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*Event_Log); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_converter_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*Event_Warning); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_converter_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*Event_Stalled); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_converter_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*Event_Retry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_converter_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*Event_Completed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_converter_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*Event_Failed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_converter_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*Event_QueueCounts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_converter_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*Event_Paused); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_converter_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*Event_Resumed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_converter_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*Event_UploadProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_converter_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*Event_UploadCompleted); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_converter_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*Event_UploadFailed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_converter_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*Event_QueueOrder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_converter_proto_msgTypes[4].OneofWrappers = []any{
+		(*Event_Added)(nil),
+		(*Event_Started_)(nil),
+		(*Event_Progress_)(nil),
+		(*Event_Phase_)(nil),
+		(*Event_Log_)(nil),
+		(*Event_Warning_)(nil),
+		(*Event_Stalled_)(nil),
+		(*Event_Retry_)(nil),
+		(*Event_Completed_)(nil),
+		(*Event_Failed_)(nil),
+		(*Event_Queue)(nil),
+		(*Event_Paused_)(nil),
+		(*Event_Resumed_)(nil),
+		(*Event_UploadProgress_)(nil),
+		(*Event_UploadCompleted_)(nil),
+		(*Event_UploadFailed_)(nil),
+		(*Event_QueueOrder_)(nil),
+	}
+	file_converter_proto_msgTypes[9].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_converter_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   26,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_converter_proto_goTypes,
+		DependencyIndexes: file_converter_proto_depIdxs,
+		MessageInfos:      file_converter_proto_msgTypes,
+	}.Build()
+	File_converter_proto = out.File
+	file_converter_proto_rawDesc = nil
+	file_converter_proto_goTypes = nil
+	file_converter_proto_depIdxs = nil
+}
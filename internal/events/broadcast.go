@@ -0,0 +1,64 @@
+package events
+
+import "sync"
+
+// Broadcaster struct
+// Hands timeline events to subscribers such as the API watch streams
+// Zaman çizelgesi olaylarını API izleme akışları gibi abonelere iletir
+type Broadcaster struct {
+	mu          sync.Mutex
+	subscribers map[chan Envelope]struct{}
+}
+
+// NewBroadcaster creates a broadcaster without subscribers
+// Abonesi olmayan bir yayıncı oluşturur
+func NewBroadcaster() *Broadcaster {
+	return &Broadcaster{subscribers: make(map[chan Envelope]struct{})}
+}
+
+// Emit passes a timeline event to every subscriber
+// Other events are ignored, a subscriber whose buffer is full misses the event
+// Diğer olaylar yok sayılır, arabelleği dolu bir abone olayı kaçırır
+func (b *Broadcaster) Emit(name string, data interface{}) {
+	envelope, ok := data.(Envelope)
+	if !ok {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.subscribers {
+		select {
+		case ch <- envelope:
+		default:
+		}
+	}
+}
+
+// Subscribe returns a channel receiving the events and a function ending the subscription
+// Olayları alan bir kanal ve aboneliği bitiren bir fonksiyon döndürür
+func (b *Broadcaster) Subscribe(buffer int) (<-chan Envelope, func()) {
+	ch := make(chan Envelope, buffer)
+	b.mu.Lock()
+	b.subscribers[ch] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.subscribers, ch)
+			b.mu.Unlock()
+			close(ch)
+		})
+	}
+}
+
+// Tee sends every event to all of the sinks
+// Her olayı tüm alıcılara gönderir
+func Tee(sinks ...Sink) Sink {
+	return SinkFunc(func(name string, data interface{}) {
+		for _, sink := range sinks {
+			sink.Emit(name, data)
+		}
+	})
+}
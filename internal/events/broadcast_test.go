@@ -0,0 +1,97 @@
+package events
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestBroadcasterFanOut hands timeline events to every subscriber and ignores other events
+// Zaman çizelgesi olaylarını her aboneye iletir ve diğer olayları yok sayar
+func TestBroadcasterFanOut(t *testing.T) {
+	b := NewBroadcaster()
+	first, unsubscribeFirst := b.Subscribe(4)
+	defer unsubscribeFirst()
+	second, unsubscribeSecond := b.Subscribe(4)
+	defer unsubscribeSecond()
+
+	b.Emit("thermal:paused", map[string]interface{}{"celsius": 95})
+	Emit(b, JobStarted, "job-1", nil)
+
+	for i, ch := range []<-chan Envelope{first, second} {
+		select {
+		case envelope := <-ch:
+			if envelope.Type != JobStarted || envelope.JobID != "job-1" || envelope.Version != SchemaVersion {
+				t.Errorf("subscriber %d got %+v", i, envelope)
+			}
+		default:
+			t.Fatalf("subscriber %d got no event", i)
+		}
+		select {
+		case envelope := <-ch:
+			t.Errorf("subscriber %d got an extra event %+v", i, envelope)
+		default:
+		}
+	}
+}
+
+// TestBroadcasterFullBuffer drops events for a subscriber that doesn't keep up instead of blocking
+// Yetişemeyen bir abone için engellemek yerine olayları atar
+func TestBroadcasterFullBuffer(t *testing.T) {
+	b := NewBroadcaster()
+	ch, unsubscribe := b.Subscribe(1)
+	defer unsubscribe()
+
+	Emit(b, JobProgress, "job-1", 10)
+	Emit(b, JobProgress, "job-1", 20)
+	if envelope := <-ch; envelope.Data != 10 {
+		t.Errorf("got %v, want the first event", envelope.Data)
+	}
+	select {
+	case envelope := <-ch:
+		t.Errorf("got %v, want the second event dropped", envelope.Data)
+	default:
+	}
+}
+
+// TestBroadcasterUnsubscribe closes the channel once and stops delivery
+// Kanalı bir kez kapatır ve iletimi durdurur
+func TestBroadcasterUnsubscribe(t *testing.T) {
+	b := NewBroadcaster()
+	ch, unsubscribe := b.Subscribe(1)
+	unsubscribe()
+	unsubscribe()
+
+	if _, ok := <-ch; ok {
+		t.Error("channel still open after unsubscribe")
+	}
+	Emit(b, JobStarted, "job-1", nil)
+	if len(b.subscribers) != 0 {
+		t.Errorf("%d subscribers left", len(b.subscribers))
+	}
+}
+
+// TestTee sends every event to all sinks in order
+// Her olayı tüm alıcılara sırayla gönderir
+func TestTee(t *testing.T) {
+	var got []string
+	record := func(prefix string) Sink {
+		return SinkFunc(func(name string, data interface{}) {
+			got = append(got, prefix+name)
+		})
+	}
+	b := NewBroadcaster()
+	ch, unsubscribe := b.Subscribe(1)
+	defer unsubscribe()
+
+	sink := Tee(record("a:"), Discard, b, record("b:"))
+	sink.Emit("queue:updated", nil)
+	Emit(sink, JobCompleted, "job-1", nil)
+
+	want := []string{"a:queue:updated", "b:queue:updated", "a:" + JobCompleted, "b:" + JobCompleted}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("events = %v, want %v", got, want)
+	}
+	if envelope := <-ch; envelope.Type != JobCompleted {
+		t.Errorf("broadcaster got %+v", envelope)
+	}
+}
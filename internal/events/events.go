@@ -0,0 +1,24 @@
+// Package events decouples the conversion logic from the Wails runtime
+// Dönüştürme mantığını Wails çalışma zamanından ayırır
+package events
+
+// Sink interface
+// Receives the events the backend reports to the frontend
+// Arka ucun ön yüze bildirdiği olayları alır
+type Sink interface {
+	Emit(name string, data interface{})
+}
+
+// SinkFunc adapts a function to the Sink interface
+// Bir fonksiyonu Sink arayüzüne uyarlar
+type SinkFunc func(name string, data interface{})
+
+// Emit calls the function
+// Fonksiyonu çağırır
+func (f SinkFunc) Emit(name string, data interface{}) {
+	f(name, data)
+}
+
+// Discard drops every event, used before the UI is up and in CLI modes
+// Her olayı yok sayar, arayüz açılmadan önce ve CLI modlarında kullanılır
+var Discard Sink = SinkFunc(func(string, interface{}) {})
@@ -0,0 +1,54 @@
+package events
+
+import "time"
+
+// SchemaVersion is the version of the job timeline events, bumped on incompatible payload changes
+// İş zaman çizelgesi olaylarının sürümü, uyumsuz yük değişikliklerinde artırılır
+const SchemaVersion = 1
+
+// Job timeline event names, every one is sent wrapped in an Envelope
+// The payload of each is documented next to its type in the main package
+// Her birinin yükü ana paketteki türünün yanında belgelenmiştir
+const (
+	JobAdded     = "job.added"     // A job was created / Bir iş oluşturuldu
+	JobStarted   = "job.started"   // A job began converting / Bir iş dönüştürmeye başladı
+	JobProgress  = "job.progress"  // Progress of the current phase / Geçerli aşamanın ilerlemesi
+	JobPhase     = "job.phase"     // A phase ended with its duration / Bir aşama süresiyle sona erdi
+	JobLog       = "job.log"       // A line of the job timeline / İş zaman çizelgesinin bir satırı
+	JobWarning   = "job.warning"   // Something the output can't keep / Çıktının koruyamadığı bir şey
+	JobStalled   = "job.stalled"   // No progress within the stall timeout / Takılma zaman aşımı içinde ilerleme yok
+	JobRetry     = "job.retry"     // A stalled attempt is retried / Takılan bir deneme yeniden deneniyor
+	JobCompleted = "job.completed" // A job finished successfully / Bir iş başarıyla bitti
+	JobFailed    = "job.failed"    // A job failed or was cancelled / Bir iş başarısız oldu veya iptal edildi
+	QueueUpdated = "queue.updated" // Jobs were added, removed or finished / İşler eklendi, kaldırıldı veya bitti
+
+	JobPaused       = "job.paused"       // A job waits, e.g. for the CPU to cool down / Bir iş bekliyor, örn. CPU'nun soğumasını
+	JobResumed      = "job.resumed"      // A paused job goes on / Duraklatılan bir iş devam ediyor
+	UploadProgress  = "upload.progress"  // Progress of a bucket upload / Bir depo yüklemesinin ilerlemesi
+	UploadCompleted = "upload.completed" // An output was uploaded to the bucket / Bir çıktı depoya yüklendi
+	UploadFailed    = "upload.failed"    // A bucket upload failed / Bir depo yüklemesi başarısız oldu
+	QueueSorted     = "queue.sorted"     // Queued jobs were reordered / Kuyruktaki işler yeniden sıralandı
+)
+
+// Envelope struct
+// Wraps every timeline event so consumers can check the version and type before the payload
+// Tüketicilerin yükten önce sürümü ve türü kontrol edebilmesi için her zaman çizelgesi olayını sarar
+type Envelope struct {
+	Version int         `json:"version"`         // SchemaVersion / Şema sürümü
+	Type    string      `json:"type"`            // Event name / Olay adı
+	JobID   string      `json:"jobId,omitempty"` // Job the event is about, empty for queue events / Olayın ilgili olduğu iş, kuyruk olaylarında boş
+	Time    time.Time   `json:"time"`            // When the event happened / Olayın gerçekleştiği zaman
+	Data    interface{} `json:"data"`            // Typed payload of the event / Olayın türlü yükü
+}
+
+// Emit sends a timeline event wrapped in an Envelope
+// Bir zaman çizelgesi olayını Envelope içinde gönderir
+func Emit(sink Sink, name, jobID string, data interface{}) {
+	sink.Emit(name, Envelope{
+		Version: SchemaVersion,
+		Type:    name,
+		JobID:   jobID,
+		Time:    time.Now(),
+		Data:    data,
+	})
+}
@@ -0,0 +1,36 @@
+package probe
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// deepProbeExtensions lists the transport and program stream formats cameras and discs write
+// Their audio and subtitle streams may start seconds in, so the default 5 MB look-ahead misses them
+// Ses ve altyazı akışları saniyeler sonra başlayabilir, bu yüzden varsayılan 5 MB ön okuma onları kaçırır
+var deepProbeExtensions = map[string]bool{
+	".ts": true, ".mts": true, ".m2ts": true,
+	".mpg": true, ".mpeg": true, ".vob": true,
+	".mxf": true,
+}
+
+// deepProbeSize is the look-ahead used for those formats, in bytes and microseconds
+// Bu biçimler için kullanılan ön okuma, bayt ve mikrosaniye cinsinden
+const deepProbeSize = "100M"
+
+// Args returns the FFprobe arguments that read the streams and format of a file
+// Bir dosyanın akışlarını ve biçimini okuyan FFprobe argümanlarını döndürür
+func Args(path string) []string {
+	args := []string{"-v", "quiet", "-print_format", "json", "-show_format", "-show_streams"}
+	args = append(args, InputArgs(path)...)
+	return append(args, path)
+}
+
+// InputArgs returns the demuxer options a file needs before its -i, shared by FFprobe and FFmpeg
+// Bir dosyanın -i öncesinde ihtiyaç duyduğu ayrıştırıcı seçeneklerini döndürür, FFprobe ve FFmpeg ortak kullanır
+func InputArgs(path string) []string {
+	if !deepProbeExtensions[strings.ToLower(filepath.Ext(path))] {
+		return nil
+	}
+	return []string{"-analyzeduration", deepProbeSize, "-probesize", deepProbeSize}
+}
@@ -0,0 +1,39 @@
+package probe
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// HDR10PlusArgs are the FFprobe arguments that read the side data of the first frames
+// HDR10+ metadata is per frame, a mastered stream carries it from the first frame on
+// HDR10+ meta verisi kare başınadır, işlenmiş bir akış onu ilk kareden itibaren taşır
+var HDR10PlusArgs = []string{
+	"-v", "quiet",
+	"-print_format", "json",
+	"-select_streams", "v:0",
+	"-read_intervals", "%+#5",
+	"-show_frames",
+	"-show_entries", "frame=side_data_list",
+}
+
+// HasHDR10Plus reports whether the output of HDR10PlusArgs contains SMPTE 2094-40 metadata
+// HDR10PlusArgs çıktısının SMPTE 2094-40 meta verisi içerip içermediğini bildirir
+func HasHDR10Plus(data []byte) bool {
+	var result struct {
+		Frames []struct {
+			SideDataList []SideData `json:"side_data_list"`
+		} `json:"frames"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return false
+	}
+	for _, frame := range result.Frames {
+		for _, side := range frame.SideDataList {
+			if strings.Contains(side.SideDataType, "SMPTE2094-40") {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,76 @@
+package probe
+
+import (
+	"bufio"
+	"bytes"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// PacketArgs are the FFprobe arguments that list the video packets of a file
+// Bir dosyanın video paketlerini listeleyen FFprobe argümanlarıdır
+var PacketArgs = []string{
+	"-v", "error",
+	"-select_streams", "v:0",
+	"-show_entries", "packet=pts_time,dts_time,size",
+	"-of", "compact=p=0",
+}
+
+// Packet struct
+// Represents the timestamp and size of a packet
+// Bir paketin zaman damgasını ve boyutunu temsil eder
+type Packet struct {
+	Time float64 // Presentation time in seconds / Saniye cinsinden gösterim zamanı
+	Size int     // Size in bytes / Bayt cinsinden boyut
+}
+
+// ParsePackets parses the compact output of PacketArgs
+// Packets without a timestamp fall back to their decode time and are skipped without either
+// Zaman damgası olmayan paketler çözme zamanına düşer, ikisi de yoksa atlanır
+func ParsePackets(data []byte) []Packet {
+	var packets []Packet
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		var packet Packet
+		pts, dts := -1.0, -1.0
+		for _, field := range strings.Split(scanner.Text(), "|") {
+			key, value, _ := strings.Cut(field, "=")
+			switch key {
+			case "pts_time":
+				if seconds, err := strconv.ParseFloat(value, 64); err == nil {
+					pts = seconds
+				}
+			case "dts_time":
+				if seconds, err := strconv.ParseFloat(value, 64); err == nil {
+					dts = seconds
+				}
+			case "size":
+				packet.Size, _ = strconv.Atoi(value)
+			}
+		}
+		switch {
+		case pts >= 0:
+			packet.Time = pts
+		case dts >= 0:
+			packet.Time = dts
+		default:
+			continue
+		}
+		packets = append(packets, packet)
+	}
+	return packets
+}
+
+// PacketSpan returns the earliest and latest packet times, packets may be stored out of order
+// En erken ve en geç paket zamanlarını döndürür, paketler sırasız saklanmış olabilir
+func PacketSpan(packets []Packet) (start, end float64) {
+	if len(packets) == 0 {
+		return 0, 0
+	}
+	start, end = packets[0].Time, packets[0].Time
+	for _, packet := range packets {
+		start, end = math.Min(start, packet.Time), math.Max(end, packet.Time)
+	}
+	return start, end
+}
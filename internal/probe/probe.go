@@ -0,0 +1,148 @@
+// Package probe reads media information with FFprobe
+// FFprobe ile medya bilgilerini okur
+package probe
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"AV1-video-converter/internal/runner"
+)
+
+// Stream struct
+// Represents a stream as reported by FFprobe
+// FFprobe'un bildirdiği şekliyle bir akışı temsil eder
+type Stream struct {
+	Index            int    `json:"index"`
+	CodecName        string `json:"codec_name"`
+	CodecType        string `json:"codec_type"`
+	NbFrames         string `json:"nb_frames"`
+	AvgFrameRate     string `json:"avg_frame_rate"`
+	Width            int    `json:"width"`
+	Height           int    `json:"height"`
+	PixFmt           string `json:"pix_fmt"`
+	BitsPerRawSample string `json:"bits_per_raw_sample"`
+	ColorTransfer    string `json:"color_transfer"`
+	ColorPrimaries   string `json:"color_primaries"`
+	ColorSpace       string `json:"color_space"`
+	ColorRange       string `json:"color_range"`
+	Channels         int    `json:"channels"`
+	Duration         string `json:"duration"`
+	Disposition      struct {
+		Default     int `json:"default"`
+		Forced      int `json:"forced"`
+		AttachedPic int `json:"attached_pic"`
+	} `json:"disposition"`
+	Tags struct {
+		Language string `json:"language"`
+		Title    string `json:"title"`
+	} `json:"tags"`
+	SideDataList []SideData `json:"side_data_list"`
+}
+
+// SideData struct
+// Represents stream or frame side data such as the Dolby Vision configuration
+// Dolby Vision yapılandırması gibi akış veya kare yan verisini temsil eder
+type SideData struct {
+	SideDataType string `json:"side_data_type"`
+	DVProfile    int    `json:"dv_profile"`
+}
+
+// Format struct
+// Represents the container information reported by FFprobe
+// FFprobe'un bildirdiği kapsayıcı bilgisini temsil eder
+type Format struct {
+	Duration string `json:"duration"`
+	Size     string `json:"size"`
+	BitRate  string `json:"bit_rate"`
+}
+
+// Result struct
+// Represents the streams and format of a media file
+// Bir medya dosyasının akışlarını ve biçimini temsil eder
+type Result struct {
+	Streams []Stream `json:"streams"`
+	Format  Format   `json:"format"`
+}
+
+// Prober interface
+// Reads the streams and format of a media file
+// Bir medya dosyasının akışlarını ve biçimini okur
+type Prober interface {
+	Probe(ctx context.Context, path string) (Result, error)
+}
+
+// FFprobe struct
+// Probes files by running the FFprobe binary
+// Dosyaları FFprobe ikili dosyasını çalıştırarak inceler
+type FFprobe struct {
+	Path   string        // FFprobe binary / FFprobe ikili dosyası
+	Runner runner.Runner // Runs the binary / İkili dosyayı çalıştırır
+}
+
+// Probe runs FFprobe on a file and parses its JSON output
+// Bir dosya üzerinde FFprobe çalıştırır ve JSON çıktısını ayrıştırır
+func (p FFprobe) Probe(ctx context.Context, path string) (Result, error) {
+	out, err := p.Runner.Output(ctx, p.Path, Args(path)...)
+	if err != nil {
+		return Result{}, err
+	}
+	return Parse(out)
+}
+
+// Parse reads the JSON output of FFprobe
+// FFprobe'un JSON çıktısını okur
+func Parse(data []byte) (Result, error) {
+	var result Result
+	if err := json.Unmarshal(data, &result); err != nil {
+		return Result{}, fmt.Errorf("invalid FFprobe output: %v", err)
+	}
+	return result, nil
+}
+
+// FrameRate converts the average frame rate like "30000/1001" to a number
+// "30000/1001" gibi ortalama kare hızını sayıya dönüştürür
+func (s Stream) FrameRate() float64 {
+	parts := strings.SplitN(s.AvgFrameRate, "/", 2)
+	numerator, err := strconv.ParseFloat(parts[0], 64)
+	if err != nil {
+		return 0
+	}
+	if len(parts) == 1 {
+		return numerator
+	}
+	denominator, err := strconv.ParseFloat(parts[1], 64)
+	if err != nil || denominator == 0 {
+		return 0
+	}
+	return numerator / denominator
+}
+
+// DolbyVisionProfile returns the Dolby Vision profile, 0 without a configuration record
+// Dolby Vision profilini döndürür, yapılandırma kaydı yoksa 0
+func (s Stream) DolbyVisionProfile() int {
+	for _, data := range s.SideDataList {
+		if strings.HasPrefix(data.SideDataType, "DOVI configuration") {
+			return data.DVProfile
+		}
+	}
+	return 0
+}
+
+// BitDepth returns the bit depth of the pixel format
+// Piksel formatının bit derinliğini döndürür
+func (s Stream) BitDepth() int {
+	switch {
+	case strings.Contains(s.PixFmt, "12"):
+		return 12
+	case strings.Contains(s.PixFmt, "10") || s.PixFmt == "p010le":
+		return 10
+	}
+	if bits, err := strconv.Atoi(s.BitsPerRawSample); err == nil && bits > 0 {
+		return bits
+	}
+	return 8
+}
@@ -0,0 +1,100 @@
+package probe
+
+import "testing"
+
+// TestParse reads a trimmed FFprobe output with a video, an audio and a subtitle stream
+// Bir video, bir ses ve bir altyazı akışı içeren kısaltılmış bir FFprobe çıktısını okur
+func TestParse(t *testing.T) {
+	data := []byte(`{
+		"streams": [
+			{"index": 0, "codec_name": "hevc", "codec_type": "video", "width": 3840, "height": 2160,
+			 "pix_fmt": "yuv420p10le", "avg_frame_rate": "24000/1001", "nb_frames": "1440",
+			 "color_transfer": "smpte2084", "disposition": {"default": 1},
+			 "side_data_list": [{"side_data_type": "DOVI configuration record", "dv_profile": 8}]},
+			{"index": 1, "codec_name": "eac3", "codec_type": "audio", "channels": 6,
+			 "tags": {"language": "tur", "title": "Türkçe"}},
+			{"index": 2, "codec_name": "subrip", "codec_type": "subtitle", "disposition": {"forced": 1}}
+		],
+		"format": {"duration": "60.060000", "size": "123456789", "bit_rate": "16443956"}
+	}`)
+	result, err := Parse(data)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if len(result.Streams) != 3 {
+		t.Fatalf("got %d streams, want 3", len(result.Streams))
+	}
+	video, audio, subtitle := result.Streams[0], result.Streams[1], result.Streams[2]
+	if video.CodecName != "hevc" || video.Width != 3840 || video.Height != 2160 || video.NbFrames != "1440" {
+		t.Errorf("video stream = %+v", video)
+	}
+	if video.Disposition.Default != 1 || video.DolbyVisionProfile() != 8 {
+		t.Errorf("video default = %d, Dolby Vision profile = %d", video.Disposition.Default, video.DolbyVisionProfile())
+	}
+	if audio.Channels != 6 || audio.Tags.Language != "tur" || audio.Tags.Title != "Türkçe" {
+		t.Errorf("audio stream = %+v", audio)
+	}
+	if subtitle.Disposition.Forced != 1 || subtitle.DolbyVisionProfile() != 0 {
+		t.Errorf("subtitle stream = %+v", subtitle)
+	}
+	if result.Format.Duration != "60.060000" || result.Format.Size != "123456789" {
+		t.Errorf("format = %+v", result.Format)
+	}
+}
+
+// TestParseInvalid rejects output that isn't JSON
+// JSON olmayan çıktıyı reddeder
+func TestParseInvalid(t *testing.T) {
+	for _, data := range []string{"", "Invalid data found when processing input", `{"streams": [`} {
+		if _, err := Parse([]byte(data)); err == nil {
+			t.Errorf("Parse(%q) succeeded, want an error", data)
+		}
+	}
+}
+
+// TestFrameRate converts the rational and plain frame rates FFprobe reports
+// FFprobe'un bildirdiği kesirli ve düz kare hızlarını dönüştürür
+func TestFrameRate(t *testing.T) {
+	tests := []struct {
+		rate string
+		want float64
+	}{
+		{"30000/1001", 30000.0 / 1001},
+		{"25/1", 25},
+		{"50", 50},
+		{"0/0", 0},
+		{"24/0", 0},
+		{"", 0},
+		{"N/A", 0},
+		{"24/abc", 0},
+	}
+	for _, test := range tests {
+		if got := (Stream{AvgFrameRate: test.rate}).FrameRate(); got != test.want {
+			t.Errorf("FrameRate(%q) = %v, want %v", test.rate, got, test.want)
+		}
+	}
+}
+
+// TestBitDepth reads the bit depth from the pixel format, then the raw sample size
+// Bit derinliğini piksel formatından, sonra ham örnek boyutundan okur
+func TestBitDepth(t *testing.T) {
+	tests := []struct {
+		pixFmt string
+		bits   string
+		want   int
+	}{
+		{"yuv420p", "", 8},
+		{"yuv420p10le", "", 10},
+		{"p010le", "", 10},
+		{"yuv444p12le", "", 12},
+		{"gbrp", "10", 10},
+		{"yuv420p", "8", 8},
+		{"", "N/A", 8},
+		{"", "0", 8},
+	}
+	for _, test := range tests {
+		if got := (Stream{PixFmt: test.pixFmt, BitsPerRawSample: test.bits}).BitDepth(); got != test.want {
+			t.Errorf("BitDepth(%q, %q) = %d, want %d", test.pixFmt, test.bits, got, test.want)
+		}
+	}
+}
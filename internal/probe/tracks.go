@@ -0,0 +1,84 @@
+package probe
+
+import (
+	"bufio"
+	"bytes"
+	"strconv"
+	"strings"
+)
+
+// TrackPacketArgs are the FFprobe arguments that list the packets of every stream
+// Her akışın paketlerini listeleyen FFprobe argümanlarıdır
+var TrackPacketArgs = []string{
+	"-v", "error",
+	"-show_entries", "packet=stream_index,pts_time,duration_time,size",
+	"-of", "compact=p=0",
+}
+
+// TrackStats struct
+// Represents the totals of a stream like mkvmerge's statistics tags
+// mkvmerge'in istatistik etiketleri gibi bir akışın toplamlarını temsil eder
+type TrackStats struct {
+	Bytes   int64   // Payload size / Yük boyutu
+	Packets int     // Number of packets, one frame each / Paket sayısı, her biri bir kare
+	Start   float64 // First timestamp in seconds / Saniye cinsinden ilk zaman damgası
+	End     float64 // End of the last packet in seconds / Saniye cinsinden son paketin sonu
+}
+
+// Seconds returns the time the stream spans
+// Akışın kapsadığı süreyi döndürür
+func (t TrackStats) Seconds() float64 {
+	return t.End - t.Start
+}
+
+// ParseTrackStats sums the output of TrackPacketArgs per stream index
+// TrackPacketArgs çıktısını akış dizinine göre toplar
+func ParseTrackStats(data []byte) map[int]*TrackStats {
+	tracks := make(map[int]*TrackStats)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		index, size := -1, 0
+		pts, duration := -1.0, 0.0
+		for _, field := range strings.Split(scanner.Text(), "|") {
+			key, value, _ := strings.Cut(field, "=")
+			switch key {
+			case "stream_index":
+				if n, err := strconv.Atoi(value); err == nil {
+					index = n
+				}
+			case "size":
+				size, _ = strconv.Atoi(value)
+			case "pts_time":
+				if seconds, err := strconv.ParseFloat(value, 64); err == nil {
+					pts = seconds
+				}
+			case "duration_time":
+				duration, _ = strconv.ParseFloat(value, 64)
+			}
+		}
+		if index < 0 {
+			continue
+		}
+		track, ok := tracks[index]
+		if !ok {
+			track = &TrackStats{Start: -1}
+			tracks[index] = track
+		}
+		track.Bytes += int64(size)
+		track.Packets++
+		if pts >= 0 {
+			if track.Start < 0 || pts < track.Start {
+				track.Start = pts
+			}
+			if end := pts + duration; end > track.End {
+				track.End = end
+			}
+		}
+	}
+	for _, track := range tracks {
+		if track.Start < 0 {
+			track.Start = 0
+		}
+	}
+	return tracks
+}
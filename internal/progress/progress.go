@@ -0,0 +1,157 @@
+// Package progress parses the status lines FFmpeg writes while encoding
+// FFmpeg'in kodlama sırasında yazdığı durum satırlarını ayrıştırır
+package progress
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// fieldRegex matches key=value pairs, FFmpeg pads values with spaces
+// anahtar=değer çiftleriyle eşleşir, FFmpeg değerleri boşluklarla doldurur
+var fieldRegex = regexp.MustCompile(`(\w+)=\s*(\S+)`)
+
+// sizeUnits maps the size suffixes of FFmpeg builds to bytes
+// FFmpeg derlemelerinin boyut soneklerini bayta eşler
+var sizeUnits = map[string]int64{
+	"b":   1,
+	"kb":  1024,
+	"kib": 1024,
+	"mb":  1024 * 1024,
+	"mib": 1024 * 1024,
+	"gb":  1024 * 1024 * 1024,
+	"gib": 1024 * 1024 * 1024,
+}
+
+// Status struct
+// Represents a parsed FFmpeg status line, missing or N/A fields stay zero
+// Ayrıştırılmış bir FFmpeg durum satırını temsil eder, eksik veya N/A alanlar sıfır kalır
+type Status struct {
+	Frame    int     // Frames encoded so far / Şimdiye kadar kodlanan kareler
+	HasFrame bool    // Frame was reported / Kare bildirildi
+	Time     float64 // Output position in seconds / Saniye cinsinden çıktı konumu
+	HasTime  bool    // Time was reported / Zaman bildirildi
+	Size     int64   // Output size in bytes / Bayt cinsinden çıktı boyutu
+	HasSize  bool    // Size was reported / Boyut bildirildi
+	Speed    string  // Speed as a multiple of real time, empty if unknown / Gerçek zamanın katı olarak hız, bilinmiyorsa boş
+}
+
+// ParseLine reads the fields of a status line
+// Lines of other output, and status lines with no frame, time or size, are rejected
+// Diğer çıktı satırları ve kare, zaman veya boyut içermeyen durum satırları reddedilir
+func ParseLine(line string) (Status, bool) {
+	var status Status
+	for _, match := range fieldRegex.FindAllStringSubmatch(line, -1) {
+		key, value := match[1], match[2]
+		if strings.EqualFold(value, "N/A") {
+			continue
+		}
+		switch key {
+		case "frame":
+			if frame, err := strconv.Atoi(value); err == nil && frame >= 0 {
+				status.Frame, status.HasFrame = frame, true
+			}
+		case "time":
+			if seconds, ok := parseTime(value); ok {
+				status.Time, status.HasTime = seconds, true
+			}
+		case "size", "Lsize":
+			if size, ok := parseSize(value); ok {
+				status.Size, status.HasSize = size, true
+			}
+		case "speed":
+			status.Speed = value
+		}
+	}
+	return status, status.HasFrame || status.HasTime || status.HasSize
+}
+
+// parseTime reads an HH:MM:SS.ss position, negative positions count as zero
+// SS:DD:SS.ss biçimindeki bir konumu okur, negatif konumlar sıfır sayılır
+func parseTime(value string) (float64, bool) {
+	if strings.HasPrefix(value, "-") {
+		return 0, true
+	}
+	parts := strings.Split(value, ":")
+	if len(parts) != 3 {
+		return 0, false
+	}
+	var seconds float64
+	for _, part := range parts {
+		number, err := strconv.ParseFloat(part, 64)
+		if err != nil {
+			return 0, false
+		}
+		seconds = seconds*60 + number
+	}
+	return seconds, true
+}
+
+// parseSize reads a size like 1024kB or 12MiB
+// 1024kB veya 12MiB gibi bir boyutu okur
+func parseSize(value string) (int64, bool) {
+	i := strings.IndexFunc(value, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.'
+	})
+	if i <= 0 {
+		return 0, false
+	}
+	number, err := strconv.ParseFloat(value[:i], 64)
+	if err != nil {
+		return 0, false
+	}
+	unit, ok := sizeUnits[strings.ToLower(value[i:])]
+	if !ok {
+		return 0, false
+	}
+	return int64(number * float64(unit)), true
+}
+
+// Percent converts encoded frames to a percentage capped at 100
+// Kodlanan kareleri 100 ile sınırlı bir yüzdeye dönüştürür
+func Percent(frame, totalFrames int) float64 {
+	if totalFrames <= 0 {
+		return 0
+	}
+	percent := float64(frame) / float64(totalFrames) * 100
+	if percent > 100 {
+		percent = 100
+	}
+	return percent
+}
+
+// TimePercent converts the output position to a percentage of the duration capped at 100
+// Çıktı konumunu süreye göre 100 ile sınırlı bir yüzdeye dönüştürür
+func TimePercent(seconds, totalSeconds float64) float64 {
+	if totalSeconds <= 0 {
+		return 0
+	}
+	return math.Min(seconds/totalSeconds*100, 100)
+}
+
+// Target struct
+// Represents what a running FFmpeg process is expected to produce, zero fields are unknown
+// Çalışan bir FFmpeg işleminin üretmesi beklenen şeyi temsil eder, sıfır alanlar bilinmiyor demektir
+type Target struct {
+	Frames      int     // Frames of the video encode, 0 when no video is encoded / Video kodlamasının kareleri, video kodlanmıyorsa 0
+	FrameOffset int     // Frames done by earlier passes / Önceki geçişlerin yaptığı kareler
+	Seconds     float64 // Duration of the output / Çıktının süresi
+	Bytes       int64   // Expected output size, for stream copies / Akış kopyaları için beklenen çıktı boyutu
+}
+
+// Percent turns a status into a percentage on the best basis the target allows
+// Frames count while video is encoded, audio and remux jobs fall back to the output time, then the size
+// Video kodlanırken kareler sayılır, ses ve yeniden paketleme işleri çıktı zamanına, sonra boyuta geri düşer
+func (t Target) Percent(status Status) (float64, bool) {
+	switch {
+	case t.Frames > 0 && status.HasFrame:
+		return Percent(t.FrameOffset+status.Frame, t.Frames), true
+	case t.Seconds > 0 && status.HasTime:
+		return TimePercent(status.Time, t.Seconds), true
+	case t.Bytes > 0 && status.Size > 0:
+		return math.Min(float64(status.Size)/float64(t.Bytes)*100, 100), true
+	}
+	return 0, false
+}
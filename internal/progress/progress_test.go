@@ -0,0 +1,148 @@
+package progress
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestParseLine checks the status lines of different FFmpeg builds and their odd values
+// Farklı FFmpeg derlemelerinin durum satırlarını ve tuhaf değerlerini kontrol eder
+func TestParseLine(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		want Status
+		ok   bool
+	}{
+		{
+			name: "full line",
+			line: "frame=  240 fps= 48 q=30.0 size=    1024kB time=00:00:10.00 bitrate= 838.9kbits/s speed=2.01x",
+			want: Status{Frame: 240, HasFrame: true, Time: 10, HasTime: true, Size: 1024 * 1024, HasSize: true, Speed: "2.01x"},
+			ok:   true,
+		},
+		{
+			name: "trailing carriage return",
+			line: "frame=12 time=00:00:00.50 speed=1x\r",
+			want: Status{Frame: 12, HasFrame: true, Time: 0.5, HasTime: true, Speed: "1x"},
+			ok:   true,
+		},
+		{
+			name: "N/A values",
+			line: "frame=N/A fps=N/A q=-1.0 size=N/A time=00:01:00.00 bitrate=N/A speed=N/A",
+			want: Status{Time: 60, HasTime: true},
+			ok:   true,
+		},
+		{
+			name: "KiB size",
+			line: "size=      12KiB time=00:00:01.00",
+			want: Status{Time: 1, HasTime: true, Size: 12 * 1024, HasSize: true},
+			ok:   true,
+		},
+		{
+			name: "MB size",
+			line: "frame=1 Lsize=    1.5MB",
+			want: Status{Frame: 1, HasFrame: true, Size: 3 * 512 * 1024, HasSize: true},
+			ok:   true,
+		},
+		{
+			name: "size only",
+			line: "size=    2048kB time=N/A bitrate=N/A speed=N/A",
+			want: Status{Size: 2048 * 1024, HasSize: true},
+			ok:   true,
+		},
+		{
+			name: "negative time",
+			line: "frame=0 time=-00:00:00.04 speed=N/A",
+			want: Status{HasFrame: true, HasTime: true},
+			ok:   true,
+		},
+		{
+			name: "missing fields",
+			line: "fps=25 q=28.0 bitrate=1000kbits/s",
+			ok:   false,
+		},
+		{
+			name: "unknown size unit",
+			line: "size=12parsecs",
+			ok:   false,
+		},
+		{
+			name: "other output",
+			line: "Stream #0:0: Video: h264 (High), yuv420p, 1920x1080",
+			ok:   false,
+		},
+		{
+			name: "empty line",
+			line: "",
+			ok:   false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, ok := ParseLine(test.line)
+			if ok != test.ok {
+				t.Fatalf("ParseLine(%q) ok = %t, want %t", test.line, ok, test.ok)
+			}
+			if ok && got != test.want {
+				t.Errorf("ParseLine(%q) = %+v, want %+v", test.line, got, test.want)
+			}
+		})
+	}
+}
+
+// TestParseLineCarriageReturns parses a stderr chunk the way FFmpeg writes it, updates separated by CR
+// FFmpeg'in yazdığı gibi CR ile ayrılmış güncellemeler içeren bir stderr parçasını ayrıştırır
+func TestParseLineCarriageReturns(t *testing.T) {
+	chunk := "Press [q] to stop\nframe=1 time=00:00:00.04\rframe=2 time=00:00:00.08\rframe=3 time=00:00:00.12\r"
+	var frames []int
+	for _, line := range strings.FieldsFunc(chunk, func(r rune) bool { return r == '\r' || r == '\n' }) {
+		if status, ok := ParseLine(line); ok {
+			frames = append(frames, status.Frame)
+		}
+	}
+	if len(frames) != 3 || frames[0] != 1 || frames[2] != 3 {
+		t.Errorf("frames = %v, want [1 2 3]", frames)
+	}
+}
+
+// TestTargetPercent checks that the target falls back from frames to time to size
+// Hedefin karelerden zamana, sonra boyuta geri düştüğünü kontrol eder
+func TestTargetPercent(t *testing.T) {
+	tests := []struct {
+		name   string
+		target Target
+		status Status
+		want   float64
+		ok     bool
+	}{
+		{"frames", Target{Frames: 200}, Status{Frame: 50, HasFrame: true}, 25, true},
+		{"frame offset", Target{Frames: 200, FrameOffset: 100}, Status{Frame: 50, HasFrame: true}, 75, true},
+		{"frames capped", Target{Frames: 100}, Status{Frame: 150, HasFrame: true}, 100, true},
+		{"time without frames", Target{Frames: 200, Seconds: 40}, Status{Time: 10, HasTime: true}, 25, true},
+		{"time", Target{Seconds: 40}, Status{Time: 30, HasTime: true}, 75, true},
+		{"size", Target{Bytes: 1000}, Status{Size: 500, HasSize: true}, 50, true},
+		{"size from a size-only line", Target{Bytes: 4096 * 1024}, mustParse(t, "size=    2048kB time=N/A"), 50, true},
+		{"size capped", Target{Bytes: 1000}, Status{Size: 5000, HasSize: true}, 100, true},
+		{"unknown target", Target{}, Status{Frame: 10, HasFrame: true, Time: 1, HasTime: true}, 0, false},
+		{"nothing usable", Target{Frames: 100}, Status{Time: 1, HasTime: true}, 0, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, ok := test.target.Percent(test.status)
+			if ok != test.ok || got != test.want {
+				t.Errorf("Percent(%+v) = %v, %t, want %v, %t", test.status, got, ok, test.want, test.ok)
+			}
+		})
+	}
+}
+
+// mustParse parses a status line that must be accepted
+// Kabul edilmesi gereken bir durum satırını ayrıştırır
+func mustParse(t *testing.T, line string) Status {
+	t.Helper()
+	status, ok := ParseLine(line)
+	if !ok {
+		t.Fatalf("ParseLine(%q) rejected", line)
+	}
+	return status
+}
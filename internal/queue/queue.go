@@ -0,0 +1,123 @@
+// Package queue keeps conversion jobs by identifier in the order they were added
+// Dönüştürme işlerini eklenme sırasına göre tanımlayıcıyla tutar
+package queue
+
+import (
+	"errors"
+	"sort"
+	"sync"
+)
+
+// ErrNotFound is returned for an unknown identifier
+// Bilinmeyen bir tanımlayıcı için döndürülür
+var ErrNotFound = errors.New("not found")
+
+// Registry struct
+// Keeps items by identifier, safe for concurrent use
+// Öğeleri tanımlayıcıya göre tutar, eşzamanlı kullanım için güvenlidir
+type Registry[T any] struct {
+	mu    sync.Mutex
+	items map[string]*T
+	order []string
+}
+
+// New creates an empty registry
+// Boş bir kayıt oluşturur
+func New[T any]() *Registry[T] {
+	return &Registry[T]{items: make(map[string]*T)}
+}
+
+// Add stores an item under an identifier
+// Bir öğeyi bir tanımlayıcı altında saklar
+func (r *Registry[T]) Add(id string, item T) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.items[id]; !ok {
+		r.order = append(r.order, id)
+	}
+	r.items[id] = &item
+}
+
+// Get returns a copy of an item
+// Bir öğenin kopyasını döndürür
+func (r *Registry[T]) Get(id string) (T, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	item, ok := r.items[id]
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	return *item, true
+}
+
+// Update changes an item under the registry lock
+// Returns false if the identifier is unknown
+// Tanımlayıcı bilinmiyorsa false döndürür
+func (r *Registry[T]) Update(id string, update func(item *T)) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	item, ok := r.items[id]
+	if ok {
+		update(item)
+	}
+	return ok
+}
+
+// List returns copies of all items in the order they were added
+// Tüm öğelerin kopyalarını eklenme sırasına göre döndürür
+func (r *Registry[T]) List() []T {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	items := make([]T, 0, len(r.order))
+	for _, id := range r.order {
+		items = append(items, *r.items[id])
+	}
+	return items
+}
+
+// Remove deletes an item unless check returns an error
+// check bir hata döndürmedikçe bir öğeyi siler
+func (r *Registry[T]) Remove(id string, check func(item *T) error) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	item, ok := r.items[id]
+	if !ok {
+		return ErrNotFound
+	}
+	if check != nil {
+		if err := check(item); err != nil {
+			return err
+		}
+	}
+	delete(r.items, id)
+	for i, orderID := range r.order {
+		if orderID == id {
+			r.order = append(r.order[:i], r.order[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+// Sort reorders the items selected by include among their own positions
+// Other items keep their place, equal items keep their order
+// Diğer öğeler yerlerini korur, eşit öğeler sıralarını korur
+func (r *Registry[T]) Sort(include func(item *T) bool, less func(a, b *T) bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var positions []int
+	var ids []string
+	for i, id := range r.order {
+		if include(r.items[id]) {
+			positions = append(positions, i)
+			ids = append(ids, id)
+		}
+	}
+	sort.SliceStable(ids, func(i, j int) bool {
+		return less(r.items[ids[i]], r.items[ids[j]])
+	})
+	for i, position := range positions {
+		r.order[position] = ids[i]
+	}
+}
@@ -0,0 +1,150 @@
+package queue
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// item is the value kept in the test registries
+// Test kayıtlarında tutulan değerdir
+type item struct {
+	Name     string
+	Priority int
+	Running  bool
+}
+
+// names returns the names of the listed items in order
+// Listelenen öğelerin adlarını sırayla döndürür
+func names(r *Registry[item]) []string {
+	var list []string
+	for _, it := range r.List() {
+		list = append(list, it.Name)
+	}
+	return list
+}
+
+// TestAddGet keeps the insertion order and replaces an item added twice in place
+// Eklenme sırasını korur ve iki kez eklenen bir öğeyi yerinde değiştirir
+func TestAddGet(t *testing.T) {
+	r := New[item]()
+	r.Add("a", item{Name: "a"})
+	r.Add("b", item{Name: "b"})
+	r.Add("c", item{Name: "c"})
+	r.Add("a", item{Name: "a", Priority: 5})
+
+	if got, want := names(r), []string{"a", "b", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("List = %v, want %v", got, want)
+	}
+	if it, ok := r.Get("a"); !ok || it.Priority != 5 {
+		t.Errorf("Get(a) = %+v, %t, want the replaced item", it, ok)
+	}
+	if _, ok := r.Get("missing"); ok {
+		t.Error("Get(missing) found an item")
+	}
+}
+
+// TestGetReturnsCopy makes sure callers can't change stored items without Update
+// Çağıranların Update olmadan saklanan öğeleri değiştiremediğinden emin olur
+func TestGetReturnsCopy(t *testing.T) {
+	r := New[item]()
+	r.Add("a", item{Name: "a"})
+	it, _ := r.Get("a")
+	it.Running = true
+	r.List()[0].Running = true
+	if stored, _ := r.Get("a"); stored.Running {
+		t.Error("changing a copy changed the stored item")
+	}
+}
+
+// TestUpdate changes known items and reports unknown ones
+// Bilinen öğeleri değiştirir ve bilinmeyenleri bildirir
+func TestUpdate(t *testing.T) {
+	r := New[item]()
+	r.Add("a", item{Name: "a"})
+	if !r.Update("a", func(it *item) { it.Running = true }) {
+		t.Fatal("Update(a) = false")
+	}
+	if it, _ := r.Get("a"); !it.Running {
+		t.Error("Update(a) was not stored")
+	}
+	called := false
+	if r.Update("missing", func(*item) { called = true }) || called {
+		t.Error("Update(missing) reported success or ran the function")
+	}
+}
+
+// TestRemove deletes items, keeps the order of the rest and honours the check
+// Öğeleri siler, kalanların sırasını korur ve kontrole uyar
+func TestRemove(t *testing.T) {
+	r := New[item]()
+	for _, name := range []string{"a", "b", "c", "d"} {
+		r.Add(name, item{Name: name, Running: name == "c"})
+	}
+	if err := r.Remove("b", nil); err != nil {
+		t.Fatalf("Remove(b): %v", err)
+	}
+	errRunning := errors.New("running")
+	check := func(it *item) error {
+		if it.Running {
+			return errRunning
+		}
+		return nil
+	}
+	if err := r.Remove("c", check); err != errRunning {
+		t.Errorf("Remove(c) = %v, want the check error", err)
+	}
+	if err := r.Remove("missing", nil); err != ErrNotFound {
+		t.Errorf("Remove(missing) = %v, want ErrNotFound", err)
+	}
+	if got, want := names(r), []string{"a", "c", "d"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("List = %v, want %v", got, want)
+	}
+
+	// A removed identifier added again goes to the end
+	// Silinip yeniden eklenen bir tanımlayıcı sona gider
+	r.Remove("a", nil)
+	r.Add("a", item{Name: "a"})
+	if got, want := names(r), []string{"c", "d", "a"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("List after re-adding = %v, want %v", got, want)
+	}
+}
+
+// TestSort reorders only the included items, stably, and leaves the others in place
+// Yalnızca dahil edilen öğeleri kararlı şekilde sıralar ve diğerlerini yerinde bırakır
+func TestSort(t *testing.T) {
+	r := New[item]()
+	r.Add("a", item{Name: "a", Priority: 1})
+	r.Add("b", item{Name: "b", Priority: 9, Running: true})
+	r.Add("c", item{Name: "c", Priority: 3})
+	r.Add("d", item{Name: "d", Priority: 1})
+	r.Add("e", item{Name: "e", Priority: 2})
+
+	r.Sort(func(it *item) bool { return !it.Running }, func(a, b *item) bool { return a.Priority > b.Priority })
+	if got, want := names(r), []string{"c", "b", "e", "a", "d"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("List = %v, want %v", got, want)
+	}
+}
+
+// TestConcurrentUse runs adds, updates and lists at once, meant for the race detector
+// Eklemeleri, güncellemeleri ve listelemeleri aynı anda çalıştırır, yarış algılayıcısı içindir
+func TestConcurrentUse(t *testing.T) {
+	r := New[item]()
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			id := fmt.Sprint(i)
+			r.Add(id, item{Name: id})
+			r.Update(id, func(it *item) { it.Priority++ })
+			r.List()
+		}(i)
+	}
+	wg.Wait()
+	if got := len(r.List()); got != 8 {
+		t.Errorf("got %d items, want 8", got)
+	}
+}
@@ -0,0 +1,78 @@
+package runner
+
+import (
+	"bytes"
+	"log"
+	"os/exec"
+	"sync"
+)
+
+// children tracks the running child processes so they can be killed on exit
+// Çıkışta sonlandırılabilmeleri için çalışan alt işlemleri izler
+var children = struct {
+	sync.Mutex
+	cmds map[*exec.Cmd]struct{}
+}{cmds: make(map[*exec.Cmd]struct{})}
+
+// Start starts a command bound to the lifetime of this process
+// The child gets its own process group and dies with the parent, see prepare and adopt
+// Alt işlem kendi işlem grubunu alır ve üst işlemle birlikte ölür, bkz. prepare ve adopt
+func Start(cmd *exec.Cmd) error {
+	prepare(cmd)
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	if err := adopt(cmd); err != nil {
+		log.Printf("Error binding %s to the application: %v", cmd.Path, err)
+	}
+
+	children.Lock()
+	children.cmds[cmd] = struct{}{}
+	children.Unlock()
+	return nil
+}
+
+// Wait waits for a command started with Start and stops tracking it
+// Start ile başlatılan bir komutu bekler ve izlemeyi bırakır
+func Wait(cmd *exec.Cmd) error {
+	err := cmd.Wait()
+	children.Lock()
+	delete(children.cmds, cmd)
+	children.Unlock()
+	return err
+}
+
+// Run starts a command with Start and waits for it
+// Bir komutu Start ile başlatır ve bekler
+func Run(cmd *exec.Cmd) error {
+	if err := Start(cmd); err != nil {
+		return err
+	}
+	return Wait(cmd)
+}
+
+// CombinedOutput runs a command with Run and returns its stdout and stderr
+// Bir komutu Run ile çalıştırır ve standart çıktı ile hatasını döndürür
+func CombinedOutput(cmd *exec.Cmd) ([]byte, error) {
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+	err := Run(cmd)
+	return out.Bytes(), err
+}
+
+// KillAll kills every tracked child together with its process group
+// Called on shutdown and before a crash takes the application down
+// Kapanışta ve bir çökme uygulamayı çökertmeden önce çağrılır
+func KillAll() {
+	children.Lock()
+	defer children.Unlock()
+	for cmd := range children.cmds {
+		if cmd.Process != nil && cmd.ProcessState == nil {
+			if err := killTree(cmd); err != nil {
+				log.Printf("Error killing %s: %v", cmd.Path, err)
+			}
+		}
+		delete(children.cmds, cmd)
+	}
+}
@@ -0,0 +1,68 @@
+//go:build linux
+
+package runner
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// I/O priority values of ioprio_set, see linux/ioprio.h
+// ioprio_set için G/Ç öncelik değerleri, bkz. linux/ioprio.h
+const (
+	ioprioWhoProcessGroup = 2
+	ioprioClassShift      = 13
+	ioprioClassBestEffort = 2
+	ioprioClassIdle       = 3
+)
+
+// prepare puts the child in its own process group and asks the kernel to kill it with the parent
+// The death signal fires when the starting thread exits, Go only retires threads of locked goroutines
+// Ölüm sinyali başlatan iş parçacığı çıkınca tetiklenir, Go yalnızca kilitli goroutine'lerin iş parçacıklarını kapatır
+func prepare(cmd *exec.Cmd) {
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Setpgid = true
+	cmd.SysProcAttr.Pdeathsig = syscall.SIGKILL
+}
+
+// adopt has nothing to do on Linux, the death signal is set before the start
+// Linux'ta yapacak bir şeyi yoktur, ölüm sinyali başlatmadan önce ayarlanır
+func adopt(cmd *exec.Cmd) error {
+	return nil
+}
+
+// killTree kills the process group of the child
+// Alt işlemin işlem grubunu sonlandırır
+func killTree(cmd *exec.Cmd) error {
+	return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+}
+
+// suspendTree stops the process group of the child
+// Alt işlemin işlem grubunu durdurur
+func suspendTree(cmd *exec.Cmd) error {
+	return syscall.Kill(-cmd.Process.Pid, syscall.SIGSTOP)
+}
+
+// resumeTree continues the stopped process group of the child
+// Alt işlemin durdurulmuş işlem grubunu devam ettirir
+func resumeTree(cmd *exec.Cmd) error {
+	return syscall.Kill(-cmd.Process.Pid, syscall.SIGCONT)
+}
+
+// lower renices the process group of the child and lowers its disk priority
+// Alt işlemin işlem grubunun önceliğini ve disk önceliğini düşürür
+func lower(cmd *exec.Cmd, priority Priority) error {
+	nice, ioprio := 10, ioprioClassBestEffort<<ioprioClassShift|7
+	if priority == PriorityIdle {
+		nice, ioprio = 19, ioprioClassIdle<<ioprioClassShift
+	}
+	if err := syscall.Setpriority(syscall.PRIO_PGRP, cmd.Process.Pid, nice); err != nil {
+		return err
+	}
+	if _, _, errno := syscall.Syscall(syscall.SYS_IOPRIO_SET, ioprioWhoProcessGroup, uintptr(cmd.Process.Pid), uintptr(ioprio)); errno != 0 {
+		return errno
+	}
+	return nil
+}
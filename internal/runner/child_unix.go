@@ -0,0 +1,52 @@
+//go:build !linux && !windows
+
+package runner
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// prepare puts the child in its own process group
+// macOS has no parent death signal, KillAll kills the group on shutdown and crash instead
+// macOS'ta üst işlem ölüm sinyali yoktur, bunun yerine KillAll grubu kapanışta ve çökmede sonlandırır
+func prepare(cmd *exec.Cmd) {
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Setpgid = true
+}
+
+// adopt has nothing to do here, the process group is set before the start
+// Burada yapacak bir şeyi yoktur, işlem grubu başlatmadan önce ayarlanır
+func adopt(cmd *exec.Cmd) error {
+	return nil
+}
+
+// killTree kills the process group of the child
+// Alt işlemin işlem grubunu sonlandırır
+func killTree(cmd *exec.Cmd) error {
+	return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+}
+
+// suspendTree stops the process group of the child
+// Alt işlemin işlem grubunu durdurur
+func suspendTree(cmd *exec.Cmd) error {
+	return syscall.Kill(-cmd.Process.Pid, syscall.SIGSTOP)
+}
+
+// resumeTree continues the stopped process group of the child
+// Alt işlemin durdurulmuş işlem grubunu devam ettirir
+func resumeTree(cmd *exec.Cmd) error {
+	return syscall.Kill(-cmd.Process.Pid, syscall.SIGCONT)
+}
+
+// lower renices the process group of the child, disk priority follows the CPU priority here
+// Alt işlemin işlem grubunun önceliğini düşürür, burada disk önceliği CPU önceliğini izler
+func lower(cmd *exec.Cmd, priority Priority) error {
+	nice := 10
+	if priority == PriorityIdle {
+		nice = 19
+	}
+	return syscall.Setpriority(syscall.PRIO_PGRP, cmd.Process.Pid, nice)
+}
@@ -0,0 +1,153 @@
+//go:build windows
+
+package runner
+
+import (
+	"fmt"
+	"os/exec"
+	"sync"
+	"syscall"
+	"unsafe"
+)
+
+// Windows API constants used for the kill-on-close Job Object
+// Kapanınca sonlandıran Job Object için kullanılan Windows API sabitleri
+const (
+	jobObjectExtendedLimitInformation = 9
+	jobObjectLimitKillOnJobClose      = 0x2000
+	processSetQuota                   = 0x0100
+	processTerminate                  = 0x0001
+	processSetInformation             = 0x0200
+	processSuspendResume              = 0x0800
+	belowNormalPriorityClass          = 0x4000
+	idlePriorityClass                 = 0x0040
+)
+
+var (
+	kernel32                     = syscall.NewLazyDLL("kernel32.dll")
+	procCreateJobObject          = kernel32.NewProc("CreateJobObjectW")
+	procSetInformationJobObject  = kernel32.NewProc("SetInformationJobObject")
+	procAssignProcessToJobObject = kernel32.NewProc("AssignProcessToJobObject")
+	procSetPriorityClass         = kernel32.NewProc("SetPriorityClass")
+	ntdll                        = syscall.NewLazyDLL("ntdll.dll")
+	procNtSuspendProcess         = ntdll.NewProc("NtSuspendProcess")
+	procNtResumeProcess          = ntdll.NewProc("NtResumeProcess")
+)
+
+// jobObjectExtendedLimit mirrors JOBOBJECT_EXTENDED_LIMIT_INFORMATION of the Windows API
+// Windows API'sinin JOBOBJECT_EXTENDED_LIMIT_INFORMATION yapısını yansıtır
+type jobObjectExtendedLimit struct {
+	PerProcessUserTimeLimit int64
+	PerJobUserTimeLimit     int64
+	LimitFlags              uint32
+	MinimumWorkingSetSize   uintptr
+	MaximumWorkingSetSize   uintptr
+	ActiveProcessLimit      uint32
+	Affinity                uintptr
+	PriorityClass           uint32
+	SchedulingClass         uint32
+	IoCounters              [6]uint64
+	ProcessMemoryLimit      uintptr
+	JobMemoryLimit          uintptr
+	PeakProcessMemoryUsed   uintptr
+	PeakJobMemoryUsed       uintptr
+}
+
+// job is the Job Object every child joins, its handle closes when this process dies
+// Her alt işlemin katıldığı Job Object, tanıtıcısı bu işlem ölünce kapanır
+var job struct {
+	once   sync.Once
+	handle syscall.Handle
+	err    error
+}
+
+// prepare has nothing to set before the start on Windows
+// Windows'ta başlatmadan önce ayarlanacak bir şey yoktur
+func prepare(cmd *exec.Cmd) {}
+
+// adopt assigns the child to the kill-on-close Job Object
+// Windows closes the handle when the application exits or crashes, which kills the child
+// Uygulama çıktığında veya çöktüğünde Windows tanıtıcıyı kapatır, bu da alt işlemi sonlandırır
+func adopt(cmd *exec.Cmd) error {
+	job.once.Do(func() { job.handle, job.err = createKillOnCloseJob() })
+	if job.err != nil {
+		return job.err
+	}
+
+	process, err := syscall.OpenProcess(processSetQuota|processTerminate, false, uint32(cmd.Process.Pid))
+	if err != nil {
+		return err
+	}
+	defer syscall.CloseHandle(process)
+	if result, _, err := procAssignProcessToJobObject.Call(uintptr(job.handle), uintptr(process)); result == 0 {
+		return err
+	}
+	return nil
+}
+
+// killTree kills the child, its own children die with the Job Object
+// Alt işlemi sonlandırır, onun alt işlemleri Job Object ile birlikte ölür
+func killTree(cmd *exec.Cmd) error {
+	return cmd.Process.Kill()
+}
+
+// suspendTree suspends every thread of the child
+// Alt işlemin her iş parçacığını askıya alır
+func suspendTree(cmd *exec.Cmd) error {
+	return callOnProcess(cmd, procNtSuspendProcess)
+}
+
+// resumeTree resumes the threads of a suspended child
+// Askıya alınmış bir alt işlemin iş parçacıklarını devam ettirir
+func resumeTree(cmd *exec.Cmd) error {
+	return callOnProcess(cmd, procNtResumeProcess)
+}
+
+// callOnProcess calls an NTSTATUS function of ntdll with a suspend and resume handle of the child
+// Alt işlemin askıya alma ve devam ettirme tanıtıcısıyla ntdll'in bir NTSTATUS fonksiyonunu çağırır
+func callOnProcess(cmd *exec.Cmd, proc *syscall.LazyProc) error {
+	process, err := syscall.OpenProcess(processSuspendResume, false, uint32(cmd.Process.Pid))
+	if err != nil {
+		return err
+	}
+	defer syscall.CloseHandle(process)
+	if status, _, _ := proc.Call(uintptr(process)); status != 0 {
+		return fmt.Errorf("%s failed with status 0x%x", proc.Name, status)
+	}
+	return nil
+}
+
+// createKillOnCloseJob creates a Job Object that kills its processes once closed
+// Kapatıldığında işlemlerini sonlandıran bir Job Object oluşturur
+func createKillOnCloseJob() (syscall.Handle, error) {
+	handle, _, err := procCreateJobObject.Call(0, 0)
+	if handle == 0 {
+		return 0, err
+	}
+	info := jobObjectExtendedLimit{LimitFlags: jobObjectLimitKillOnJobClose}
+	result, _, err := procSetInformationJobObject.Call(handle, jobObjectExtendedLimitInformation,
+		uintptr(unsafe.Pointer(&info)), unsafe.Sizeof(info))
+	if result == 0 {
+		syscall.CloseHandle(syscall.Handle(handle))
+		return 0, err
+	}
+	return syscall.Handle(handle), nil
+}
+
+// lower sets the priority class of the child, only a process can put its own disk access in background mode
+// Alt işlemin öncelik sınıfını ayarlar, disk erişimini arka plan moduna yalnızca işlemin kendisi alabilir
+func lower(cmd *exec.Cmd, priority Priority) error {
+	class := uintptr(belowNormalPriorityClass)
+	if priority == PriorityIdle {
+		class = idlePriorityClass
+	}
+	process, err := syscall.OpenProcess(processSetInformation, false, uint32(cmd.Process.Pid))
+	if err != nil {
+		return err
+	}
+	defer syscall.CloseHandle(process)
+	if result, _, err := procSetPriorityClass.Call(uintptr(process), class); result == 0 {
+		return err
+	}
+	return nil
+}
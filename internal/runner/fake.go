@@ -0,0 +1,151 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"AV1-video-converter/internal/progress"
+)
+
+// ErrKilled is returned by Fake.Wait after Kill
+// Kill'den sonra Fake.Wait tarafından döndürülür
+var ErrKilled = errors.New("killed")
+
+// Fake struct
+// Plays back scripted progress instead of encoding, for tests
+// Test için kodlamak yerine önceden yazılmış ilerlemeyi oynatır
+type Fake struct {
+	Statuses []progress.Status // Updates sent in order / Sırayla gönderilen güncellemeler
+	Interval time.Duration     // Time between updates / Güncellemeler arasındaki süre
+	StartErr error             // Returned by Start / Start tarafından döndürülür
+	ExitErr  error             // Returned by Wait after the last update / Son güncellemeden sonra Wait tarafından döndürülür
+	Args     []string          // Arguments Start was called with / Start'ın çağrıldığı argümanlar
+	CPU      time.Duration     // Reported by CPUTime / CPUTime tarafından bildirilir
+
+	progress  chan progress.Status
+	done      chan struct{}
+	kill      chan struct{}
+	killOnce  sync.Once
+	err       error
+	mu        sync.Mutex
+	suspended chan struct{} // Closed by Resume, nil while playing / Resume tarafından kapatılır, oynarken nil
+}
+
+// Start records the arguments and begins playing back the updates
+// Argümanları kaydeder ve güncellemeleri oynatmaya başlar
+func (f *Fake) Start(ctx context.Context, args []string) error {
+	f.Args = args
+	if f.StartErr != nil {
+		return f.StartErr
+	}
+	f.progress = make(chan progress.Status)
+	f.done = make(chan struct{})
+	f.kill = make(chan struct{})
+
+	go func() {
+		defer close(f.done)
+		defer close(f.progress)
+		for _, status := range f.Statuses {
+			select {
+			case <-time.After(f.Interval):
+			case <-ctx.Done():
+				f.err = ctx.Err()
+				return
+			case <-f.kill:
+				f.err = ErrKilled
+				return
+			}
+			if err := f.waitResumed(ctx); err != nil {
+				f.err = err
+				return
+			}
+			select {
+			case f.progress <- status:
+			case <-ctx.Done():
+				f.err = ctx.Err()
+				return
+			case <-f.kill:
+				f.err = ErrKilled
+				return
+			}
+		}
+		f.err = f.ExitErr
+	}()
+	return nil
+}
+
+// Wait returns once playback ended, was killed or cancelled
+// Oynatma bittiğinde, sonlandırıldığında veya iptal edildiğinde döner
+func (f *Fake) Wait() error {
+	if f.done == nil {
+		return errors.New("fake not started")
+	}
+	<-f.done
+	return f.err
+}
+
+// Kill stops the playback
+// Oynatmayı durdurur
+func (f *Fake) Kill() error {
+	if f.kill == nil {
+		return errors.New("fake not started")
+	}
+	f.killOnce.Do(func() { close(f.kill) })
+	return nil
+}
+
+// Suspend holds the playback until Resume
+// Oynatmayı Resume çağrılana kadar bekletir
+func (f *Fake) Suspend() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.suspended == nil {
+		f.suspended = make(chan struct{})
+	}
+	return nil
+}
+
+// Resume goes on with a held playback
+// Bekletilen oynatmaya devam eder
+func (f *Fake) Resume() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.suspended != nil {
+		close(f.suspended)
+		f.suspended = nil
+	}
+	return nil
+}
+
+// waitResumed blocks while the playback is suspended
+// Oynatma askıdayken bekler
+func (f *Fake) waitResumed(ctx context.Context) error {
+	f.mu.Lock()
+	suspended := f.suspended
+	f.mu.Unlock()
+	if suspended == nil {
+		return nil
+	}
+	select {
+	case <-suspended:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-f.kill:
+		return ErrKilled
+	}
+}
+
+// ProgressStream returns the played back updates
+// Oynatılan güncellemeleri döndürür
+func (f *Fake) ProgressStream() <-chan progress.Status {
+	return f.progress
+}
+
+// CPUTime returns the scripted CPU time
+// Önceden yazılmış CPU süresini döndürür
+func (f *Fake) CPUTime() time.Duration {
+	return f.CPU
+}
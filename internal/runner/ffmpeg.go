@@ -0,0 +1,148 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os/exec"
+	"sync"
+	"time"
+
+	"AV1-video-converter/internal/progress"
+)
+
+// progressBuffer is the number of status updates kept for a slow reader
+// Yavaş bir okuyucu için tutulan durum güncellemesi sayısıdır
+const progressBuffer = 16
+
+// FFmpegRunner interface
+// Runs a single FFmpeg process and reports its progress
+// Tek bir FFmpeg işlemini çalıştırır ve ilerlemesini bildirir
+type FFmpegRunner interface {
+	Start(ctx context.Context, args []string) error
+	Wait() error
+	Kill() error
+	Suspend() error
+	Resume() error
+	ProgressStream() <-chan progress.Status
+	CPUTime() time.Duration
+}
+
+// FFmpeg struct
+// Runs FFmpeg as a real process, its output goes to Log
+// FFmpeg'i gerçek bir işlem olarak çalıştırır, çıktısı Log'a gider
+type FFmpeg struct {
+	Path      string        // FFmpeg binary / FFmpeg ikili dosyası
+	Log       io.Writer     // Receives stdout and stderr / Standart çıktı ve hatayı alır
+	WaitDelay time.Duration // Time to wait for the pipes of a killed process / Sonlandırılan bir işlemin borularını bekleme süresi
+
+	cmd      *exec.Cmd
+	progress chan progress.Status
+	once     sync.Once
+}
+
+// Start launches FFmpeg, it is killed when the context is cancelled
+// FFmpeg'i başlatır, bağlam iptal edildiğinde sonlandırılır
+func (f *FFmpeg) Start(ctx context.Context, args []string) error {
+	if f.cmd != nil {
+		return errors.New("FFmpeg already started")
+	}
+	f.progress = make(chan progress.Status, progressBuffer)
+	f.cmd = exec.CommandContext(ctx, f.Path, args...)
+	f.cmd.WaitDelay = f.WaitDelay
+	f.cmd.Stdout = f.Log
+	f.cmd.Stderr = io.MultiWriter(f.Log, &statusWriter{updates: f.progress})
+	if err := Start(f.cmd); err != nil {
+		f.closeProgress()
+		return err
+	}
+	return nil
+}
+
+// Wait waits for FFmpeg to exit and closes the progress stream
+// FFmpeg'in çıkmasını bekler ve ilerleme akışını kapatır
+func (f *FFmpeg) Wait() error {
+	if f.cmd == nil {
+		return errors.New("FFmpeg not started")
+	}
+	err := Wait(f.cmd)
+	f.closeProgress()
+	return err
+}
+
+// Kill stops FFmpeg immediately
+// FFmpeg'i hemen durdurur
+func (f *FFmpeg) Kill() error {
+	if f.cmd == nil || f.cmd.Process == nil {
+		return errors.New("FFmpeg not started")
+	}
+	return f.cmd.Process.Kill()
+}
+
+// Suspend stops FFmpeg without ending it, e.g. while the CPU cools down
+// FFmpeg'i sonlandırmadan durdurur, örn. CPU soğurken
+func (f *FFmpeg) Suspend() error {
+	if f.cmd == nil || f.cmd.Process == nil {
+		return errors.New("FFmpeg not started")
+	}
+	return suspendTree(f.cmd)
+}
+
+// Resume lets a suspended FFmpeg go on
+// Askıya alınmış bir FFmpeg'in devam etmesini sağlar
+func (f *FFmpeg) Resume() error {
+	if f.cmd == nil || f.cmd.Process == nil {
+		return errors.New("FFmpeg not started")
+	}
+	return resumeTree(f.cmd)
+}
+
+// ProgressStream returns the parsed status updates, closed once FFmpeg exits
+// Ayrıştırılmış durum güncellemelerini döndürür, FFmpeg çıkınca kapanır
+func (f *FFmpeg) ProgressStream() <-chan progress.Status {
+	return f.progress
+}
+
+// CPUTime returns the user and system CPU time of the exited process
+// Çıkmış işlemin kullanıcı ve sistem CPU süresini döndürür
+func (f *FFmpeg) CPUTime() time.Duration {
+	if f.cmd == nil || f.cmd.ProcessState == nil {
+		return 0
+	}
+	return f.cmd.ProcessState.UserTime() + f.cmd.ProcessState.SystemTime()
+}
+
+// closeProgress closes the progress stream once
+// İlerleme akışını bir kez kapatır
+func (f *FFmpeg) closeProgress() {
+	f.once.Do(func() { close(f.progress) })
+}
+
+// statusWriter struct
+// Splits FFmpeg stderr into status lines and parses them
+// FFmpeg stderr çıktısını durum satırlarına böler ve ayrıştırır
+type statusWriter struct {
+	updates chan<- progress.Status
+	line    []byte
+}
+
+// Write parses every complete line, status updates end with a carriage return
+// Her tam satırı ayrıştırır, durum güncellemeleri satır başı karakteriyle biter
+func (w *statusWriter) Write(p []byte) (int, error) {
+	for _, b := range p {
+		if b != '\r' && b != '\n' {
+			w.line = append(w.line, b)
+			continue
+		}
+		if status, ok := progress.ParseLine(string(w.line)); ok {
+			// Drop updates a slow reader can't keep up with, the next one supersedes them
+			// Yavaş bir okuyucunun yetişemediği güncellemeleri at, sonraki onların yerini alır
+			select {
+			case w.updates <- status:
+			default:
+			}
+		}
+		w.line = w.line[:0]
+	}
+	return len(p), nil
+}
@@ -0,0 +1,127 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"AV1-video-converter/internal/progress"
+)
+
+// TestStatusWriter splits stderr on carriage returns and newlines, also across writes
+// Stderr çıktısını satır başı ve yeni satır karakterlerinde böler, yazmalar arasında da
+func TestStatusWriter(t *testing.T) {
+	updates := make(chan progress.Status, 8)
+	w := &statusWriter{updates: updates}
+	chunks := []string{
+		"Input #0, matroska,webm, from 'in.mkv':\n",
+		"frame=   10 time=00:00:00.40 speed=1x\rframe=   2",
+		"0 time=00:00:00.80 speed=1x\r",
+		"[libsvtav1 @ 0x1] some warning\r\n",
+		"frame=   30 time=00:00:01.20 speed=1x\n",
+	}
+	for _, chunk := range chunks {
+		if n, err := w.Write([]byte(chunk)); n != len(chunk) || err != nil {
+			t.Fatalf("Write = %d, %v", n, err)
+		}
+	}
+	close(updates)
+
+	var frames []int
+	for status := range updates {
+		frames = append(frames, status.Frame)
+	}
+	if len(frames) != 3 || frames[0] != 10 || frames[1] != 20 || frames[2] != 30 {
+		t.Errorf("frames = %v, want [10 20 30]", frames)
+	}
+}
+
+// TestStatusWriterSlowReader drops updates instead of blocking FFmpeg's stderr
+// FFmpeg'in stderr çıktısını engellemek yerine güncellemeleri atar
+func TestStatusWriterSlowReader(t *testing.T) {
+	updates := make(chan progress.Status, 1)
+	w := &statusWriter{updates: updates}
+	done := make(chan struct{})
+	go func() {
+		w.Write([]byte("frame=1 time=00:00:01.00\rframe=2 time=00:00:02.00\rframe=3 time=00:00:03.00\r"))
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Write blocked on a full channel")
+	}
+	if status := <-updates; status.Frame != 1 {
+		t.Errorf("kept frame %d, want the first update", status.Frame)
+	}
+}
+
+// TestFakePlayback plays back every status and returns the exit error
+// Her durumu oynatır ve çıkış hatasını döndürür
+func TestFakePlayback(t *testing.T) {
+	exitErr := errors.New("exit status 1")
+	fake := &Fake{
+		Statuses: []progress.Status{{Frame: 1, HasFrame: true}, {Frame: 2, HasFrame: true}},
+		ExitErr:  exitErr,
+	}
+	if err := fake.Start(context.Background(), []string{"-i", "in.mkv"}); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	count := 0
+	for range fake.ProgressStream() {
+		count++
+	}
+	if count != 2 {
+		t.Errorf("got %d updates, want 2", count)
+	}
+	if err := fake.Wait(); err != exitErr {
+		t.Errorf("Wait = %v, want %v", err, exitErr)
+	}
+	if len(fake.Args) != 2 {
+		t.Errorf("Args = %v", fake.Args)
+	}
+}
+
+// TestFakeKillAndCancel ends the playback early with Kill or a cancelled context
+// Oynatmayı Kill veya iptal edilen bir bağlamla erken bitirir
+func TestFakeKillAndCancel(t *testing.T) {
+	statuses := []progress.Status{{Frame: 1, HasFrame: true}}
+
+	killed := &Fake{Statuses: statuses, Interval: time.Hour}
+	killed.Start(context.Background(), nil)
+	killed.Kill()
+	if err := killed.Wait(); err != ErrKilled {
+		t.Errorf("Wait after Kill = %v, want ErrKilled", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancelled := &Fake{Statuses: statuses, Interval: time.Hour}
+	cancelled.Start(ctx, nil)
+	cancel()
+	if err := cancelled.Wait(); err != context.Canceled {
+		t.Errorf("Wait after cancel = %v, want context.Canceled", err)
+	}
+}
+
+// TestFakeSuspend holds the playback between Suspend and Resume
+// Suspend ile Resume arasında oynatmayı bekletir
+func TestFakeSuspend(t *testing.T) {
+	fake := &Fake{Statuses: []progress.Status{{Frame: 1, HasFrame: true}}, Interval: time.Millisecond}
+	if err := fake.Start(context.Background(), nil); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	fake.Suspend()
+	select {
+	case <-fake.ProgressStream():
+		t.Fatal("an update arrived while suspended")
+	case <-time.After(50 * time.Millisecond):
+	}
+	fake.Resume()
+	if status := <-fake.ProgressStream(); status.Frame != 1 {
+		t.Errorf("got frame %d after Resume, want 1", status.Frame)
+	}
+	if err := fake.Wait(); err != nil {
+		t.Errorf("Wait = %v", err)
+	}
+}
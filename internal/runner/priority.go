@@ -0,0 +1,58 @@
+package runner
+
+import (
+	"context"
+	"log"
+	"os/exec"
+)
+
+// Priority is the scheduling priority of a child process
+// Bir alt işlemin zamanlama önceliğidir
+type Priority int
+
+const (
+	PriorityNormal Priority = iota // Inherited from the application / Uygulamadan devralınır
+	PriorityLow                    // Below normal CPU and disk priority / Normalin altında CPU ve disk önceliği
+	PriorityIdle                   // Only runs when nothing else wants the machine / Yalnızca makineyi başka bir şey istemediğinde çalışır
+)
+
+// priorityKey is the context key of WithPriority
+// WithPriority'nin bağlam anahtarıdır
+type priorityKey struct{}
+
+// WithPriority returns a context whose commands run by Exec get the given priority
+// Exec ile çalıştırılan komutlarına verilen önceliği atayan bir bağlam döndürür
+func WithPriority(ctx context.Context, priority Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, priority)
+}
+
+// PriorityOf returns the priority set on a context, PriorityNormal if none
+// Bir bağlama atanan önceliği döndürür, yoksa PriorityNormal
+func PriorityOf(ctx context.Context) Priority {
+	priority, _ := ctx.Value(priorityKey{}).(Priority)
+	return priority
+}
+
+// StartAt starts a command with Start and lowers its priority
+// A child that can't be lowered still runs, at the normal priority
+// Düşürülemeyen bir alt işlem yine de normal öncelikte çalışır
+func StartAt(cmd *exec.Cmd, priority Priority) error {
+	if err := Start(cmd); err != nil {
+		return err
+	}
+	if priority != PriorityNormal {
+		if err := lower(cmd, priority); err != nil {
+			log.Printf("Error lowering the priority of %s: %v", cmd.Path, err)
+		}
+	}
+	return nil
+}
+
+// RunAt starts a command with StartAt and waits for it
+// Bir komutu StartAt ile başlatır ve bekler
+func RunAt(cmd *exec.Cmd, priority Priority) error {
+	if err := StartAt(cmd, priority); err != nil {
+		return err
+	}
+	return Wait(cmd)
+}
@@ -0,0 +1,61 @@
+// Package runner starts external tools such as FFmpeg and FFprobe
+// FFmpeg ve FFprobe gibi harici araçları başlatır
+package runner
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"time"
+)
+
+// Runner interface
+// Runs an external command and returns its standard output
+// Harici bir komutu çalıştırır ve standart çıktısını döndürür
+type Runner interface {
+	Output(ctx context.Context, name string, args ...string) ([]byte, error)
+}
+
+// Exec struct
+// Runs commands as real processes
+// Komutları gerçek işlemler olarak çalıştırır
+type Exec struct {
+	WaitDelay time.Duration // Time to wait for the pipes of a killed process / Sonlandırılan bir işlemin borularını bekleme süresi
+}
+
+// Error struct
+// Represents a failed command with its standard error output
+// Standart hata çıktısıyla birlikte başarısız bir komutu temsil eder
+type Error struct {
+	Args   []string // Command line / Komut satırı
+	Err    error    // Exit error / Çıkış hatası
+	Stderr string   // Standard error output / Standart hata çıktısı
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%v: %v", e.Args, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Output runs the command until it exits or the context is cancelled
+// The command gets the priority set on the context with WithPriority
+// Komutu çıkana veya bağlam iptal edilene kadar çalıştırır, komut bağlama WithPriority ile atanan önceliği alır
+func (e Exec) Output(ctx context.Context, name string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, name, args...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	// Don't wait for pipes of a process stuck in uninterruptible I/O
+	// Kesintisiz G/Ç'de takılan bir işlemin borularını bekleme
+	cmd.WaitDelay = e.WaitDelay
+
+	if err := RunAt(cmd, PriorityOf(ctx)); err != nil {
+		return nil, &Error{Args: cmd.Args, Err: err, Stderr: stderr.String()}
+	}
+	return stdout.Bytes(), nil
+}
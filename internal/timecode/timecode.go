@@ -0,0 +1,148 @@
+// Package timecode converts between seconds and SMPTE timecodes
+// Saniyeler ile SMPTE zaman kodları arasında dönüştürür
+package timecode
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// dropFrameRates maps the NTSC rates that use drop-frame timecode to the frame numbers skipped each minute
+// Frames 00 and 01 (or 00-03 at 59.94) are skipped every minute except every tenth
+// 00 ve 01 numaralı kareler (59.94'te 00-03) onuncu dakikalar hariç her dakika atlanır
+var dropFrameRates = []struct {
+	rate    float64
+	nominal int
+	dropped int
+}{
+	{30000.0 / 1001, 30, 2},
+	{60000.0 / 1001, 60, 4},
+}
+
+// Timebase describes how frames are counted for a frame rate
+// Bir kare hızı için karelerin nasıl sayıldığını tanımlar
+type Timebase struct {
+	Rate      float64 // Actual frames per second / Gerçek saniyedeki kare sayısı
+	Nominal   int     // Frames counted per timecode second / Zaman kodu saniyesi başına sayılan kareler
+	Dropped   int     // Frame numbers skipped each minute, 0 for non-drop / Her dakika atlanan kare numaraları, non-drop için 0
+	DropFrame bool    // Drop-frame counting is used / Drop-frame sayımı kullanılır
+}
+
+// For returns the timebase of a frame rate, 29.97 and 59.94 count drop-frame
+// Unknown rates count whole seconds only
+// Bilinmeyen hızlar yalnızca tam saniyeleri sayar
+func For(rate float64) Timebase {
+	if rate <= 0 || math.IsNaN(rate) || math.IsInf(rate, 0) {
+		return Timebase{}
+	}
+	for _, drop := range dropFrameRates {
+		if math.Abs(rate-drop.rate) < 0.01 {
+			return Timebase{Rate: drop.rate, Nominal: drop.nominal, Dropped: drop.dropped, DropFrame: true}
+		}
+	}
+	return Timebase{Rate: rate, Nominal: int(math.Round(rate))}
+}
+
+// Format returns the timecode of a position, drop-frame timecodes separate the frames with a semicolon
+// Konumun zaman kodunu döndürür, drop-frame zaman kodları kareleri noktalı virgülle ayırır
+func Format(seconds, rate float64) string {
+	if seconds < 0 || math.IsNaN(seconds) || math.IsInf(seconds, 0) {
+		seconds = 0
+	}
+	tb := For(rate)
+	if tb.Nominal == 0 {
+		whole := int64(seconds)
+		return fmt.Sprintf("%02d:%02d:%02d:00", whole/3600, whole/60%60, whole%60)
+	}
+
+	// Small epsilon so exact frame boundaries don't round down a frame
+	// Tam kare sınırları bir kare aşağı yuvarlanmasın diye küçük bir pay
+	frame := int64(seconds*tb.Rate + 1e-6)
+	if tb.DropFrame {
+		frame = addDroppedFrames(frame, tb)
+	}
+
+	nominal := int64(tb.Nominal)
+	separator := ":"
+	if tb.DropFrame {
+		separator = ";"
+	}
+	totalSeconds := frame / nominal
+	return fmt.Sprintf("%02d:%02d:%02d%s%02d", totalSeconds/3600, totalSeconds/60%60, totalSeconds%60, separator, frame%nominal)
+}
+
+// Parse reads a timecode, a clock time or plain seconds into seconds
+// Accepts HH:MM:SS:FF, HH:MM:SS;FF, HH:MM:SS.sss, MM:SS and seconds
+// HH:MM:SS:FF, HH:MM:SS;FF, HH:MM:SS.sss, MM:SS ve saniyeleri kabul eder
+func Parse(value string, rate float64) (float64, error) {
+	value = strings.TrimSpace(value)
+	if seconds, err := strconv.ParseFloat(value, 64); err == nil {
+		if seconds < 0 {
+			return 0, fmt.Errorf("negative time: %s", value)
+		}
+		return seconds, nil
+	}
+
+	// A semicolon or a fourth field marks the frame count
+	// Noktalı virgül veya dördüncü alan kare sayısını işaretler
+	clock, frames, hasFrames := value, "", false
+	if i := strings.LastIndex(value, ";"); i >= 0 {
+		clock, frames, hasFrames = value[:i], value[i+1:], true
+	} else if parts := strings.Split(value, ":"); len(parts) == 4 {
+		clock, frames, hasFrames = strings.Join(parts[:3], ":"), parts[3], true
+	}
+
+	parts := strings.Split(clock, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, fmt.Errorf("invalid timecode: %s", value)
+	}
+	var seconds float64
+	for i, part := range parts {
+		number, err := strconv.ParseFloat(part, 64)
+		if err != nil || number < 0 || (i > 0 && number >= 60) {
+			return 0, fmt.Errorf("invalid timecode: %s", value)
+		}
+		seconds = seconds*60 + number
+	}
+	if !hasFrames {
+		return seconds, nil
+	}
+
+	tb := For(rate)
+	frame, err := strconv.Atoi(frames)
+	if err != nil || frame < 0 || (tb.Nominal > 0 && frame >= tb.Nominal) || seconds != math.Trunc(seconds) {
+		return 0, fmt.Errorf("invalid timecode: %s", value)
+	}
+	if tb.Nominal == 0 {
+		return seconds, nil
+	}
+
+	// Count the frames on the timecode clock, then remove the skipped frame numbers
+	// Zaman kodu saatindeki kareleri say, ardından atlanan kare numaralarını çıkar
+	count := int64(seconds)*int64(tb.Nominal) + int64(frame)
+	if tb.DropFrame {
+		minutes := int64(seconds) / 60
+		if int64(seconds)%60 == 0 && minutes%10 != 0 && frame < tb.Dropped {
+			return 0, fmt.Errorf("%s doesn't exist in drop-frame timecode", value)
+		}
+		count -= int64(tb.Dropped) * (minutes - minutes/10)
+	}
+	return float64(count) / tb.Rate, nil
+}
+
+// addDroppedFrames turns a frame count into the drop-frame timecode frame number
+// Bir kare sayısını drop-frame zaman kodu kare numarasına dönüştürür
+func addDroppedFrames(frame int64, tb Timebase) int64 {
+	dropped := int64(tb.Dropped)
+	perMinute := int64(tb.Nominal)*60 - dropped
+	perTenMinutes := perMinute*10 + dropped
+
+	tens, rest := frame/perTenMinutes, frame%perTenMinutes
+	frame += dropped * 9 * tens
+	if rest > dropped {
+		frame += dropped * ((rest - dropped) / perMinute)
+	}
+	return frame
+}
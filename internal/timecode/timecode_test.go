@@ -0,0 +1,113 @@
+package timecode
+
+import (
+	"math"
+	"testing"
+)
+
+// TestParse reads timecodes, clock times and seconds
+// Zaman kodlarını, saat zamanlarını ve saniyeleri okur
+func TestParse(t *testing.T) {
+	tests := []struct {
+		value string
+		rate  float64
+		want  float64
+	}{
+		{"90", 25, 90},
+		{" 12.5 ", 25, 12.5},
+		{"01:30", 25, 90},
+		{"01:00:00.500", 25, 3600.5},
+		{"00:00:01:12", 25, 1.48},
+		{"00:00:10:00", 24, 10},
+		{"00:00:01:00", 0, 1},
+		{"00:01:00;02", 30000.0 / 1001, 1800 / (30000.0 / 1001)},
+		{"00:10:00;00", 30000.0 / 1001, 17982 / (30000.0 / 1001)},
+		{"00:01:00;04", 60000.0 / 1001, 3600 / (60000.0 / 1001)},
+	}
+	for _, test := range tests {
+		got, err := Parse(test.value, test.rate)
+		if err != nil {
+			t.Errorf("Parse(%q, %v): %v", test.value, test.rate, err)
+			continue
+		}
+		if math.Abs(got-test.want) > 1e-9 {
+			t.Errorf("Parse(%q, %v) = %v, want %v", test.value, test.rate, got, test.want)
+		}
+	}
+}
+
+// TestParseInvalid rejects malformed timecodes and frame numbers drop-frame skips
+// Bozuk zaman kodlarını ve drop-frame'in atladığı kare numaralarını reddeder
+func TestParseInvalid(t *testing.T) {
+	tests := []struct {
+		value string
+		rate  float64
+	}{
+		{"", 25},
+		{"-5", 25},
+		{"abc", 25},
+		{"1:2:3:4:5", 25},
+		{"00:61:00", 25},
+		{"00:00:01:25", 25},
+		{"00:00:01.5:10", 25},
+		{"00:01:00;00", 30000.0 / 1001},
+		{"00:01:00;01", 30000.0 / 1001},
+		{"00:01:00;03", 60000.0 / 1001},
+	}
+	for _, test := range tests {
+		if got, err := Parse(test.value, test.rate); err == nil {
+			t.Errorf("Parse(%q, %v) = %v, want an error", test.value, test.rate, got)
+		}
+	}
+}
+
+// TestFormatRoundTrip formats positions and parses them back to the same frame
+// Konumları biçimlendirir ve aynı kareye geri ayrıştırır
+func TestFormatRoundTrip(t *testing.T) {
+	tests := []struct {
+		seconds float64
+		rate    float64
+		want    string
+	}{
+		{0, 25, "00:00:00:00"},
+		{1.48, 25, "00:00:01:12"},
+		{3661, 24, "01:01:01:00"},
+		{1800 / (30000.0 / 1001), 30000.0 / 1001, "00:01:00;02"},
+		{17982 / (30000.0 / 1001), 30000.0 / 1001, "00:10:00;00"},
+		{-3, 25, "00:00:00:00"},
+		{61.7, 0, "00:01:01:00"},
+	}
+	for _, test := range tests {
+		got := Format(test.seconds, test.rate)
+		if got != test.want {
+			t.Errorf("Format(%v, %v) = %s, want %s", test.seconds, test.rate, got, test.want)
+			continue
+		}
+		if test.seconds < 0 || test.rate == 0 {
+			continue
+		}
+		back, err := Parse(got, test.rate)
+		if err != nil || math.Abs(back-test.seconds) > 1e-6 {
+			t.Errorf("Parse(Format(%v)) = %v, %v", test.seconds, back, err)
+		}
+	}
+}
+
+// TestFor picks drop-frame counting only for the NTSC rates
+// Drop-frame sayımını yalnızca NTSC hızları için seçer
+func TestFor(t *testing.T) {
+	if tb := For(29.97); !tb.DropFrame || tb.Nominal != 30 || tb.Dropped != 2 {
+		t.Errorf("For(29.97) = %+v", tb)
+	}
+	if tb := For(59.94); !tb.DropFrame || tb.Nominal != 60 || tb.Dropped != 4 {
+		t.Errorf("For(59.94) = %+v", tb)
+	}
+	if tb := For(23.976); tb.DropFrame || tb.Nominal != 24 {
+		t.Errorf("For(23.976) = %+v", tb)
+	}
+	for _, rate := range []float64{0, -1, math.NaN(), math.Inf(1)} {
+		if tb := For(rate); tb != (Timebase{}) {
+			t.Errorf("For(%v) = %+v, want the zero timebase", rate, tb)
+		}
+	}
+}
@@ -0,0 +1,280 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"AV1-video-converter/internal/events"
+	"AV1-video-converter/internal/queue"
+)
+
+var (
+	// errCancelledByUser is the cancellation cause of CancelConversion
+	// CancelConversion'ın iptal nedenidir
+	errCancelledByUser = errors.New("cancelled by user")
+
+	// errJobTimeout is the cancellation cause of the job timeout
+	// İş zaman aşımının iptal nedenidir
+	errJobTimeout = errors.New("job timed out")
+
+	// errStalled is the cancellation cause of a stalled attempt
+	// Takılan bir denemenin iptal nedenidir
+	errStalled = errors.New("conversion stalled")
+
+	// errShuttingDown is the cancellation cause of application shutdown
+	// Uygulama kapanışının iptal nedenidir
+	errShuttingDown = errors.New("application shutting down")
+
+	// errSuspended is the cancellation cause of an attempt the system sleep broke
+	// Sistem uykusunun bozduğu bir denemenin iptal nedenidir
+	errSuspended = errors.New("interrupted by system sleep")
+)
+
+// Job struct
+// Represents a conversion job created by the backend
+// Arka uç tarafından oluşturulan bir dönüştürme işini temsil eder
+type Job struct {
+	ID           string             `json:"id"`           // Unique job identifier / Benzersiz iş tanımlayıcısı
+	InputPath    string             `json:"inputPath"`    // Source file / Kaynak dosya
+	OutputFolder string             `json:"outputFolder"` // Destination folder / Hedef klasör
+	OutputPath   string             `json:"outputPath"`   // Output file once known / Bilindiğinde çıktı dosyası
+	TotalFrames  int                `json:"totalFrames"`  // Expected frame count / Beklenen kare sayısı
+	Settings     ConversionSettings `json:"settings"`     // Settings snapshot / Ayarların anlık görüntüsü
+	Status       string             `json:"status"`       // queued, running, completed, failed / İş durumu
+	Error        string             `json:"error"`        // Failure reason / Hata nedeni
+	CreatedAt    time.Time          `json:"createdAt"`    // Creation time / Oluşturulma zamanı
+	OutputPaths  []string           `json:"outputPaths"`  // Output file of every rendition / Her sürümün çıktı dosyası
+	Timeline     []JobEvent         `json:"timeline"`     // Stages the job went through / İşin geçtiği aşamalar
+	Renditions   []Rendition        `json:"renditions"`   // Outputs to produce, empty for one output with Settings / Üretilecek çıktılar, boşsa Settings ile tek çıktı
+	Phases       []JobPhase         `json:"phases"`       // Time spent in each phase / Her aşamada geçen süre
+	CPUSeconds   float64            `json:"cpuSeconds"`   // CPU time of the FFmpeg processes / FFmpeg işlemlerinin CPU süresi
+	Energy       *EnergyUsage       `json:"energy"`       // Estimated energy of the encode / Kodlamanın tahmini enerjisi
+	AudioOnly    bool               `json:"audioOnly"`    // Audio file encoded with the music settings / Müzik ayarlarıyla kodlanan ses dosyası
+	Clip         *ClipExport        `json:"clip"`         // Range exported instead of a full conversion / Tam dönüştürme yerine dışa aktarılan aralık
+	SourceReport *SourceReport      `json:"sourceReport"` // Black, frozen and corrupt segments of the source / Kaynağın siyah, donmuş ve bozuk bölümleri
+	Workflow     string             `json:"workflow"`     // Workflow that queued the job / İşi kuyruğa ekleyen iş akışı
+	Mismatches   []OutputMismatch   `json:"mismatches"`   // Differences between the outputs and the source / Çıktılar ile kaynak arasındaki farklar
+	Notes        string             `json:"notes"`        // Free text of the user / Kullanıcının serbest metni
+	Tags         []string           `json:"tags"`         // Labels of the user, e.g. season 2 / Kullanıcının etiketleri, örn. season 2
+	Route        *EncoderRoute      `json:"route"`        // Path the balanced mode picked, nil outside it / Dengeli modun seçtiği yol, onun dışında nil
+
+	cancel     context.CancelCauseFunc // Cancels the running job / Çalışan işi iptal eder
+	restart    context.CancelCauseFunc // Cancels the current attempt so it starts again / Mevcut denemeyi yeniden başlaması için iptal eder
+	writing    []string                // Files the running FFmpeg writes / Çalışan FFmpeg'in yazdığı dosyalar
+	advancedAt time.Time               // Last FFmpeg progress, zero outside an encode / Son FFmpeg ilerlemesi, kodlama dışında sıfır
+}
+
+// JobEvent struct
+// Represents a stage in the timeline of a job
+// Bir işin zaman çizelgesindeki bir aşamayı temsil eder
+type JobEvent struct {
+	Time    time.Time `json:"time"`    // When the stage was reached / Aşamaya ulaşılma zamanı
+	Stage   string    `json:"stage"`   // encode, transfer, completed, failed / Aşama
+	Message string    `json:"message"` // Details / Ayrıntılar
+}
+
+// JobPhase struct
+// Represents the time a job spent in one phase of its pipeline
+// Bir işin hattının bir aşamasında geçirdiği süreyi temsil eder
+type JobPhase struct {
+	Name      string    `json:"name"`      // download, probe, analysis, encode, mux, transfer, verification, upload / Aşama adı
+	StartedAt time.Time `json:"startedAt"` // Phase start / Aşama başlangıcı
+	Seconds   float64   `json:"seconds"`   // Duration in seconds / Saniye cinsinden süre
+}
+
+// AddJob creates a job for a source with the current settings
+// Returns the job so the frontend can correlate its events by ID
+// Ön yüzün olaylarını kimliğe göre eşleştirebilmesi için işi döndürür
+func (a *App) AddJob(inputPath, outputFolder string, totalFrames int) (Job, error) {
+	job, err := a.addJob(inputPath, outputFolder, totalFrames, a.GetSettings())
+	if err != nil {
+		return Job{}, err
+	}
+	a.emitJobEvent(events.JobAdded, job.ID, JobAddedEvent{Job: job})
+	a.emitQueueUpdated()
+	return job, nil
+}
+
+// addJob registers a queued job with the given settings
+// Verilen ayarlarla kuyruktaki bir işi kaydeder
+func (a *App) addJob(inputPath, outputFolder string, totalFrames int, settings ConversionSettings) (Job, error) {
+	id, err := newJobID()
+	if err != nil {
+		return Job{}, fmt.Errorf("failed to create job ID: %v", err)
+	}
+
+	job := Job{
+		ID:           id,
+		InputPath:    inputPath,
+		OutputFolder: outputFolder,
+		TotalFrames:  totalFrames,
+		Settings:     settings,
+		AudioOnly:    a.isAudioFile(inputPath),
+		Status:       "queued",
+		CreatedAt:    time.Now(),
+	}
+
+	a.jobs.Add(id, job)
+
+	log.Printf("Job %s added for %s", id, inputPath)
+	return job, nil
+}
+
+// GetJobs returns all jobs in the order they were added
+// Tüm işleri eklenme sırasına göre döndürür
+func (a *App) GetJobs() []Job {
+	return a.jobs.List()
+}
+
+// RemoveJob forgets a job that isn't running
+// Çalışmayan bir işi unutur
+func (a *App) RemoveJob(jobID string) error {
+	err := a.jobs.Remove(jobID, func(job *Job) error {
+		if job.Status == "running" || job.cancel != nil {
+			return fmt.Errorf("job %s is running, cancel it first", jobID)
+		}
+		return nil
+	})
+	if errors.Is(err, queue.ErrNotFound) {
+		return fmt.Errorf("unknown job: %s", jobID)
+	}
+	if err == nil {
+		a.emitQueueUpdated()
+	}
+	return err
+}
+
+// CancelConversion stops a running conversion
+// Kills FFmpeg and its progress monitor, the job is reported as failed
+// FFmpeg'i ve ilerleme izleyicisini sonlandırır, iş başarısız olarak bildirilir
+func (a *App) CancelConversion(jobID string) error {
+	var cancel context.CancelCauseFunc
+	if !a.jobs.Update(jobID, func(job *Job) { cancel = job.cancel }) {
+		return fmt.Errorf("unknown job: %s", jobID)
+	}
+	if cancel == nil {
+		return fmt.Errorf("job %s is not running", jobID)
+	}
+	log.Printf("Cancelling job %s", jobID)
+	cancel(errCancelledByUser)
+	return nil
+}
+
+// getJob returns a copy of a job
+// Bir işin kopyasını döndürür
+func (a *App) getJob(jobID string) (Job, bool) {
+	return a.jobs.Get(jobID)
+}
+
+// updateJob changes a job under the registry lock
+// Kayıt kilidi altında bir işi değiştirir
+func (a *App) updateJob(jobID string, update func(job *Job)) {
+	a.jobs.Update(jobID, update)
+}
+
+// newJobContext claims a job and creates its context, cancelled by CancelConversion or app shutdown
+// The check that the job hasn't started yet and the claim are one registry update
+// İşin henüz başlamadığının kontrolü ve sahiplenme tek bir kayıt güncellemesidir
+func (a *App) newJobContext(jobID string) (context.Context, context.CancelCauseFunc, error) {
+	ctx, cancel := context.WithCancelCause(a.baseContext())
+	var err error
+	found := a.jobs.Update(jobID, func(job *Job) {
+		if job.Status == "running" || job.cancel != nil {
+			err = fmt.Errorf("job %s is already running", jobID)
+			return
+		}
+		job.cancel = cancel
+	})
+	if !found {
+		err = fmt.Errorf("unknown job: %s", jobID)
+	}
+	if err != nil {
+		cancel(nil)
+		return nil, nil, err
+	}
+	return ctx, cancel, nil
+}
+
+// withJobTimeout starts the job timeout once a job runs, the time it waited in the queue doesn't count
+// İş çalışmaya başladığında iş zaman aşımını başlatır, kuyrukta beklediği süre sayılmaz
+func (a *App) withJobTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	timeout := a.currentPreferences().JobTimeout
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeoutCause(ctx, time.Duration(timeout)*time.Minute, errJobTimeout)
+}
+
+// finishJobContext releases the job context once the job has ended
+// İş bittiğinde iş bağlamını serbest bırakır
+func (a *App) finishJobContext(jobID string, cancel context.CancelCauseFunc) {
+	a.updateJob(jobID, func(job *Job) {
+		job.cancel, job.restart = nil, nil
+	})
+	cancel(nil)
+}
+
+// shutdownGrace is how long shutdown lets cancelled jobs clean up before FFmpeg is killed
+// Kapanışın FFmpeg sonlandırılmadan önce iptal edilen işlerin temizlenmesini beklediği süredir
+const shutdownGrace = 10 * time.Second
+
+// waitForJobs waits until no job holds a context anymore, false when the timeout passed first
+// Hiçbir iş bağlam tutmayana kadar bekler, önce zaman aşımı dolarsa false
+func (a *App) waitForJobs(timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for {
+		active := false
+		for _, job := range a.GetJobs() {
+			if job.cancel != nil {
+				active = true
+				break
+			}
+		}
+		if !active {
+			return true
+		}
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+// newJobID returns a random job identifier
+// Rastgele bir iş tanımlayıcısı döndürür
+func newJobID() (string, error) {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// addJobEvent appends a stage to the timeline of a job and reports it to the frontend
+// Bir işin zaman çizelgesine bir aşama ekler ve ön yüze bildirir
+func (a *App) addJobEvent(jobID, stage, message string) {
+	event := JobEvent{Time: time.Now(), Stage: stage, Message: message}
+	a.updateJob(jobID, func(job *Job) {
+		job.Timeline = append(job.Timeline, event)
+	})
+	a.emitJobEvent(events.JobLog, jobID, JobLogEvent{Stage: stage, Message: message})
+}
+
+// startPhase starts timing a phase of a job, the returned function ends it
+// The timing is recorded on the job and reported to the frontend
+// Süre işe kaydedilir ve ön yüze bildirilir
+func (a *App) startPhase(jobID, name string) func() {
+	start := time.Now()
+	return func() {
+		phase := JobPhase{Name: name, StartedAt: start, Seconds: time.Since(start).Seconds()}
+		a.updateJob(jobID, func(job *Job) {
+			job.Phases = append(job.Phases, phase)
+		})
+		a.emitJobEvent(events.JobPhase, jobID, JobPhaseEvent{Phase: name, Seconds: phase.Seconds})
+	}
+}
@@ -0,0 +1,41 @@
+//go:build darwin
+
+package main
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// keychainLoad reads the secret key from the macOS login keychain
+// Gizli anahtarı macOS giriş anahtar zincirinden okur
+func keychainLoad(appDir string) ([]byte, error) {
+	out, err := exec.Command("security", "find-generic-password",
+		"-s", keychainService, "-a", appDir, "-w").Output()
+	if err != nil {
+		return nil, errKeychainUnavailable
+	}
+	return base64.StdEncoding.DecodeString(strings.TrimSpace(string(out)))
+}
+
+// keychainStore writes the secret key to the macOS login keychain
+// An existing entry is never overwritten, it may hold the key of older secrets
+// The command goes through stdin of security -i so the key never shows up in the process list
+// security -i exits cleanly after a failed command, secretKey reads the key back before relying on it
+// Komut security -i'nin standart girdisinden verilir, böylece anahtar işlem listesinde asla görünmez
+func keychainStore(appDir string, key []byte) error {
+	command := fmt.Sprintf("add-generic-password -s %s -a %s -l %s -w %s\n",
+		securityQuote(keychainService), securityQuote(appDir), securityQuote(appName),
+		securityQuote(base64.StdEncoding.EncodeToString(key)))
+	cmd := exec.Command("security", "-i")
+	cmd.Stdin = strings.NewReader(command)
+	return cmd.Run()
+}
+
+// securityQuote quotes an argument for the command line of security -i
+// security -i komut satırı için bir argümanı tırnak içine alır
+func securityQuote(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
@@ -0,0 +1,30 @@
+//go:build !darwin && !windows
+
+package main
+
+import (
+	"encoding/base64"
+	"os/exec"
+	"strings"
+)
+
+// keychainLoad reads the secret key from the Secret Service through secret-tool
+// Gizli anahtarı secret-tool aracılığıyla Secret Service'ten okur
+func keychainLoad(appDir string) ([]byte, error) {
+	out, err := exec.Command("secret-tool", "lookup",
+		"service", keychainService, "account", appDir).Output()
+	if err != nil || len(out) == 0 {
+		return nil, errKeychainUnavailable
+	}
+	return base64.StdEncoding.DecodeString(strings.TrimSpace(string(out)))
+}
+
+// keychainStore writes the secret key to the Secret Service through secret-tool
+// The secret is passed on stdin so it never shows up in the process list
+// Gizli değer standart girdiden verilir, böylece işlem listesinde asla görünmez
+func keychainStore(appDir string, key []byte) error {
+	cmd := exec.Command("secret-tool", "store", "--label="+appName,
+		"service", keychainService, "account", appDir)
+	cmd.Stdin = strings.NewReader(base64.StdEncoding.EncodeToString(key))
+	return cmd.Run()
+}
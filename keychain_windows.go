@@ -0,0 +1,58 @@
+//go:build windows
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"syscall"
+	"unsafe"
+)
+
+var (
+	crypt32                = syscall.NewLazyDLL("crypt32.dll")
+	procCryptProtectData   = crypt32.NewProc("CryptProtectData")
+	procCryptUnprotectData = crypt32.NewProc("CryptUnprotectData")
+)
+
+// dataBlob mirrors the DATA_BLOB structure of the Windows API
+// Windows API'sinin DATA_BLOB yapısını yansıtır
+type dataBlob struct {
+	size uint32
+	data *byte
+}
+
+// keychainLoad reads the secret key protected with DPAPI for the current user
+// Geçerli kullanıcı için DPAPI ile korunan gizli anahtarı okur
+func keychainLoad(appDir string) ([]byte, error) {
+	sealed, err := os.ReadFile(filepath.Join(appDir, "secret.dpapi"))
+	if err != nil || len(sealed) == 0 {
+		return nil, errKeychainUnavailable
+	}
+	return dpapiCall(procCryptUnprotectData, sealed)
+}
+
+// keychainStore protects the secret key with DPAPI for the current user
+// Gizli anahtarı geçerli kullanıcı için DPAPI ile korur
+func keychainStore(appDir string, key []byte) error {
+	sealed, err := dpapiCall(procCryptProtectData, key)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(appDir, "secret.dpapi"), sealed, 0600)
+}
+
+// dpapiCall runs CryptProtectData or CryptUnprotectData on a buffer
+// Bir arabellek üzerinde CryptProtectData veya CryptUnprotectData çalıştırır
+func dpapiCall(proc *syscall.LazyProc, input []byte) ([]byte, error) {
+	in := dataBlob{size: uint32(len(input)), data: &input[0]}
+	var out dataBlob
+	ret, _, err := proc.Call(
+		uintptr(unsafe.Pointer(&in)), 0, 0, 0, 0, 0,
+		uintptr(unsafe.Pointer(&out)))
+	if ret == 0 {
+		return nil, err
+	}
+	defer syscall.LocalFree(syscall.Handle(unsafe.Pointer(out.data)))
+	return append([]byte(nil), unsafe.Slice(out.data, out.size)...), nil
+}
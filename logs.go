@@ -0,0 +1,164 @@
+package main
+
+import (
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// LogPurgeResult struct
+// Represents the outcome of a log purge
+// Bir log temizliğinin sonucunu temsil eder
+type LogPurgeResult struct {
+	Removed    int   `json:"removed"`    // Log files and job folders removed / Silinen log dosyaları ve iş klasörleri
+	FreedBytes int64 `json:"freedBytes"` // Disk space freed in bytes / Bayt cinsinden boşaltılan disk alanı
+}
+
+// logItem struct
+// Represents a job log folder or a loose log file in the logs directory
+// Log dizinindeki bir iş log klasörünü veya tek bir log dosyasını temsil eder
+type logItem struct {
+	path    string
+	size    int64
+	modTime time.Time
+}
+
+// logsDir returns the directory holding the application and job logs
+// Uygulama ve iş loglarını tutan dizini döndürür
+func (a *App) logsDir() string {
+	return filepath.Join(a.appDir, "logs")
+}
+
+// jobLogPath returns the path of a log file inside the folder of a job
+// Creates the folder named after the job ID if needed
+// Gerekirse iş kimliğiyle adlandırılan klasörü oluşturur
+func (a *App) jobLogPath(jobID, fileName string) (string, error) {
+	dir := filepath.Join(a.logsDir(), jobID)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, fileName), nil
+}
+
+// PurgeLogs removes every job log except those of running jobs
+// Deletes all job log folders and loose log files, app.log is kept
+// Tüm iş log klasörlerini ve tek log dosyalarını siler, app.log korunur
+func (a *App) PurgeLogs() (LogPurgeResult, error) {
+	items, err := a.listLogItems()
+	if err != nil {
+		log.Printf("Error reading logs directory: %v", err)
+		return LogPurgeResult{}, fmt.Errorf("failed to read logs directory: %v", err)
+	}
+
+	var result LogPurgeResult
+	for _, item := range items {
+		if removeLogItem(item) {
+			result.Removed++
+			result.FreedBytes += item.size
+		}
+	}
+	log.Printf("Purged %d logs, freed %d bytes", result.Removed, result.FreedBytes)
+	return result, nil
+}
+
+// cleanupLogs applies the retention preferences to the job logs
+// Removes logs older than the age limit, then the oldest ones above the count and size limits
+// Yaş sınırından eski logları, ardından sayı ve boyut sınırlarını aşan en eski logları siler
+func (a *App) cleanupLogs() {
+	items, err := a.listLogItems()
+	if err != nil {
+		log.Printf("Error reading logs directory: %v", err)
+		return
+	}
+
+	// Newest first, so everything past a limit is the oldest
+	// En yeniden başla, böylece bir sınırı aşan her şey en eskidir
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].modTime.After(items[j].modTime)
+	})
+
+	prefs := a.currentPreferences()
+	maxAge := time.Duration(prefs.LogRetentionDays) * 24 * time.Hour
+	maxBytes := int64(prefs.LogRetentionMB) * 1024 * 1024
+	now := time.Now()
+
+	var kept int
+	var keptBytes int64
+	for _, item := range items {
+		expired := maxAge > 0 && now.Sub(item.modTime) > maxAge
+		tooMany := prefs.LogRetentionFiles > 0 && kept >= prefs.LogRetentionFiles
+		tooLarge := maxBytes > 0 && keptBytes+item.size > maxBytes
+		if (expired || tooMany || tooLarge) && removeLogItem(item) {
+			log.Printf("Removed old log: %s", item.path)
+			continue
+		}
+		kept++
+		keptBytes += item.size
+	}
+}
+
+// listLogItems lists the job log folders and loose log files
+// app.log and the logs of running jobs are never listed
+// app.log ve çalışan işlerin logları asla listelenmez
+func (a *App) listLogItems() ([]logItem, error) {
+	entries, err := os.ReadDir(a.logsDir())
+	if err != nil {
+		return nil, err
+	}
+
+	running := make(map[string]bool)
+	for _, job := range a.GetJobs() {
+		if job.Status == "running" {
+			running[job.ID] = true
+		}
+	}
+
+	var items []logItem
+	for _, entry := range entries {
+		if entry.Name() == "app.log" || running[entry.Name()] {
+			continue
+		}
+		item := logItem{path: filepath.Join(a.logsDir(), entry.Name())}
+		if err := measureLogItem(&item); err != nil {
+			log.Printf("Error reading log %s: %v", item.path, err)
+			continue
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// measureLogItem fills the total size and the latest modification time
+// Toplam boyutu ve en son değişiklik zamanını doldurur
+func measureLogItem(item *logItem) error {
+	return filepath.WalkDir(item.path, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		if !entry.IsDir() {
+			item.size += info.Size()
+		}
+		if info.ModTime().After(item.modTime) {
+			item.modTime = info.ModTime()
+		}
+		return nil
+	})
+}
+
+// removeLogItem deletes a log file or job log folder
+// Bir log dosyasını veya iş log klasörünü siler
+func removeLogItem(item logItem) bool {
+	if err := os.RemoveAll(item.path); err != nil {
+		log.Printf("Error removing log %s: %v", item.path, err)
+		return false
+	}
+	return true
+}
@@ -1,7 +1,17 @@
+//go:build !headless
+
 package main
 
 import (
+	"context"
 	"embed"
+	"os"
+	"os/signal"
+	"runtime/debug"
+	"syscall"
+
+	"AV1-video-converter/internal/runner"
+
 	"github.com/wailsapp/wails/v2"
 	"github.com/wailsapp/wails/v2/pkg/options"
 	"github.com/wailsapp/wails/v2/pkg/options/assetserver"
@@ -11,9 +21,35 @@ import (
 var assets embed.FS
 
 func main() {
+	// Answer the password prompt of an SFTP transfer instead of starting the UI
+	// Arayüzü başlatmak yerine bir SFTP aktarımının parola istemini yanıtla
+	if answerAskpass() {
+		return
+	}
+
 	// Create an instance of the app structure
 	app := NewApp()
 
+	// Record a crash report before a panic takes the application down
+	// Bir panik uygulamayı çökertmeden önce bir çökme raporu kaydet
+	defer func() {
+		if r := recover(); r != nil {
+			app.writeCrashReport("main", r, debug.Stack())
+			runner.KillAll()
+			panic(r)
+		}
+	}()
+
+	// Shut down like a closed window when terminated by a signal, so jobs clean up before FFmpeg is killed
+	// Bir sinyalle sonlandırıldığında kapatılan bir pencere gibi kapan, böylece FFmpeg sonlandırılmadan önce işler temizlenir
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-signals
+		app.shutdown(context.Background())
+		os.Exit(1)
+	}()
+
 	// Create application with options
 	err := wails.Run(&options.App{
 		Title:         "MD-AV1-Converter",
@@ -21,10 +57,12 @@ func main() {
 		Height:        680,
 		DisableResize: true,
 		AssetServer: &assetserver.Options{
-			Assets: assets,
+			Assets:  assets,
+			Handler: app.media,
 		},
 		BackgroundColour: &options.RGBA{R: 27, G: 38, B: 54, A: 1},
 		OnStartup:        app.startup,
+		OnShutdown:       app.shutdown,
 		Bind: []interface{}{
 			app,
 		},
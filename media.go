@@ -0,0 +1,97 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+// mediaRoutePrefix is the asset server path converted files are served from
+// Dönüştürülmüş dosyaların sunulduğu varlık sunucusu yoludur
+const mediaRoutePrefix = "/media/"
+
+// mediaServer struct
+// Serves registered local files to the in-app HTML5 player
+// Kayıtlı yerel dosyaları uygulama içi HTML5 oynatıcıya sunar
+type mediaServer struct {
+	mu    sync.Mutex
+	files map[string]string // Token to file path / Belirteçten dosya yoluna
+}
+
+// newMediaServer creates an empty media server
+// Boş bir medya sunucusu oluşturur
+func newMediaServer() *mediaServer {
+	return &mediaServer{files: make(map[string]string)}
+}
+
+// GetPreviewURL returns a URL the frontend player can stream the file from
+// Only files registered this way are served, never arbitrary paths
+// Yalnızca bu şekilde kaydedilen dosyalar sunulur, asla rastgele yollar sunulmaz
+func (a *App) GetPreviewURL(path string) (string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", fmt.Errorf("file not found: %v", err)
+	}
+	if info.IsDir() {
+		return "", fmt.Errorf("not a file: %s", path)
+	}
+	return a.media.register(path)
+}
+
+// register stores the path under a random token and returns its URL
+// Yolu rastgele bir belirteç altında saklar ve URL'sini döndürür
+func (m *mediaServer) register(path string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for token, registered := range m.files {
+		if registered == path {
+			return mediaRoutePrefix + token, nil
+		}
+	}
+
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(buf)
+	m.files[token] = path
+	return mediaRoutePrefix + token, nil
+}
+
+// ServeHTTP streams a registered file with range request support for seeking
+// Sarma için aralık isteği desteğiyle kayıtlı bir dosyayı akıtır
+func (m *mediaServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.URL.Path, mediaRoutePrefix) {
+		http.NotFound(w, r)
+		return
+	}
+
+	m.mu.Lock()
+	path, ok := m.files[strings.TrimPrefix(r.URL.Path, mediaRoutePrefix)]
+	m.mu.Unlock()
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		log.Printf("Error opening media file %s: %v", path, err)
+		http.Error(w, "file not available", http.StatusNotFound)
+		return
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		http.Error(w, "file not available", http.StatusNotFound)
+		return
+	}
+	http.ServeContent(w, r, info.Name(), info.ModTime(), file)
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	goruntime "runtime"
+	"strings"
+	"sync"
+)
+
+// Memory estimate constants, rough on purpose, they only need to catch 8K and 12-bit jobs
+// Kaba tutulan bellek tahmini sabitleri, yalnızca 8K ve 12 bit işleri yakalamaları yeterlidir
+const (
+	memoryBaseMB         = 256  // FFmpeg, demuxers and audio / FFmpeg, ayrıştırıcılar ve ses
+	memoryDecodeFrames   = 16   // Decoded source frames queued ahead of the filters / Filtrelerin önünde sıraya alınan çözülmüş kaynak kareler
+	autoMemoryBudgetPart = 0.75 // Share of the physical memory used when no budget is set / Bütçe ayarlanmadığında kullanılan fiziksel bellek payı
+)
+
+// MemoryEstimate struct
+// Represents the expected memory use of a job and the budget it is scheduled against
+// Bir işin beklenen bellek kullanımını ve planlandığı bütçeyi temsil eder
+type MemoryEstimate struct {
+	EstimatedMB int64 `json:"estimatedMB"` // Expected peak use / Beklenen en yüksek kullanım
+	BudgetMB    int64 `json:"budgetMB"`    // Budget of concurrent jobs, 0 for no limit / Eşzamanlı işlerin bütçesi, 0 sınırsız
+	RunsAlone   bool  `json:"runsAlone"`   // The job exceeds the budget and waits for the others / İş bütçeyi aşar ve diğerlerini bekler
+}
+
+// memoryGate struct
+// Admits jobs while their estimates fit the budget, a job is always admitted when nothing runs
+// İşleri tahminleri bütçeye sığdıkça kabul eder, hiçbir şey çalışmıyorsa bir iş her zaman kabul edilir
+type memoryGate struct {
+	mu      sync.Mutex
+	usedMB  int64
+	running int
+	changed chan struct{}
+}
+
+// acquire waits until the job fits, the returned function gives the memory back
+// İş sığana kadar bekler, döndürülen fonksiyon belleği geri verir
+func (g *memoryGate) acquire(ctx context.Context, needMB, budgetMB int64, onWait func()) (func(), error) {
+	waited := false
+	for {
+		g.mu.Lock()
+		if g.changed == nil {
+			g.changed = make(chan struct{})
+		}
+		if g.running == 0 || g.usedMB+needMB <= budgetMB {
+			g.usedMB += needMB
+			g.running++
+			g.mu.Unlock()
+			return func() { g.release(needMB) }, nil
+		}
+		changed := g.changed
+		g.mu.Unlock()
+
+		if !waited {
+			waited = true
+			onWait()
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("conversion cancelled: %w", context.Cause(ctx))
+		case <-changed:
+		}
+	}
+}
+
+// release gives the memory of a finished job back and wakes the waiting jobs
+// Biten bir işin belleğini geri verir ve bekleyen işleri uyandırır
+func (g *memoryGate) release(needMB int64) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.usedMB -= needMB
+	g.running--
+	close(g.changed)
+	g.changed = make(chan struct{})
+}
+
+// EstimateJobMemory returns the memory estimate of a job
+// Bir işin bellek tahminini döndürür
+func (a *App) EstimateJobMemory(jobID string) (MemoryEstimate, error) {
+	job, ok := a.getJob(jobID)
+	if !ok {
+		return MemoryEstimate{}, fmt.Errorf("unknown job: %s", jobID)
+	}
+	video, err := a.getVideoInfo(job.InputPath)
+	if err != nil {
+		return MemoryEstimate{}, err
+	}
+	estimate := MemoryEstimate{EstimatedMB: estimateJobMemory(video, job), BudgetMB: a.memoryBudgetMB()}
+	estimate.RunsAlone = estimate.BudgetMB > 0 && estimate.EstimatedMB > estimate.BudgetMB
+	return estimate, nil
+}
+
+// reserveMemory holds a job back until its estimated memory fits the budget
+// Jobs over the whole budget run once nothing else does, an unknown source isn't held back
+// Bütçenin tamamını aşan işler başka hiçbir şey çalışmadığında çalışır, bilinmeyen bir kaynak bekletilmez
+func (a *App) reserveMemory(ctx context.Context, job Job) (func(), error) {
+	budget := a.memoryBudgetMB()
+	if budget <= 0 {
+		return func() {}, nil
+	}
+	video, err := a.getVideoInfo(job.InputPath)
+	if err != nil {
+		log.Printf("Skipping the memory estimate of job %s: %v", job.ID, err)
+		return func() {}, nil
+	}
+
+	need := estimateJobMemory(video, job)
+	if need > budget {
+		log.Printf("Job %s needs about %d MB, more than the %d MB budget, it runs alone", job.ID, need, budget)
+		a.addJobEvent(job.ID, "memory", fmt.Sprintf("needs about %d MB, more than the %d MB budget, running it alone", need, budget))
+	}
+	return a.memory.acquire(ctx, need, budget, func() {
+		log.Printf("Job %s waits for %d MB of memory", job.ID, need)
+		a.addJobEvent(job.ID, "memory", fmt.Sprintf("waiting for %d MB of memory", need))
+	})
+}
+
+// memoryBudgetMB returns the memory concurrent jobs may use, 0 for no limit
+// Eşzamanlı işlerin kullanabileceği belleği döndürür, 0 sınırsız
+func (a *App) memoryBudgetMB() int64 {
+	switch budget := a.currentPreferences().MemoryBudgetMB; {
+	case budget > 0:
+		return int64(budget)
+	case budget < 0:
+		return 0
+	}
+	total := totalMemory()
+	if total <= 0 {
+		return 0
+	}
+	return int64(float64(total) * autoMemoryBudgetPart / (1 << 20))
+}
+
+// estimateJobMemory estimates the peak memory of a job in MB
+// All renditions are encoded from one decode, so their encoders add up
+// Tüm yorumlar tek bir çözmeden kodlanır, bu yüzden kodlayıcıları toplanır
+func estimateJobMemory(video VideoInfo, job Job) int64 {
+	outputs := []ConversionSettings{job.Settings}
+	if len(job.Renditions) > 0 {
+		outputs = outputs[:0]
+		for _, rendition := range job.Renditions {
+			outputs = append(outputs, rendition.Settings)
+		}
+	}
+
+	threads := goruntime.NumCPU()
+	bytes := float64(memoryBaseMB) * (1 << 20)
+	bytes += frameBytes(video.Width, video.Height, "", video.BitDepth) * memoryDecodeFrames
+	for _, settings := range outputs {
+		spec := encoderSpecs[settings.Encoder]
+		width, height := outputSize(video.Width, video.Height, settings.MaxWidth, settings.MaxHeight)
+		frames := spec.memoryFrames + spec.threadFrames*threads
+		bytes += frameBytes(width, height, settings.PixelFormat, video.BitDepth) * float64(frames)
+	}
+	return int64(math.Ceil(bytes / (1 << 20)))
+}
+
+// frameBytes returns the size of a raw frame, the pixel format decides chroma and depth
+// Ham bir karenin boyutunu döndürür, piksel formatı renk örneklemesini ve derinliği belirler
+func frameBytes(width, height int, pixelFormat string, sourceDepth int) float64 {
+	samples := 1.5
+	switch {
+	case strings.Contains(pixelFormat, "444"):
+		samples = 3
+	case strings.Contains(pixelFormat, "422"):
+		samples = 2
+	}
+	bytesPerSample := 1.0
+	if strings.Contains(pixelFormat, "10") || strings.Contains(pixelFormat, "12") || (pixelFormat == "" && sourceDepth > 8) {
+		bytesPerSample = 2
+	}
+	return float64(width*height) * samples * bytesPerSample
+}
+
+// outputSize returns the dimensions after the size limits, keeping the aspect ratio
+// Boyut sınırlarından sonraki boyutları en boy oranını koruyarak döndürür
+func outputSize(width, height, maxWidth, maxHeight int) (int, int) {
+	scale := 1.0
+	if maxWidth > 0 && width > maxWidth {
+		scale = math.Min(scale, float64(maxWidth)/float64(width))
+	}
+	if maxHeight > 0 && height > maxHeight {
+		scale = math.Min(scale, float64(maxHeight)/float64(height))
+	}
+	return int(float64(width) * scale), int(float64(height) * scale)
+}
@@ -0,0 +1,20 @@
+//go:build darwin
+
+package main
+
+import (
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// totalMemory returns the physical memory in bytes, 0 if it can't be read
+// Fiziksel belleği bayt cinsinden döndürür, okunamazsa 0
+func totalMemory() int64 {
+	out, err := exec.Command("sysctl", "-n", "hw.memsize").Output()
+	if err != nil {
+		return 0
+	}
+	total, _ := strconv.ParseInt(strings.TrimSpace(string(out)), 10, 64)
+	return total
+}
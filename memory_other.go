@@ -0,0 +1,9 @@
+//go:build !linux && !darwin && !windows
+
+package main
+
+// totalMemory can't read the physical memory here, the budget then defaults to no limit
+// Burada fiziksel bellek okunamaz, bütçe bu durumda varsayılan olarak sınırsızdır
+func totalMemory() int64 {
+	return 0
+}
@@ -0,0 +1,35 @@
+//go:build windows
+
+package main
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+var procGlobalMemoryStatusEx = syscall.NewLazyDLL("kernel32.dll").NewProc("GlobalMemoryStatusEx")
+
+// memoryStatusEx mirrors the MEMORYSTATUSEX structure of the Windows API
+// Windows API'sinin MEMORYSTATUSEX yapısını yansıtır
+type memoryStatusEx struct {
+	length               uint32
+	memoryLoad           uint32
+	totalPhys            uint64
+	availPhys            uint64
+	totalPageFile        uint64
+	availPageFile        uint64
+	totalVirtual         uint64
+	availVirtual         uint64
+	availExtendedVirtual uint64
+}
+
+// totalMemory returns the physical memory in bytes, 0 if it can't be read
+// Fiziksel belleği bayt cinsinden döndürür, okunamazsa 0
+func totalMemory() int64 {
+	status := memoryStatusEx{}
+	status.length = uint32(unsafe.Sizeof(status))
+	if result, _, _ := procGlobalMemoryStatusEx.Call(uintptr(unsafe.Pointer(&status))); result == 0 {
+		return 0
+	}
+	return int64(status.totalPhys)
+}
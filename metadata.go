@@ -0,0 +1,126 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// appName and appVersion identify the application in output metadata
+// Çıktı meta verilerinde uygulamayı tanımlar
+const (
+	appName    = "MD-AV1-Converter"
+	appVersion = "1.0.0"
+)
+
+// hashSampleSize is the number of bytes hashed from each end of a file
+// Bir dosyanın her iki ucundan özetlenen bayt sayısıdır
+const hashSampleSize = 4 * 1024 * 1024
+
+// ConversionRecord struct
+// Represents how an output file was produced
+// Bir çıktı dosyasının nasıl üretildiğini temsil eder
+type ConversionRecord struct {
+	App           string `json:"app"`           // Application name / Uygulama adı
+	AppVersion    string `json:"appVersion"`    // Application version / Uygulama sürümü
+	FFmpegVersion string `json:"ffmpegVersion"` // FFmpeg version / FFmpeg sürümü
+	Encoder       string `json:"encoder"`       // Video encoder / Video kodlayıcı
+	CRF           int    `json:"crf"`           // Quality value / Kalite değeri
+	Preset        string `json:"preset"`        // Encoder preset / Kodlayıcı ön ayarı
+	SourceName    string `json:"sourceName"`    // Source file name / Kaynak dosya adı
+	SourceHash    string `json:"sourceHash"`    // Partial SHA-256 of the source / Kaynağın kısmi SHA-256 özeti
+	ConvertedAt   string `json:"convertedAt"`   // Conversion time (RFC 3339) / Dönüştürme zamanı
+}
+
+// newConversionRecord describes a conversion about to start
+// Başlamak üzere olan bir dönüştürmeyi tanımlar
+func (a *App) newConversionRecord(inputPath string, settings ConversionSettings) ConversionRecord {
+	sourceHash, err := quickHash(inputPath)
+	if err != nil {
+		log.Printf("Error hashing %s: %v", inputPath, err)
+	}
+	return ConversionRecord{
+		App:           appName,
+		AppVersion:    appVersion,
+		FFmpegVersion: a.ffmpegVersion,
+		Encoder:       settings.Encoder,
+		CRF:           settings.CRF,
+		Preset:        settings.Preset,
+		SourceName:    filepath.Base(inputPath),
+		SourceHash:    sourceHash,
+		ConvertedAt:   time.Now().Format(time.RFC3339),
+	}
+}
+
+// metadataArgs returns the FFmpeg arguments writing the record into the output
+// The record is stored as JSON in the comment tag, which all containers keep
+// Kayıt, tüm kapsayıcıların koruduğu comment etiketinde JSON olarak saklanır
+func metadataArgs(record ConversionRecord) []string {
+	data, err := json.Marshal(record)
+	if err != nil {
+		log.Printf("Error marshalling conversion record: %v", err)
+		return nil
+	}
+	return []string{
+		"-metadata", "comment=" + string(data),
+		"-metadata", "encoding_tool=" + record.App + " " + record.AppVersion,
+	}
+}
+
+// quickHash returns a SHA-256 over the size and both ends of a file
+// Hashing whole multi-gigabyte sources would take longer than probing them
+// Çok gigabaytlık kaynakların tamamını özetlemek onları incelemekten uzun sürer
+func quickHash(filePath string) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	stat, err := file.Stat()
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(strconv.FormatInt(stat.Size(), 10)))
+	if _, err := io.CopyN(hash, file, hashSampleSize); err != nil && err != io.EOF {
+		return "", err
+	}
+	if stat.Size() > 2*hashSampleSize {
+		if _, err := file.Seek(-hashSampleSize, io.SeekEnd); err != nil {
+			return "", err
+		}
+		if _, err := io.CopyN(hash, file, hashSampleSize); err != nil && err != io.EOF {
+			return "", err
+		}
+	}
+	return "sha256-partial:" + hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// detectFFmpegVersion returns the version reported by an FFmpeg binary
+// Bir FFmpeg ikili dosyasının bildirdiği sürümü döndürür
+func detectFFmpegVersion(binaryPath string) string {
+	out, err := exec.Command(binaryPath, "-version").Output()
+	if err != nil {
+		log.Printf("Error getting version of %s: %v", binaryPath, err)
+		return "unknown"
+	}
+
+	// First line looks like "ffmpeg version 7.0.1 Copyright ..."
+	// İlk satır "ffmpeg version 7.0.1 Copyright ..." biçimindedir
+	fields := strings.Fields(firstLine(string(out)))
+	if len(fields) >= 3 && fields[1] == "version" {
+		return fields[2]
+	}
+	return fmt.Sprintf("unknown (%s)", firstLine(string(out)))
+}
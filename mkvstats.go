@@ -0,0 +1,77 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"AV1-video-converter/internal/probe"
+	"AV1-video-converter/internal/runner"
+)
+
+// writeMatroskaStatistics adds the BPS, NUMBER_OF_FRAMES and NUMBER_OF_BYTES tags media servers read
+// FFmpeg only writes DURATION, mkvpropedit edits the file in place and is used when available
+// FFmpeg yalnızca DURATION yazar, varsa dosyayı yerinde düzenleyen mkvpropedit kullanılır
+func (a *App) writeMatroskaStatistics(ctx context.Context, path string) error {
+	if mkvpropedit := a.findExecutable("mkvpropedit"); mkvpropedit != "" {
+		out, err := runner.CombinedOutput(exec.CommandContext(ctx, mkvpropedit, "--add-track-statistics-tags", path))
+		if err != nil {
+			return fmt.Errorf("mkvpropedit failed: %v: %s", err, strings.TrimSpace(string(out)))
+		}
+		return nil
+	}
+
+	// Without MKVToolNix, count the packets and remux with the tags set
+	// MKVToolNix olmadan paketleri say ve etiketleri ayarlayarak yeniden birleştir
+	args := append(append([]string{}, probe.TrackPacketArgs...), path)
+	out, err := a.runner.Output(ctx, a.ffprobePath, args...)
+	if err != nil {
+		return fmt.Errorf("failed to count packets: %v", err)
+	}
+	tracks := probe.ParseTrackStats(out)
+	indexes := make([]int, 0, len(tracks))
+	for index := range tracks {
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+
+	written := time.Now().UTC().Format("2006-01-02 15:04:05")
+	tempPath := filepath.Join(filepath.Dir(path), ".stats-"+filepath.Base(path))
+	remux := []string{"-hide_banner", "-nostats", "-loglevel", "error", "-y", "-i", path, "-map", "0", "-c", "copy"}
+	for _, index := range indexes {
+		track := tracks[index]
+		bps := int64(0)
+		if seconds := track.Seconds(); seconds > 0 {
+			bps = int64(float64(track.Bytes) * 8 / seconds)
+		}
+		stream := "-metadata:s:" + strconv.Itoa(index)
+		remux = append(remux,
+			stream, "BPS="+strconv.FormatInt(bps, 10),
+			stream, "NUMBER_OF_FRAMES="+strconv.Itoa(track.Packets),
+			stream, "NUMBER_OF_BYTES="+strconv.FormatInt(track.Bytes, 10),
+			stream, "_STATISTICS_WRITING_APP=AV1 Video Converter",
+			stream, "_STATISTICS_WRITING_DATE_UTC="+written,
+			stream, "_STATISTICS_TAGS=BPS DURATION NUMBER_OF_FRAMES NUMBER_OF_BYTES")
+	}
+	remux = append(remux, tempPath)
+
+	cmd := exec.CommandContext(ctx, a.ffmpegPath, remux...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := runner.Run(cmd); err != nil {
+		os.Remove(tempPath)
+		return fmt.Errorf("failed to write statistics tags: %v: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	if err := os.Rename(tempPath, path); err != nil {
+		os.Remove(tempPath)
+		return fmt.Errorf("failed to replace output with the tagged file: %v", err)
+	}
+	return nil
+}
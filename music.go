@@ -0,0 +1,134 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"path/filepath"
+	"strings"
+
+	"AV1-video-converter/internal/command"
+)
+
+// defaultAudioExtensions lists the audio file extensions accepted when none are configured
+// Files with these extensions are queued as audio-only jobs
+// Bu uzantılara sahip dosyalar yalnızca ses işleri olarak kuyruğa eklenir
+var defaultAudioExtensions = []string{
+	".flac", ".wav", ".mp3", ".m4a", ".aac", ".ogg", ".opus", ".wma", ".aiff", ".aif", ".ape", ".wv",
+}
+
+// musicFormat struct
+// Describes an encoder used for audio-only inputs and the file it writes
+// Yalnızca ses girişleri için kullanılan bir kodlayıcıyı ve yazdığı dosyayı tanımlar
+type musicFormat struct {
+	encoder   string // FFmpeg encoder / FFmpeg kodlayıcısı
+	codec     string // Codec name reported by FFprobe / FFprobe'un bildirdiği kodek adı
+	extension string // Output file extension / Çıktı dosya uzantısı
+	lossless  bool   // Bitrate doesn't apply / Bit hızı uygulanmaz
+}
+
+// musicFormats lists the encoders available for audio-only inputs, the first is the default
+// Yalnızca ses girişleri için kullanılabilen kodlayıcıları listeler, ilki varsayılandır
+var musicFormats = []musicFormat{
+	{encoder: "libopus", codec: "opus", extension: "opus"},
+	{encoder: "aac", codec: "aac", extension: "m4a"},
+	{encoder: "libmp3lame", codec: "mp3", extension: "mp3"},
+	{encoder: "flac", codec: "flac", extension: "flac", lossless: true},
+}
+
+// GetAudioExtensions returns the file extensions accepted as audio inputs
+// Ses girişi olarak kabul edilen dosya uzantılarını döndürür
+func (a *App) GetAudioExtensions() []string {
+	return a.audioExtensions()
+}
+
+// audioExtensions returns the configured audio extensions, or the defaults if none are set
+// Yapılandırılmış ses uzantılarını, hiçbiri ayarlanmamışsa varsayılanları döndürür
+func (a *App) audioExtensions() []string {
+	if len(a.currentPreferences().AudioExtensions) == 0 {
+		return defaultAudioExtensions
+	}
+	return a.currentPreferences().AudioExtensions
+}
+
+// isAudioFile reports whether a file has one of the accepted audio extensions
+// Bir dosyanın kabul edilen ses uzantılarından birine sahip olup olmadığını bildirir
+func (a *App) isAudioFile(path string) bool {
+	return containsString(a.audioExtensions(), strings.ToLower(filepath.Ext(path)))
+}
+
+// isMediaFile reports whether a file is accepted as a video or an audio input
+// Bir dosyanın video veya ses girişi olarak kabul edilip edilmediğini bildirir
+func (a *App) isMediaFile(path string) bool {
+	return a.isVideoFile(path) || a.isAudioFile(path)
+}
+
+// findMusicFormat returns the format of an encoder, empty picks the default
+// Bir kodlayıcının biçimini döndürür, boş değer varsayılanı seçer
+func findMusicFormat(encoder string) (musicFormat, bool) {
+	if encoder == "" {
+		return musicFormats[0], true
+	}
+	for _, format := range musicFormats {
+		if format.encoder == encoder {
+			return format, true
+		}
+	}
+	return musicFormat{}, false
+}
+
+// musicEncoders returns the encoder names of the music formats
+// Müzik biçimlerinin kodlayıcı adlarını döndürür
+func musicEncoders() []string {
+	encoders := make([]string, 0, len(musicFormats))
+	for _, format := range musicFormats {
+		encoders = append(encoders, format.encoder)
+	}
+	return encoders
+}
+
+// musicOutputArgs assembles the options of an audio-only output
+// Only the first kept audio track is encoded, cover art and other streams are dropped
+// Yalnızca tutulan ilk ses parçası kodlanır, kapak görseli ve diğer akışlar atılır
+func musicOutputArgs(plan conversionPlan, output planOutput) []string {
+	settings := output.Settings
+	format, _ := findMusicFormat(settings.MusicCodec)
+
+	options := streamOptions(settings)
+	_, audio, _ := command.SelectStreams(options, command.RetagStreams(options, plan.Streams))
+	args := []string{"-vn", "-sn", "-dn"}
+	channels := 2
+	if len(audio) > 0 {
+		args = append(args, "-map", fmt.Sprintf("0:%d", audio[0].Index))
+		channels = audio[0].Channels
+		if len(audio) > 1 {
+			log.Printf("%s has %d audio tracks, only track %d is kept", plan.InputPath, len(audio), audio[0].Index)
+		}
+	} else {
+		args = append(args, "-map", "0:a:0")
+	}
+
+	args = append(args, "-c:a", format.encoder)
+	if !format.lossless {
+		bitrate := settings.MusicBitrate
+		if bitrate == 0 {
+			bitrate = command.FallbackAudioBitrate(channels)
+		}
+		args = append(args, "-b:a", fmt.Sprintf("%dk", bitrate))
+	}
+
+	// Keep the tags of the track, MP4 audio also starts before it's fully downloaded
+	// Parçanın etiketlerini koru, MP4 ses de tamamı indirilmeden başlar
+	args = append(args, "-map_metadata", "0")
+	if format.extension == "m4a" {
+		args = append(args, "-movflags", "+faststart")
+	}
+	args = append(args, metadataArgs(output.Record)...)
+	return append(args, output.Path)
+}
+
+// musicOutputName returns the tag and extension of an audio-only output
+// Yalnızca ses çıktısının etiketini ve uzantısını döndürür
+func musicOutputName(settings ConversionSettings) (tag, extension string) {
+	format, _ := findMusicFormat(settings.MusicCodec)
+	return format.codec, format.extension
+}
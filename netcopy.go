@@ -0,0 +1,173 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// copyChunkSize is the amount copied between progress and cancellation checks
+// İlerleme ve iptal kontrolleri arasında kopyalanan miktardır
+const copyChunkSize = 4 * 1024 * 1024
+
+// partialSuffix marks a destination file still being copied
+// Hâlâ kopyalanan bir hedef dosyayı işaretler
+const partialSuffix = ".part"
+
+// stagingDir returns the local folder a job encodes into before copying
+// Bir işin kopyalamadan önce içine kodladığı yerel klasörü döndürür
+func (a *App) stagingDir(jobID string) (string, error) {
+	root := a.currentPreferences().StagingFolder
+	if root == "" {
+		root = a.workCacheDir("av1-staging")
+	}
+	dir := filepath.Join(root, jobID)
+	return dir, os.MkdirAll(dir, 0755)
+}
+
+// copyToDestination copies a staged output to its destination
+// Interrupted copies resume from the partial file, the result is verified by checksum
+// Kesilen kopyalar yarım dosyadan devam eder, sonuç sağlama toplamıyla doğrulanır
+func (a *App) copyToDestination(ctx context.Context, jobID, src, dst string) error {
+	partPath := dst + partialSuffix
+	var err error
+	for attempt := 0; attempt <= a.currentPreferences().CopyRetries; attempt++ {
+		if attempt > 0 {
+			log.Printf("Retrying copy of %s (attempt %d of %d): %v", src, attempt, a.currentPreferences().CopyRetries, err)
+			select {
+			case <-ctx.Done():
+				return context.Cause(ctx)
+			case <-time.After(time.Duration(attempt) * 2 * time.Second):
+			}
+		}
+
+		if err = a.resumeCopy(ctx, jobID, src, partPath); err != nil {
+			if ctx.Err() != nil {
+				return err
+			}
+			continue
+		}
+		if err = verifyCopy(src, partPath); err != nil {
+			// A corrupt copy can't be resumed, start over
+			// Bozuk bir kopya devam ettirilemez, baştan başla
+			os.Remove(partPath)
+			continue
+		}
+		if err = os.Rename(partPath, dst); err != nil {
+			continue
+		}
+		if err := os.Remove(src); err != nil {
+			log.Printf("Error removing staged output %s: %v", src, err)
+		}
+		log.Printf("Copied %s to %s", src, dst)
+		return nil
+	}
+	return err
+}
+
+// resumeCopy appends the missing part of the source to the partial file
+// Kaynağın eksik kısmını yarım dosyaya ekler
+func (a *App) resumeCopy(ctx context.Context, jobID, src, partPath string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	stat, err := in.Stat()
+	if err != nil {
+		return err
+	}
+
+	out, err := os.OpenFile(partPath, os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	// Continue where the previous attempt stopped
+	// Önceki denemenin durduğu yerden devam et
+	offset, err := out.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	if offset > stat.Size() {
+		if err := out.Truncate(0); err != nil {
+			return err
+		}
+		offset = 0
+	}
+	if offset > 0 {
+		log.Printf("Resuming copy of %s at %d bytes", src, offset)
+	}
+	if _, err := out.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+	if _, err := in.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+
+	started, copied := time.Now(), int64(0)
+	throttle := a.newProgressThrottle()
+	for offset < stat.Size() {
+		if err := ctx.Err(); err != nil {
+			return context.Cause(ctx)
+		}
+		n, err := io.CopyN(out, in, copyChunkSize)
+		offset, copied = offset+n, copied+n
+		if err != nil && err != io.EOF {
+			return err
+		}
+
+		if !throttle.allow(offset >= stat.Size() || err == io.EOF) {
+			continue
+		}
+		speed := ""
+		if elapsed := time.Since(started).Seconds(); elapsed > 0 {
+			speed = fmt.Sprintf("%.1f MB/s", float64(copied)/elapsed/1024/1024)
+		}
+		a.emitProgress(jobID, "copy", float64(offset)/float64(stat.Size())*100, speed)
+		if err == io.EOF {
+			break
+		}
+	}
+	return out.Sync()
+}
+
+// verifyCopy compares the SHA-256 of the source and the copy
+// Kaynağın ve kopyanın SHA-256 özetlerini karşılaştırır
+func verifyCopy(src, dst string) error {
+	srcHash, err := fileHash(src)
+	if err != nil {
+		return err
+	}
+	dstHash, err := fileHash(dst)
+	if err != nil {
+		return err
+	}
+	if srcHash != dstHash {
+		return fmt.Errorf("checksum mismatch after copying %s", src)
+	}
+	return nil
+}
+
+// fileHash returns the SHA-256 of a whole file
+// Bir dosyanın tamamının SHA-256 özetini döndürür
+func fileHash(filePath string) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
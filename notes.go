@@ -0,0 +1,149 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+)
+
+// Limits of the notes and tags of a job
+// Bir işin notlarının ve etiketlerinin sınırları
+const (
+	maxNoteLength = 4000
+	maxTags       = 20
+	maxTagLength  = 50
+)
+
+// HistoryQuery struct
+// Represents a search of the conversion history, empty fields match everything
+// Dönüştürme geçmişinde bir aramayı temsil eder, boş alanlar her şeyle eşleşir
+type HistoryQuery struct {
+	Text   string `json:"text"`   // Part of the paths or notes, case-insensitive / Yolların veya notların bir parçası, büyük/küçük harf duyarsız
+	Tag    string `json:"tag"`    // Tag the entry must carry / Kaydın taşıması gereken etiket
+	Status string `json:"status"` // completed or failed / completed veya failed
+}
+
+// SetJobNotes stores the notes and tags of a job on the queue item and its history entries
+// Jobs of an earlier session only live on in the history, which is updated alone then
+// Önceki bir oturumun işleri yalnızca geçmişte yaşar, o zaman yalnızca geçmiş güncellenir
+func (a *App) SetJobNotes(jobID, notes string, tags []string) error {
+	notes = strings.TrimSpace(notes)
+	if len([]rune(notes)) > maxNoteLength {
+		return fmt.Errorf("notes must be at most %d characters", maxNoteLength)
+	}
+	tags, err := normalizeTags(tags)
+	if err != nil {
+		return err
+	}
+
+	queued := a.jobs.Update(jobID, func(job *Job) {
+		job.Notes, job.Tags = notes, tags
+	})
+
+	a.historyMu.Lock()
+	defer a.historyMu.Unlock()
+	entries, err := a.loadHistory()
+	if err != nil && !errors.Is(err, errHistoryBackedUp) {
+		return err
+	}
+	recorded := false
+	for i := range entries {
+		if entries[i].JobID == jobID {
+			entries[i].Notes, entries[i].Tags = notes, tags
+			recorded = true
+		}
+	}
+	if !queued && !recorded {
+		return fmt.Errorf("unknown job: %s", jobID)
+	}
+	if recorded {
+		if err := a.writeHistory(entries); err != nil {
+			return fmt.Errorf("failed to save history: %v", err)
+		}
+	}
+	log.Printf("Updated notes of job %s", jobID)
+	return nil
+}
+
+// SearchHistory returns the history entries matching the query, oldest first
+// Sorguyla eşleşen geçmiş kayıtlarını en eskiden başlayarak döndürür
+func (a *App) SearchHistory(query HistoryQuery) ([]HistoryEntry, error) {
+	history, err := a.GetHistory()
+	if err != nil {
+		return nil, err
+	}
+	text := strings.ToLower(strings.TrimSpace(query.Text))
+	matches := []HistoryEntry{}
+	for _, entry := range history {
+		if query.Status != "" && entry.Status != query.Status {
+			continue
+		}
+		if query.Tag != "" && !hasTag(entry.Tags, query.Tag) {
+			continue
+		}
+		if text != "" && !strings.Contains(strings.ToLower(entry.InputPath+"\n"+entry.OutputPath+"\n"+entry.Notes), text) {
+			continue
+		}
+		matches = append(matches, entry)
+	}
+	return matches, nil
+}
+
+// GetTags returns every tag used in the queue and the history, sorted
+// Kuyrukta ve geçmişte kullanılan tüm etiketleri sıralı döndürür
+func (a *App) GetTags() []string {
+	seen := make(map[string]bool)
+	tags := []string{}
+	add := func(list []string) {
+		for _, tag := range list {
+			if key := strings.ToLower(tag); !seen[key] {
+				seen[key] = true
+				tags = append(tags, tag)
+			}
+		}
+	}
+	for _, job := range a.GetJobs() {
+		add(job.Tags)
+	}
+	// An unreadable history only hides its tags, loadHistory already logged why
+	// Okunamayan bir geçmiş yalnızca etiketlerini gizler, loadHistory nedenini zaten logladı
+	history, _ := a.GetHistory()
+	for _, entry := range history {
+		add(entry.Tags)
+	}
+	sort.Slice(tags, func(i, j int) bool { return strings.ToLower(tags[i]) < strings.ToLower(tags[j]) })
+	return tags
+}
+
+// normalizeTags trims the tags and drops empty and repeated ones, the first spelling wins
+// Etiketleri kırpar, boş ve tekrarlananları atar, ilk yazım kalır
+func normalizeTags(tags []string) ([]string, error) {
+	normalized := []string{}
+	for _, tag := range tags {
+		tag = strings.Join(strings.Fields(tag), " ")
+		if tag == "" || hasTag(normalized, tag) {
+			continue
+		}
+		if len([]rune(tag)) > maxTagLength {
+			return nil, fmt.Errorf("tag %q is longer than %d characters", tag, maxTagLength)
+		}
+		normalized = append(normalized, tag)
+	}
+	if len(normalized) > maxTags {
+		return nil, fmt.Errorf("at most %d tags are allowed", maxTags)
+	}
+	return normalized, nil
+}
+
+// hasTag reports whether a tag is in the list, case-insensitively
+// Bir etiketin listede olup olmadığını büyük/küçük harf duyarsız bildirir
+func hasTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if strings.EqualFold(t, tag) {
+			return true
+		}
+	}
+	return false
+}
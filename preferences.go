@@ -0,0 +1,236 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"net/url"
+	"path/filepath"
+	"strings"
+)
+
+// AppPreferences struct
+// Represents application-wide behaviour settings
+// Uygulama genelindeki davranış ayarlarını temsil eder
+type AppPreferences struct {
+	ProbeTimeout      int    `json:"probeTimeout"`      // FFprobe timeout in seconds / Saniye cinsinden FFprobe zaman aşımı
+	JobTimeout        int    `json:"jobTimeout"`        // Conversion timeout in minutes, 0 for none / Dakika cinsinden dönüştürme zaman aşımı, 0 sınırsız
+	StallTimeout      int    `json:"stallTimeout"`      // Minutes without progress before a job counts as stalled, 0 disables / İşin takılmış sayılması için ilerlemesiz dakika, 0 kapalı
+	StallRecovery     bool   `json:"stallRecovery"`     // Kill and retry stalled jobs / Takılan işleri sonlandır ve yeniden dene
+	StallRetries      int    `json:"stallRetries"`      // Retries for a stalled job / Takılan bir iş için yeniden deneme sayısı
+	KeepPartialOutput bool   `json:"keepPartialOutput"` // Keep the output of failed conversions / Başarısız dönüştürmelerin çıktısını koru
+	LogRetentionDays  int    `json:"logRetentionDays"`  // Days job logs are kept, 0 for no limit / İş loglarının saklandığı gün, 0 sınırsız
+	LogRetentionFiles int    `json:"logRetentionFiles"` // Job log folders kept, 0 for no limit / Saklanan iş log klasörü sayısı, 0 sınırsız
+	LogRetentionMB    int    `json:"logRetentionMB"`    // Total job log size in MB, 0 for no limit / MB cinsinden toplam iş log boyutu, 0 sınırsız
+	StageOutputs      bool   `json:"stageOutputs"`      // Encode locally, then copy to the destination / Yerelde kodla, ardından hedefe kopyala
+	StagingFolder     string `json:"stagingFolder"`     // Local folder for staged outputs, empty for the working folder / Hazırlanan çıktılar için yerel klasör, boşsa çalışma klasörü
+	WorkingFolder     string `json:"workingFolder"`     // Scratch folder for temporary encodes, chunks and samples, empty for the temp folder / Geçici kodlamalar, parçalar ve örnekler için çalışma klasörü, boşsa geçici klasör
+	MinWorkingSpaceGB int    `json:"minWorkingSpaceGB"` // Free space kept on the working folder, 0 to skip the check / Çalışma klasöründe boş bırakılan alan, 0 kontrolü atlar
+	MemoryBudgetMB    int    `json:"memoryBudgetMB"`    // Memory concurrent jobs may use, 0 for 75% of RAM, -1 for no limit / Eşzamanlı işlerin kullanabileceği bellek, 0 RAM'in %75'i, -1 sınırsız
+	ParallelJobs      int    `json:"parallelJobs"`      // Jobs encoded at once, 0 for one, the calibration recommends a value / Aynı anda kodlanan işler, 0 bir, kalibrasyon bir değer önerir
+	CopyRetries       int    `json:"copyRetries"`       // Retries of the copy stage / Kopyalama aşamasının yeniden deneme sayısı
+	DownloadURLInputs bool   `json:"downloadURLInputs"` // Download URL inputs before encoding / URL girişlerini kodlamadan önce indir
+	ProgressEventRate int    `json:"progressEventRate"` // Progress events per second, 0 for no limit / Saniyedeki ilerleme olayı, 0 sınırsız
+	EnergyEstimation  bool   `json:"energyEstimation"`  // Estimate the energy of every encode / Her kodlamanın enerjisini tahmin et
+	CPUWatts          int    `json:"cpuWatts"`          // CPU TDP for the CPU time estimate, 0 for the default / CPU süresi tahmini için CPU TDP değeri, 0 varsayılan
+	ThermalLimit      int    `json:"thermalLimit"`      // CPU °C that holds new encodes back and suspends running ones, 0 to disable / Yeni kodlamaları bekleten ve çalışanları askıya alan CPU °C değeri, 0 kapalı
+	ThermalResume     int    `json:"thermalResume"`     // CPU °C paused encodes resume at, 0 for 10 below the limit / Duraklatılan kodlamaların devam ettiği CPU °C değeri, 0 sınırın 10 altı
+
+	BackgroundPriority      string `json:"backgroundPriority"`      // normal, low or idle priority of bulk probing, thumbnails and analysis, empty for low / Toplu inceleme, küçük resim ve analiz önceliği normal, low veya idle, boşsa low
+	PauseBackgroundOnEncode bool   `json:"pauseBackgroundOnEncode"` // Hold that work while an encode runs / Bir kodlama çalışırken bu işi beklet
+
+	DestinationWatermarks []DestinationWatermark `json:"destinationWatermarks"` // Free space destinations keep, the queue pauses below it / Hedeflerin koruduğu boş alan, altında kuyruk duraklar
+
+	EncoderMode          string `json:"encoderMode"`          // balanced picks SVT-AV1 or a hardware encoder per job, empty keeps the settings / balanced her iş için SVT-AV1 veya donanım kodlayıcı seçer, boşsa ayarlar korunur
+	BalancedMinHeight    int    `json:"balancedMinHeight"`    // Source height from which hardware is used, 0 for 2160 / Donanımın kullanıldığı kaynak yüksekliği, 0 ise 2160
+	BalancedQueueLength  int    `json:"balancedQueueLength"`  // Queued jobs from which hardware is used, 0 for 10 / Donanımın kullanıldığı kuyruktaki iş sayısı, 0 ise 10
+	BalancedQualityFloor int    `json:"balancedQualityFloor"` // Jobs below this CRF stay on SVT-AV1, 0 for no floor / Bu CRF altındaki işler SVT-AV1'de kalır, 0 sınırsız
+
+	LibraryRefreshURL    string `json:"libraryRefreshURL"`    // Media server URL called after a replacement / Değiştirmeden sonra çağrılan medya sunucusu URL'si
+	LibraryRefreshMethod string `json:"libraryRefreshMethod"` // HTTP method of the refresh call, empty for POST / Yenileme çağrısının HTTP yöntemi, boşsa POST
+
+	APIEnabled      bool   `json:"apiEnabled"`      // Serve the local HTTP API / Yerel HTTP API'sini sun
+	APIAddress      string `json:"apiAddress"`      // Address the API listens on / API'nin dinlediği adres
+	APIKey          string `json:"apiKey"`          // Key every API request must send / Her API isteğinin göndermesi gereken anahtar
+	GRPCAddress     string `json:"grpcAddress"`     // Address the gRPC service listens on, empty for HTTP only / gRPC hizmetinin dinlediği adres, yalnızca HTTP için boş
+	ArrProfile      string `json:"arrProfile"`      // Platform profile for Sonarr/Radarr imports, empty for the current settings / Sonarr/Radarr içe aktarmaları için platform profili, boşsa geçerli ayarlar
+	ArrOutputFolder string `json:"arrOutputFolder"` // Folder for imported files, empty for next to the source / İçe aktarılan dosyalar için klasör, boşsa kaynağın yanı
+
+	WatchFolders    []WatchFolder `json:"watchFolders"`    // Folders whose new files are queued automatically / Yeni dosyaları otomatik kuyruğa eklenen klasörler
+	VideoExtensions []string      `json:"videoExtensions"` // Extensions accepted as video inputs, empty for the defaults / Video girişi olarak kabul edilen uzantılar, boşsa varsayılanlar
+	AudioExtensions []string      `json:"audioExtensions"` // Extensions accepted as audio inputs, empty for the defaults / Ses girişi olarak kabul edilen uzantılar, boşsa varsayılanlar
+
+	TelemetryEnabled bool   `json:"telemetryEnabled"` // Send anonymous usage statistics, off unless turned on / Anonim kullanım istatistikleri gönder, açılmadıkça kapalı
+	TelemetryURL     string `json:"telemetryURL"`     // Where usage statistics are sent / Kullanım istatistiklerinin gönderildiği yer
+}
+
+// defaultPreferences returns the preferences used before any are saved
+// Hiçbir tercih kaydedilmeden önce kullanılan tercihleri döndürür
+func defaultPreferences() AppPreferences {
+	return AppPreferences{
+		ProbeTimeout: 30,
+		StallTimeout: 5,
+		StallRetries: 1,
+
+		LogRetentionDays: 1,
+
+		CopyRetries:       3,
+		MinWorkingSpaceGB: defaultMinWorkingSpaceGB,
+
+		DownloadURLInputs: true,
+		ProgressEventRate: 4,
+
+		APIAddress:  defaultAPIAddress,
+		GRPCAddress: defaultGRPCAddress,
+	}
+}
+
+// GetPreferences returns the current application preferences without the API key
+// Retrieves the application-wide behaviour settings, GetSecretStorage tells whether the key is set
+// Uygulama genelindeki davranış ayarlarını alır, anahtarın ayarlı olup olmadığını GetSecretStorage söyler
+func (a *App) GetPreferences() AppPreferences {
+	preferences := a.currentPreferences()
+	preferences.APIKey = ""
+	return preferences
+}
+
+// currentPreferences returns a copy of the preferences including the API key, for the backend only
+// API anahtarı dahil tercihlerin bir kopyasını döndürür, yalnızca arka uç için
+func (a *App) currentPreferences() AppPreferences {
+	a.configMu.RLock()
+	defer a.configMu.RUnlock()
+	return a.preferences
+}
+
+// updatePreferences changes the preferences in place under the lock
+// Tercihleri kilit altında yerinde değiştirir
+func (a *App) updatePreferences(update func(preferences *AppPreferences)) {
+	a.configMu.Lock()
+	defer a.configMu.Unlock()
+	update(&a.preferences)
+}
+
+// SavePreferences validates and stores the application preferences
+// Rejects invalid preferences and persists valid ones to the config file, an empty API key keeps the stored one
+// Geçersiz tercihleri reddeder ve geçerli olanları yapılandırma dosyasına kaydeder, boş bir API anahtarı kayıtlı olanı korur
+func (a *App) SavePreferences(preferences AppPreferences) error {
+	if preferences.APIKey == "" {
+		preferences.APIKey = a.currentPreferences().APIKey
+	}
+	if err := validatePreferences(preferences); err != nil {
+		log.Printf("Rejected preferences: %v", err)
+		return err
+	}
+	a.updatePreferences(func(current *AppPreferences) { *current = preferences })
+	a.slots.limitChanged()
+	a.saveConfig()
+
+	// Apply watch folder and API changes right away
+	// İzleme klasörü ve API değişikliklerini hemen uygula
+	a.stopWatchFolders()
+	a.startWatchFolders()
+	a.stopAPI()
+	return a.startAPI()
+}
+
+// validatePreferences checks the preference values
+// Tercih değerlerini kontrol eder
+func validatePreferences(preferences AppPreferences) error {
+	if preferences.ProbeTimeout < 1 || preferences.ProbeTimeout > 3600 {
+		return fmt.Errorf("probe timeout must be between 1 and 3600 seconds")
+	}
+	if preferences.JobTimeout < 0 {
+		return fmt.Errorf("job timeout must not be negative")
+	}
+	if preferences.StallTimeout < 0 || preferences.StallRetries < 0 {
+		return fmt.Errorf("stall timeout and retries must not be negative")
+	}
+	if preferences.LogRetentionDays < 0 || preferences.LogRetentionFiles < 0 || preferences.LogRetentionMB < 0 {
+		return fmt.Errorf("log retention limits must not be negative")
+	}
+	if preferences.ProgressEventRate < 0 || preferences.ProgressEventRate > 60 {
+		return fmt.Errorf("progress event rate must be between 0 and 60 per second")
+	}
+	if preferences.CPUWatts < 0 || preferences.CPUWatts > 1000 {
+		return fmt.Errorf("CPU watts must be between 0 and 1000")
+	}
+	if preferences.ThermalLimit != 0 && (preferences.ThermalLimit < 40 || preferences.ThermalLimit > 110) {
+		return fmt.Errorf("thermal limit must be between 40 and 110 °C")
+	}
+	if preferences.ThermalResume < 0 || (preferences.ThermalResume > 0 && preferences.ThermalResume >= preferences.ThermalLimit) {
+		return fmt.Errorf("thermal resume temperature must be below the thermal limit")
+	}
+	if _, ok := backgroundPriorities[preferences.BackgroundPriority]; !ok {
+		return fmt.Errorf("background priority must be normal, low or idle")
+	}
+	if preferences.CopyRetries < 0 {
+		return fmt.Errorf("copy retries must not be negative")
+	}
+	if preferences.StagingFolder != "" && !filepath.IsAbs(preferences.StagingFolder) {
+		return fmt.Errorf("staging folder must be an absolute path")
+	}
+	if preferences.WorkingFolder != "" && !filepath.IsAbs(preferences.WorkingFolder) {
+		return fmt.Errorf("working folder must be an absolute path")
+	}
+	if preferences.MinWorkingSpaceGB < 0 {
+		return fmt.Errorf("working folder reserve must not be negative")
+	}
+	if err := validateDestinationWatermarks(preferences.DestinationWatermarks); err != nil {
+		return err
+	}
+	if err := validateEncoderMode(preferences); err != nil {
+		return err
+	}
+	if preferences.MemoryBudgetMB < -1 {
+		return fmt.Errorf("memory budget must be -1, 0 or a size in MB")
+	}
+	if preferences.ParallelJobs < 0 || preferences.ParallelJobs > maxParallelJobs {
+		return fmt.Errorf("parallel jobs must be between 0 and %d", maxParallelJobs)
+	}
+	if preferences.LibraryRefreshURL != "" {
+		refreshURL, err := url.Parse(strings.ReplaceAll(preferences.LibraryRefreshURL, "{path}", ""))
+		if err != nil || (refreshURL.Scheme != "http" && refreshURL.Scheme != "https") || refreshURL.Host == "" {
+			return fmt.Errorf("library refresh URL must be an http or https URL")
+		}
+	}
+	if preferences.APIEnabled {
+		if _, _, err := net.SplitHostPort(preferences.APIAddress); err != nil {
+			return fmt.Errorf("API address must be host:port: %v", err)
+		}
+		if len(preferences.APIKey) < minAPIKeyLength {
+			return fmt.Errorf("API key must be at least %d characters", minAPIKeyLength)
+		}
+		if err := validGRPCAddress(preferences); err != nil {
+			return err
+		}
+	}
+	if preferences.ArrProfile != "" {
+		if _, ok := findPlatformProfile(preferences.ArrProfile); !ok {
+			return fmt.Errorf("unknown profile: %s", preferences.ArrProfile)
+		}
+	}
+	if preferences.ArrOutputFolder != "" && !filepath.IsAbs(preferences.ArrOutputFolder) {
+		return fmt.Errorf("Sonarr/Radarr output folder must be an absolute path")
+	}
+	if err := validateWatchFolders(preferences.WatchFolders); err != nil {
+		return err
+	}
+	if err := validateExtensions("video", preferences.VideoExtensions); err != nil {
+		return err
+	}
+	if err := validateExtensions("audio", preferences.AudioExtensions); err != nil {
+		return err
+	}
+	if preferences.TelemetryEnabled {
+		telemetryURL, err := url.Parse(preferences.TelemetryURL)
+		if err != nil || telemetryURL.Scheme != "https" || telemetryURL.Host == "" {
+			return fmt.Errorf("usage statistics URL must be an https URL")
+		}
+	}
+	switch preferences.LibraryRefreshMethod {
+	case "", "GET", "POST", "PUT":
+	default:
+		return fmt.Errorf("library refresh method must be GET, POST or PUT")
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	goruntime "runtime"
+	"sync"
+	"time"
+
+	"AV1-video-converter/internal/probe"
+	"AV1-video-converter/internal/runner"
+)
+
+// maxProbeWorkers caps the number of concurrent FFprobe processes
+// Eşzamanlı FFprobe işlemlerinin sayısını sınırlar
+const maxProbeWorkers = 8
+
+// probeFiles probes the files with a bounded worker pool
+// Emits "file:probed" for each file so the list fills progressively, results keep the input order
+// Liste kademeli dolsun diye her dosya için "file:probed" yayar, sonuçlar giriş sırasını korur
+func (a *App) probeFiles(files []string) []VideoInfo {
+	workers := goruntime.NumCPU()
+	if workers > maxProbeWorkers {
+		workers = maxProbeWorkers
+	}
+
+	// Bulk probing yields to the running encodes
+	// Toplu inceleme çalışan kodlamalara yol verir
+	ctx := a.backgroundContext(a.baseContext())
+	results := make([]*VideoInfo, len(files))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer a.recoverCrash("probeFiles")
+			for i := range indexes {
+				file := files[i]
+				log.Printf("Processing file: %s", file)
+				if _, err := a.statWithTimeout(file); err != nil {
+					log.Printf("File is not accessible: %s: %v", file, err)
+					a.events.Emit("file:probe-error", map[string]interface{}{
+						"path":  file,
+						"error": err.Error(),
+					})
+					continue
+				}
+				info, err := a.probeVideoInfo(ctx, file)
+				if err != nil {
+					log.Printf("Error getting info for %s: %v", file, err)
+					a.events.Emit("file:probe-error", map[string]interface{}{
+						"path":  file,
+						"error": err.Error(),
+					})
+					continue
+				}
+				results[i] = &info
+				a.events.Emit("file:probed", info)
+				log.Printf("Successfully processed file: %s", file)
+			}
+		}()
+	}
+	for i := range files {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	a.probeCache.save()
+
+	videoInfos := make([]VideoInfo, 0, len(files))
+	for _, info := range results {
+		if info != nil {
+			videoInfos = append(videoInfos, *info)
+		}
+	}
+	return videoInfos
+}
+
+// probeFile runs FFprobe bounded by the probe timeout and ctx
+// A probe against a dead network share is killed instead of hanging forever
+// Ölü bir ağ paylaşımına yapılan inceleme sonsuza kadar asılı kalmak yerine sonlandırılır
+func (a *App) probeFile(ctx context.Context, filePath string) (probe.Result, error) {
+	if err := a.waitForEncodes(ctx); err != nil {
+		return probe.Result{}, fmt.Errorf("FFprobe cancelled")
+	}
+	timeout := time.Duration(a.currentPreferences().ProbeTimeout) * time.Second
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	result, err := a.prober.Probe(ctx, filePath)
+	if err != nil {
+		log.Printf("FFprobe failed for %s: %v", filePath, err)
+		switch {
+		case errors.Is(ctx.Err(), context.DeadlineExceeded):
+			return probe.Result{}, fmt.Errorf("FFprobe timed out after %s", timeout)
+		case ctx.Err() != nil:
+			return probe.Result{}, fmt.Errorf("FFprobe cancelled")
+		}
+		var runErr *runner.Error
+		if errors.As(err, &runErr) {
+			log.Printf("FFprobe stderr: %s", runErr.Stderr)
+			return probe.Result{}, fmt.Errorf("FFprobe error: %v, stderr: %s", runErr.Err, runErr.Stderr)
+		}
+		return probe.Result{}, fmt.Errorf("FFprobe error: %v", err)
+	}
+	return result, nil
+}
+
+// statWithTimeout stats a file without blocking on an unreachable share
+// Erişilemeyen bir paylaşımda takılmadan bir dosyanın bilgilerini alır
+func (a *App) statWithTimeout(filePath string) (os.FileInfo, error) {
+	type statResult struct {
+		info os.FileInfo
+		err  error
+	}
+	done := make(chan statResult, 1)
+	go func() {
+		info, err := os.Stat(filePath)
+		done <- statResult{info, err}
+	}()
+
+	timeout := time.Duration(a.currentPreferences().ProbeTimeout) * time.Second
+	select {
+	case result := <-done:
+		return result.info, result.err
+	case <-time.After(timeout):
+		return nil, fmt.Errorf("accessing %s timed out after %s", filePath, timeout)
+	case <-a.baseContext().Done():
+		return nil, fmt.Errorf("cancelled")
+	}
+}
+
+// baseContext returns the app context cancelled on shutdown, or a background context before startup
+// Kapanışta iptal edilen uygulama bağlamını, başlangıçtan önce ise arka plan bağlamını döndürür
+func (a *App) baseContext() context.Context {
+	if a.appCtx == nil {
+		return context.Background()
+	}
+	return a.appCtx
+}
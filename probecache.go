@@ -0,0 +1,127 @@
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// maxProbeCacheEntries caps the number of cached probe results
+// Önbelleğe alınan inceleme sonuçlarının sayısını sınırlar
+const maxProbeCacheEntries = 20000
+
+// probeCacheEntry struct
+// Represents a cached FFprobe result and the file state it belongs to
+// Önbelleğe alınmış bir FFprobe sonucunu ve ait olduğu dosya durumunu temsil eder
+type probeCacheEntry struct {
+	Size     int64     `json:"size"`     // File size when probed / İncelendiğindeki dosya boyutu
+	ModTime  time.Time `json:"modTime"`  // Modification time when probed / İncelendiğindeki değiştirme zamanı
+	LastUsed time.Time `json:"lastUsed"` // Last cache hit / Son önbellek isabeti
+	Info     VideoInfo `json:"info"`     // Probe result / İnceleme sonucu
+}
+
+// probeCache struct
+// Keeps FFprobe results on disk keyed by path, size and modification time
+// FFprobe sonuçlarını yol, boyut ve değiştirme zamanına göre diskte tutar
+type probeCache struct {
+	mu      sync.Mutex
+	path    string
+	entries map[string]probeCacheEntry
+	dirty   bool
+}
+
+// newProbeCache loads the cache file, starting empty if it can't be read
+// Önbellek dosyasını yükler, okunamazsa boş başlar
+func newProbeCache(path string) *probeCache {
+	cache := &probeCache{path: path, entries: make(map[string]probeCacheEntry)}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Printf("Error reading probe cache: %v", err)
+		}
+		return cache
+	}
+	if err := json.Unmarshal(data, &cache.entries); err != nil {
+		log.Printf("Error unmarshalling probe cache: %v", err)
+		cache.entries = make(map[string]probeCacheEntry)
+	}
+	log.Printf("Loaded %d probe cache entries", len(cache.entries))
+	return cache
+}
+
+// get returns the cached result if the file hasn't changed since
+// Dosya o zamandan beri değişmediyse önbellekteki sonucu döndürür
+func (c *probeCache) get(filePath string, stat os.FileInfo) (VideoInfo, bool) {
+	if c == nil {
+		return VideoInfo{}, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[filePath]
+	if !ok || entry.Size != stat.Size() || !entry.ModTime.Equal(stat.ModTime()) {
+		return VideoInfo{}, false
+	}
+	entry.LastUsed = time.Now()
+	c.entries[filePath] = entry
+	c.dirty = true
+	return entry.Info, true
+}
+
+// put stores a probe result for the current file state
+// Geçerli dosya durumu için bir inceleme sonucunu saklar
+func (c *probeCache) put(filePath string, stat os.FileInfo, info VideoInfo) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[filePath] = probeCacheEntry{
+		Size:     stat.Size(),
+		ModTime:  stat.ModTime(),
+		LastUsed: time.Now(),
+		Info:     info,
+	}
+	c.dirty = true
+}
+
+// save writes the cache to disk, dropping the least recently used entries over the limit
+// Önbelleği diske yazar, sınırı aşan en az kullanılan kayıtları atar
+func (c *probeCache) save() {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.dirty {
+		return
+	}
+
+	if len(c.entries) > maxProbeCacheEntries {
+		paths := make([]string, 0, len(c.entries))
+		for path := range c.entries {
+			paths = append(paths, path)
+		}
+		sort.Slice(paths, func(i, j int) bool {
+			return c.entries[paths[i]].LastUsed.Before(c.entries[paths[j]].LastUsed)
+		})
+		for _, path := range paths[:len(paths)-maxProbeCacheEntries] {
+			delete(c.entries, path)
+		}
+	}
+
+	data, err := json.Marshal(c.entries)
+	if err != nil {
+		log.Printf("Error marshalling probe cache: %v", err)
+		return
+	}
+	if err := os.WriteFile(c.path, data, 0644); err != nil {
+		log.Printf("Error writing probe cache: %v", err)
+		return
+	}
+	c.dirty = false
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"fmt"
+	"log"
+)
+
+// PlatformProfile struct
+// Represents the upload limits of a target platform
+// Bir hedef platformun yükleme sınırlarını temsil eder
+type PlatformProfile struct {
+	ID           string `json:"id"`           // Profile identifier / Profil tanımlayıcısı
+	Name         string `json:"name"`         // Display name / Görünen ad
+	Container    string `json:"container"`    // Required container / Gerekli kapsayıcı
+	MaxWidth     int    `json:"maxWidth"`     // Maximum width / En büyük genişlik
+	MaxHeight    int    `json:"maxHeight"`    // Maximum height / En büyük yükseklik
+	MaxBitrate   int    `json:"maxBitrate"`   // Maximum video bitrate in kbps / Kbps cinsinden en yüksek video bit hızı
+	MaxFileSize  int    `json:"maxFileSize"`  // Maximum file size in MB / MB cinsinden en büyük dosya boyutu
+	AudioCodec   string `json:"audioCodec"`   // Required audio codec / Gerekli ses kodeki
+	AudioBitrate int    `json:"audioBitrate"` // Audio bitrate in kbps / Kbps cinsinden ses bit hızı
+}
+
+// ProfileResult struct
+// Represents settings adjusted to a platform profile for a source
+// Bir kaynak için platform profiline göre ayarlanmış ayarları temsil eder
+type ProfileResult struct {
+	Settings ConversionSettings `json:"settings"` // Adjusted settings / Ayarlanmış ayarlar
+	Warnings []string           `json:"warnings"` // Problems meeting the limits / Sınırlara uyma sorunları
+}
+
+// platformProfiles lists the built-in platform profiles
+// Yerleşik platform profillerini listeler
+var platformProfiles = []PlatformProfile{
+	{ID: "youtube", Name: "YouTube", Container: "mp4", MaxWidth: 7680, MaxHeight: 4320, AudioCodec: "aac", AudioBitrate: 384},
+	{ID: "vimeo", Name: "Vimeo", Container: "mp4", MaxWidth: 7680, MaxHeight: 4320, AudioCodec: "aac", AudioBitrate: 320},
+	{ID: "telegram", Name: "Telegram", Container: "mp4", MaxWidth: 1920, MaxHeight: 1080, MaxFileSize: 2000, AudioCodec: "aac", AudioBitrate: 128},
+	{ID: "discord", Name: "Discord", Container: "webm", MaxWidth: 1280, MaxHeight: 720, MaxFileSize: 10, AudioCodec: "libopus", AudioBitrate: 96},
+}
+
+// minimumVideoBitrate is the lowest useful bitrate in kbps for a size target
+// Bir boyut hedefi için kullanışlı en düşük bit hızıdır (kbps)
+const minimumVideoBitrate = 150
+
+// GetPlatformProfiles returns the built-in platform profiles
+// Lists the profiles the frontend can offer
+// Ön yüzün sunabileceği profilleri listeler
+func (a *App) GetPlatformProfiles() []PlatformProfile {
+	return platformProfiles
+}
+
+// ApplyPlatformProfile adjusts the current settings to a platform's limits
+// Returns the adjusted settings and warnings when the source can't meet them
+// Ayarlanmış ayarları ve kaynak sınırlara uyamıyorsa uyarıları döndürür
+func (a *App) ApplyPlatformProfile(profileID string, video VideoInfo) (ProfileResult, error) {
+	profile, ok := findPlatformProfile(profileID)
+	if !ok {
+		return ProfileResult{}, fmt.Errorf("unknown platform profile: %s", profileID)
+	}
+
+	result := ProfileResult{Settings: applyPlatformProfile(a.GetSettings(), profile)}
+	result.Warnings = profileWarnings(profile, result.Settings, video)
+	for _, warning := range result.Warnings {
+		log.Printf("Profile %s warning for %s: %s", profile.ID, video.FullPath, warning)
+	}
+	return result, nil
+}
+
+// findPlatformProfile looks up a built-in profile by identifier
+// Yerleşik bir profili tanımlayıcısına göre bulur
+func findPlatformProfile(id string) (PlatformProfile, bool) {
+	for _, profile := range platformProfiles {
+		if profile.ID == id {
+			return profile, true
+		}
+	}
+	return PlatformProfile{}, false
+}
+
+// applyPlatformProfile enforces the profile limits on the settings
+// Profil sınırlarını ayarlara uygular
+func applyPlatformProfile(settings ConversionSettings, profile PlatformProfile) ConversionSettings {
+	settings.Container = profile.Container
+	settings.MaxWidth = profile.MaxWidth
+	settings.MaxHeight = profile.MaxHeight
+	settings.MaxBitrate = profile.MaxBitrate
+	settings.MaxFileSize = profile.MaxFileSize
+	settings.AudioCodec = profile.AudioCodec
+	settings.AudioBitrate = profile.AudioBitrate
+	return settings
+}
+
+// profileWarnings explains which limits the source can't meet as-is
+// Kaynağın olduğu gibi karşılayamadığı sınırları açıklar
+func profileWarnings(profile PlatformProfile, settings ConversionSettings, video VideoInfo) []string {
+	var warnings []string
+
+	if (profile.MaxWidth > 0 && video.Width > profile.MaxWidth) || (profile.MaxHeight > 0 && video.Height > profile.MaxHeight) {
+		warnings = append(warnings, fmt.Sprintf("%dx%d source will be downscaled to fit %dx%d",
+			video.Width, video.Height, profile.MaxWidth, profile.MaxHeight))
+	}
+
+	if profile.MaxFileSize > 0 {
+		if video.DurationSeconds <= 0 {
+			warnings = append(warnings, "source duration is unknown, the file size limit can't be guaranteed")
+		} else if rate := videoBitrateCap(settings, video.DurationSeconds); rate < minimumVideoBitrate {
+			warnings = append(warnings, fmt.Sprintf("source is too long for %d MB: only %d kbps left for video, quality will be very poor",
+				profile.MaxFileSize, rate))
+		}
+	}
+
+	return warnings
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"os/exec"
+	"regexp"
+	"strconv"
+
+	"AV1-video-converter/internal/runner"
+)
+
+// vmafScoreRegex matches the score printed by the libvmaf filter
+// libvmaf filtresinin yazdırdığı puanla eşleşir
+var vmafScoreRegex = regexp.MustCompile(`VMAF score:\s*([\d.]+)`)
+
+// measureVMAF compares an encoded file against its reference
+// Requires an FFmpeg build with libvmaf
+// libvmaf içeren bir FFmpeg yapısı gerektirir
+func (a *App) measureVMAF(distortedPath, referencePath string) (float64, error) {
+	// The distorted input comes first for libvmaf
+	// libvmaf için bozulmuş giriş önce gelir
+	cmd := exec.Command(a.ffmpegPath,
+		"-hide_banner", "-nostats",
+		"-i", distortedPath,
+		"-i", referencePath,
+		"-lavfi", "[0:v]setpts=PTS-STARTPTS[d];[1:v]setpts=PTS-STARTPTS[r];[d][r]libvmaf=n_threads=4",
+		"-f", "null", "-")
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := runner.Run(cmd); err != nil {
+		log.Printf("Error measuring VMAF of %s: %v", distortedPath, err)
+		return 0, fmt.Errorf("VMAF measurement failed (is libvmaf available?): %v", err)
+	}
+
+	match := vmafScoreRegex.FindStringSubmatch(stderr.String())
+	if match == nil {
+		return 0, fmt.Errorf("VMAF score not found in FFmpeg output")
+	}
+	return strconv.ParseFloat(match[1], 64)
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"time"
+)
+
+// queueFileVersion is the format version of exported queue files
+// Dışa aktarılan kuyruk dosyalarının biçim sürümüdür
+const queueFileVersion = 1
+
+// QueueItem struct
+// Represents a queued video with its destination and settings
+// Hedefi ve ayarlarıyla birlikte kuyruktaki bir videoyu temsil eder
+type QueueItem struct {
+	Video        VideoInfo          `json:"video"`        // Source video / Kaynak video
+	OutputFolder string             `json:"outputFolder"` // Destination folder / Hedef klasör
+	Settings     ConversionSettings `json:"settings"`     // Conversion settings / Dönüştürme ayarları
+	Notes        string             `json:"notes"`        // Free text of the user / Kullanıcının serbest metni
+	Tags         []string           `json:"tags"`         // Labels of the user / Kullanıcının etiketleri
+}
+
+// QueueImportResult struct
+// Represents the items loaded from a queue file
+// Bir kuyruk dosyasından yüklenen öğeleri temsil eder
+type QueueImportResult struct {
+	Items   []QueueItem `json:"items"`   // Items ready to queue / Kuyruğa hazır öğeler
+	Skipped []string    `json:"skipped"` // Items that couldn't be loaded and why / Yüklenemeyen öğeler ve nedeni
+}
+
+// queueFile struct
+// Represents the contents of an exported queue file
+// Dışa aktarılan bir kuyruk dosyasının içeriğini temsil eder
+type queueFile struct {
+	Version    int         `json:"version"`
+	ExportedAt time.Time   `json:"exportedAt"`
+	Items      []QueueItem `json:"items"`
+}
+
+// ExportQueue writes the given queue items to a JSON file chosen by the user
+// Returns the path written, or an empty string if the dialog was cancelled
+// Yazılan yolu döndürür, iletişim kutusu iptal edildiyse boş metin döndürür
+func (a *App) ExportQueue(items []QueueItem) (string, error) {
+	path, err := a.saveFileDialog("Export Queue", "av1-queue.json",
+		fileFilter{name: "Queue Files", pattern: "*.json"})
+	if err != nil || path == "" {
+		return "", err
+	}
+
+	data, err := json.MarshalIndent(queueFile{
+		Version:    queueFileVersion,
+		ExportedAt: time.Now(),
+		Items:      items,
+	}, "", "  ")
+	if err != nil {
+		log.Printf("Error marshalling queue: %v", err)
+		return "", err
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		log.Printf("Error writing queue file: %v", err)
+		return "", fmt.Errorf("failed to write queue file: %v", err)
+	}
+
+	log.Printf("Exported %d queue items to %s", len(items), path)
+	return path, nil
+}
+
+// ImportQueue loads queue items from a JSON file chosen by the user
+// Sources are re-probed since the file may come from another machine
+// Dosya başka bir makineden gelebileceği için kaynaklar yeniden incelenir
+func (a *App) ImportQueue() (QueueImportResult, error) {
+	path, err := a.openFileDialog("Import Queue",
+		fileFilter{name: "Queue Files", pattern: "*.json"})
+	if err != nil || path == "" {
+		return QueueImportResult{}, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		log.Printf("Error reading queue file: %v", err)
+		return QueueImportResult{}, fmt.Errorf("failed to read queue file: %v", err)
+	}
+	var file queueFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		log.Printf("Error unmarshalling queue file: %v", err)
+		return QueueImportResult{}, fmt.Errorf("invalid queue file: %v", err)
+	}
+	if file.Version > queueFileVersion {
+		return QueueImportResult{}, fmt.Errorf("queue file version %d is newer than supported version %d", file.Version, queueFileVersion)
+	}
+
+	var result QueueImportResult
+	for _, item := range file.Items {
+		source := item.Video.FullPath
+		if errs := validateSettings(item.Settings); len(errs) > 0 {
+			result.Skipped = append(result.Skipped, fmt.Sprintf("%s: %v", source, errs))
+			continue
+		}
+		video, err := a.getVideoInfo(source)
+		if err != nil {
+			result.Skipped = append(result.Skipped, fmt.Sprintf("%s: %v", source, err))
+			continue
+		}
+		item.Video = video
+		result.Items = append(result.Items, item)
+	}
+
+	log.Printf("Imported %d queue items from %s, skipped %d", len(result.Items), path, len(result.Skipped))
+	return result, nil
+}
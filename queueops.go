@@ -0,0 +1,176 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"path/filepath"
+	"strings"
+
+	"AV1-video-converter/internal/events"
+)
+
+// queueSortKeys lists the keys queued jobs can be sorted by
+// Kuyruktaki işlerin sıralanabileceği anahtarları listeler
+var queueSortKeys = []string{"size", "duration", "eta", "name"}
+
+// BulkResult struct
+// Represents the outcome of an action on several jobs
+// Birden fazla iş üzerindeki bir eylemin sonucunu temsil eder
+type BulkResult struct {
+	Affected []string `json:"affected"` // Jobs the action changed / Eylemin değiştirdiği işler
+	Skipped  []string `json:"skipped"`  // Jobs left alone and why / Dokunulmayan işler ve nedeni
+}
+
+// SortQueue reorders the queued jobs by size, duration, estimated time or file name
+// Running and finished jobs keep their place
+// Çalışan ve biten işler yerlerini korur
+func (a *App) SortQueue(key string, descending bool) error {
+	if !containsString(queueSortKeys, key) {
+		return fmt.Errorf("sort key must be one of %s", strings.Join(queueSortKeys, ", "))
+	}
+
+	// Keys are read first, probing under the queue lock would block every job update
+	// Anahtarlar önce okunur, kuyruk kilidi altında incelemek her iş güncellemesini engellerdi
+	numbers := make(map[string]float64)
+	names := make(map[string]string)
+	for _, job := range a.GetJobs() {
+		if job.Status != "queued" {
+			continue
+		}
+		switch key {
+		case "size":
+			numbers[job.ID] = float64(fileSize(job.InputPath))
+		case "duration":
+			if info, err := a.getVideoInfo(job.InputPath); err == nil {
+				numbers[job.ID] = info.DurationSeconds
+			}
+		case "eta":
+			numbers[job.ID], _ = a.estimateEncodeSeconds(job.InputPath, job.Settings)
+		case "name":
+			names[job.ID] = strings.ToLower(filepath.Base(job.InputPath))
+		}
+	}
+
+	a.jobs.Sort(func(job *Job) bool {
+		return job.Status == "queued"
+	}, func(x, y *Job) bool {
+		if descending {
+			x, y = y, x
+		}
+		if key == "name" {
+			return names[x.ID] < names[y.ID]
+		}
+		return numbers[x.ID] < numbers[y.ID]
+	})
+	log.Printf("Sorted the queue by %s", key)
+
+	// The frontend keeps its own list, it reorders it from the new order
+	// Ön yüz kendi listesini tutar, onu yeni sıraya göre yeniden düzenler
+	sorted := QueueSortedEvent{Key: key, Descending: descending, JobIDs: []string{}}
+	for _, job := range a.GetJobs() {
+		if job.Status == "queued" {
+			sorted.JobIDs = append(sorted.JobIDs, job.ID)
+		}
+	}
+	events.Emit(a.events, events.QueueSorted, "", sorted)
+	a.emitQueueUpdated()
+	return nil
+}
+
+// RemoveFailedJobs forgets every failed job
+// Başarısız olan her işi unutur
+func (a *App) RemoveFailedJobs() BulkResult {
+	result := BulkResult{Affected: []string{}, Skipped: []string{}}
+	for _, job := range a.GetJobs() {
+		if job.Status != "failed" {
+			continue
+		}
+		if err := a.jobs.Remove(job.ID, func(job *Job) error {
+			if job.Status != "failed" {
+				return fmt.Errorf("job %s is %s", job.ID, job.Status)
+			}
+			return nil
+		}); err != nil {
+			result.Skipped = append(result.Skipped, err.Error())
+			continue
+		}
+		result.Affected = append(result.Affected, job.ID)
+	}
+	log.Printf("Removed %d failed jobs", len(result.Affected))
+	a.emitQueueUpdated()
+	return result
+}
+
+// RetryFailedJobs puts every failed job back into the queue
+// The jobs are announced again so the frontend queues them after its current list
+// İşler yeniden duyurulur, böylece ön yüz onları mevcut listesinin ardından kuyruğa ekler
+func (a *App) RetryFailedJobs() BulkResult {
+	result := BulkResult{Affected: []string{}, Skipped: []string{}}
+	for _, job := range a.GetJobs() {
+		if job.Status != "failed" {
+			continue
+		}
+		if err := a.requeueJob(job, "queued again"); err != nil {
+			result.Skipped = append(result.Skipped, fmt.Sprintf("job %s: %v", job.ID, err))
+			continue
+		}
+		result.Affected = append(result.Affected, job.ID)
+	}
+	log.Printf("Retrying %d failed jobs", len(result.Affected))
+	a.emitQueueUpdated()
+	return result
+}
+
+// requeueJob puts a failed job back into the queue and announces it again
+// Başarısız bir işi kuyruğa geri koyar ve yeniden duyurur
+func (a *App) requeueJob(job Job, reason string) error {
+	info, err := a.getVideoInfo(job.InputPath)
+	if err != nil {
+		return err
+	}
+	a.updateJob(job.ID, func(job *Job) {
+		job.Status, job.Error = "queued", ""
+	})
+	job.Status, job.Error = "queued", ""
+	a.addJobEvent(job.ID, "retry", reason)
+	a.emitJobEvent(events.JobAdded, job.ID, JobAddedEvent{Job: job, Video: &info})
+	return nil
+}
+
+// ApplyProfileToJobs applies a platform profile or archive-<mode> to the selected queued jobs
+// The profile changes only what it covers, the rest of each job's settings stays
+// Profil yalnızca kapsadığı şeyleri değiştirir, her işin ayarlarının geri kalanı kalır
+func (a *App) ApplyProfileToJobs(jobIDs []string, profile string) (BulkResult, error) {
+	if profile == "" {
+		return BulkResult{}, fmt.Errorf("no profile given")
+	}
+	if _, err := watchProfileSettings(a.GetSettings(), profile); err != nil {
+		return BulkResult{}, err
+	}
+
+	result := BulkResult{Affected: []string{}, Skipped: []string{}}
+	for _, jobID := range jobIDs {
+		var err error
+		found := a.jobs.Update(jobID, func(job *Job) {
+			if job.Status != "queued" && job.Status != "failed" {
+				err = fmt.Errorf("job %s is %s", jobID, job.Status)
+				return
+			}
+			var settings ConversionSettings
+			if settings, err = watchProfileSettings(job.Settings, profile); err == nil {
+				job.Settings = settings
+			}
+		})
+		if !found {
+			err = fmt.Errorf("unknown job: %s", jobID)
+		}
+		if err != nil {
+			result.Skipped = append(result.Skipped, err.Error())
+			continue
+		}
+		result.Affected = append(result.Affected, jobID)
+	}
+	log.Printf("Applied profile %s to %d jobs", profile, len(result.Affected))
+	a.emitQueueUpdated()
+	return result, nil
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"AV1-video-converter/internal/runner"
+)
+
+// rclonePrefix marks a destination handled by rclone, e.g. "rclone:gdrive:Videos"
+// rclone tarafından işlenen bir hedefi işaretler, örn. "rclone:gdrive:Videos"
+const rclonePrefix = "rclone:"
+
+// rcloneStatsRegex matches the one-line stats, e.g. "1.2 GiB / 2 GiB, 61%, 10.5 MiB/s, ETA 1m"
+// Tek satırlık istatistiklerle eşleşir
+var rcloneStatsRegex = regexp.MustCompile(`(\d+)%,\s*([\d.]+\s*\S*/s)`)
+
+// RcloneInfo struct
+// Represents the local rclone installation and its configured remotes
+// Yerel rclone kurulumunu ve yapılandırılmış uzak hedeflerini temsil eder
+type RcloneInfo struct {
+	Available bool     `json:"available"` // rclone was found / rclone bulundu
+	Path      string   `json:"path"`      // rclone binary / rclone ikili dosyası
+	Version   string   `json:"version"`   // rclone version / rclone sürümü
+	Remotes   []string `json:"remotes"`   // Configured remotes, e.g. "gdrive:" / Yapılandırılmış uzak hedefler
+}
+
+// GetRcloneInfo reports whether rclone is installed and lists its remotes
+// The frontend offers each remote as a destination
+// Ön yüz her uzak hedefi bir hedef olarak sunar
+func (a *App) GetRcloneInfo() (RcloneInfo, error) {
+	info := RcloneInfo{Path: a.rclonePath}
+	if a.rclonePath == "" {
+		return info, nil
+	}
+	info.Available = true
+
+	out, err := exec.Command(a.rclonePath, "version").Output()
+	if err != nil {
+		log.Printf("Error getting rclone version: %v", err)
+		return info, fmt.Errorf("failed to run rclone: %v", err)
+	}
+	info.Version = strings.TrimPrefix(firstLine(string(out)), "rclone ")
+
+	out, err = exec.Command(a.rclonePath, "listremotes").Output()
+	if err != nil {
+		log.Printf("Error listing rclone remotes: %v", err)
+		return info, fmt.Errorf("failed to list rclone remotes: %v", err)
+	}
+	for _, line := range strings.Split(string(out), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			info.Remotes = append(info.Remotes, line)
+		}
+	}
+	return info, nil
+}
+
+// isRcloneDestination reports whether a destination is an rclone remote path
+// Bir hedefin rclone uzak yolu olup olmadığını bildirir
+func isRcloneDestination(destination string) bool {
+	return strings.HasPrefix(destination, rclonePrefix)
+}
+
+// rcloneTransfer moves a staged output to an rclone remote
+// rclone verifies size and checksum itself and retries failed transfers
+// rclone boyutu ve sağlama toplamını kendisi doğrular ve başarısız aktarımları yeniden dener
+func (a *App) rcloneTransfer(ctx context.Context, jobID, src, destination string) error {
+	if a.rclonePath == "" {
+		return fmt.Errorf("rclone not found, install it to use %s", destination)
+	}
+
+	cmd := exec.CommandContext(ctx, a.rclonePath, "moveto", src, strings.TrimPrefix(destination, rclonePrefix),
+		"--retries", strconv.Itoa(a.currentPreferences().CopyRetries+1),
+		"--stats", "1s", "--stats-one-line", "--stats-log-level", "NOTICE")
+	cmd.WaitDelay = 10 * time.Second
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return err
+	}
+	if err := runner.Start(cmd); err != nil {
+		log.Printf("Failed to start rclone: %v", err)
+		return fmt.Errorf("failed to start rclone: %v", err)
+	}
+
+	// Stats lines become transfer progress, the last other line explains a failure
+	// İstatistik satırları aktarım ilerlemesi olur, son diğer satır bir hatayı açıklar
+	var lastMessage string
+	throttle := a.newProgressThrottle()
+	scanner := bufio.NewScanner(stderr)
+	for scanner.Scan() {
+		line := scanner.Text()
+		match := rcloneStatsRegex.FindStringSubmatch(line)
+		if match == nil {
+			if strings.TrimSpace(line) != "" {
+				lastMessage = strings.TrimSpace(line)
+			}
+			continue
+		}
+		percent, _ := strconv.Atoi(match[1])
+		if !throttle.allow(percent >= 100) {
+			continue
+		}
+		a.emitProgress(jobID, "transfer", float64(percent), match[2])
+	}
+
+	if err := runner.Wait(cmd); err != nil {
+		if lastMessage != "" {
+			return fmt.Errorf("rclone failed: %v: %s", err, lastMessage)
+		}
+		return fmt.Errorf("rclone failed: %v", err)
+	}
+
+	// moveto normally removes the source, make sure nothing stays staged
+	// moveto normalde kaynağı siler, hazırlık alanında hiçbir şey kalmadığından emin ol
+	if err := os.Remove(src); err != nil && !os.IsNotExist(err) {
+		log.Printf("Error removing staged output %s: %v", src, err)
+	}
+	log.Printf("Moved %s to %s with rclone", src, destination)
+	return nil
+}
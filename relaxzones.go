@@ -0,0 +1,142 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"AV1-video-converter/internal/runner"
+)
+
+// relaxMinDuration is the shortest black or static range worth a zone, in seconds
+// Bir bölgeye değecek en kısa siyah veya durağan aralıktır, saniye cinsinden
+const relaxMinDuration = 10
+
+// defaultRelaxCRFOffset is added to the CRF of detected ranges when none is set
+// Hiçbiri ayarlanmadığında algılanan aralıkların CRF değerine eklenir
+const defaultRelaxCRFOffset = 12
+
+// relaxAnalysisFilter finds black ranges, credit rolls and frozen pictures
+// Credit rolls are mostly black, so a loose picture threshold catches them
+// Jenerikler çoğunlukla siyahtır, bu yüzden gevşek bir resim eşiği onları yakalar
+var relaxAnalysisFilter = fmt.Sprintf("scale=320:-2,blackdetect=d=%d:pic_th=0.85:pix_th=0.10,freezedetect=n=0.003:d=%d", relaxMinDuration, relaxMinDuration)
+
+var (
+	blackRangeRegex  = regexp.MustCompile(`black_start:\s*([\d.]+)\s+black_end:\s*([\d.]+)`)
+	freezeStartRegex = regexp.MustCompile(`freeze_start:\s*([\d.]+)`)
+	freezeEndRegex   = regexp.MustCompile(`freeze_end:\s*([\d.]+)`)
+)
+
+// timeRange struct
+// Represents a detected range of the timeline in seconds
+// Zaman çizelgesinin algılanan bir aralığını saniye cinsinden temsil eder
+type timeRange struct {
+	start, end float64
+	label      string
+}
+
+// DetectRelaxZones analyses a source for ranges that can be encoded at a higher CRF
+// Returns the zones the conversion would use with the current settings
+// Dönüştürmenin geçerli ayarlarla kullanacağı bölgeleri döndürür
+func (a *App) DetectRelaxZones(filePath string) ([]Zone, error) {
+	info, err := a.getVideoInfo(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to probe input: %v", err)
+	}
+	return a.detectRelaxZones(a.baseContext(), filePath, a.GetSettings(), info.DurationSeconds)
+}
+
+// detectRelaxZones runs blackdetect and freezedetect and turns the ranges into zones
+// blackdetect ve freezedetect çalıştırır ve aralıkları bölgelere dönüştürür
+func (a *App) detectRelaxZones(ctx context.Context, filePath string, settings ConversionSettings, duration float64) ([]Zone, error) {
+	cmd := exec.CommandContext(ctx, a.ffmpegPath,
+		"-hide_banner", "-nostats",
+		"-i", filePath,
+		"-map", "0:v:0",
+		"-vf", relaxAnalysisFilter,
+		"-an", "-f", "null", "-")
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := runner.Run(cmd); err != nil {
+		log.Printf("Error analysing %s for relaxed zones: %v", filePath, err)
+		return nil, fmt.Errorf("zone analysis failed: %v", err)
+	}
+
+	offset := settings.RelaxCRFOffset
+	if offset == 0 {
+		offset = defaultRelaxCRFOffset
+	}
+	crf := settings.CRF + offset
+	if spec, ok := encoderSpecs[settings.Encoder]; ok && crf > spec.qualityMax {
+		crf = spec.qualityMax
+	}
+
+	var zones []Zone
+	for _, r := range mergeRanges(parseRelaxRanges(stderr.String(), duration)) {
+		zones = append(zones, Zone{Label: r.label, Start: r.start, End: r.end, CRF: crf})
+	}
+	log.Printf("Relaxed zones for %s: %+v", filePath, zones)
+	return zones, nil
+}
+
+// parseRelaxRanges reads the black and frozen ranges from the FFmpeg output
+// A freeze still running at the end of the file has no end line
+// Dosyanın sonunda hâlâ süren bir donmanın bitiş satırı yoktur
+func parseRelaxRanges(output string, duration float64) []timeRange {
+	var ranges []timeRange
+	for _, match := range blackRangeRegex.FindAllStringSubmatch(output, -1) {
+		start, _ := strconv.ParseFloat(match[1], 64)
+		end, _ := strconv.ParseFloat(match[2], 64)
+		ranges = append(ranges, timeRange{start: start, end: end, label: "black"})
+	}
+
+	starts := freezeStartRegex.FindAllStringSubmatch(output, -1)
+	ends := freezeEndRegex.FindAllStringSubmatch(output, -1)
+	for i, match := range starts {
+		start, _ := strconv.ParseFloat(match[1], 64)
+		end := duration
+		if i < len(ends) {
+			end, _ = strconv.ParseFloat(ends[i][1], 64)
+		}
+		if end > start {
+			ranges = append(ranges, timeRange{start: start, end: end, label: "static"})
+		}
+	}
+	return ranges
+}
+
+// mergeRanges joins overlapping ranges and drops the ones that are too short
+// Çakışan aralıkları birleştirir ve çok kısa olanları atar
+func mergeRanges(ranges []timeRange) []timeRange {
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].start < ranges[j].start
+	})
+
+	var merged []timeRange
+	for _, r := range ranges {
+		if last := len(merged) - 1; last >= 0 && r.start <= merged[last].end {
+			if r.end > merged[last].end {
+				merged[last].end = r.end
+			}
+			if merged[last].label != r.label {
+				merged[last].label = "black+static"
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+
+	kept := merged[:0]
+	for _, r := range merged {
+		if r.end-r.start >= relaxMinDuration {
+			kept = append(kept, r)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,307 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"AV1-video-converter/internal/runner"
+)
+
+// askpassEnv carries the SSH password to the app when it runs as SSH_ASKPASS
+// Uygulama SSH_ASKPASS olarak çalıştığında SSH parolasını taşır
+const askpassEnv = "MD_AV1_ASKPASS"
+
+// RemoteConnection struct
+// Represents the login of an SFTP, FTP or FTPS destination
+// Bir SFTP, FTP veya FTPS hedefinin oturum bilgilerini temsil eder
+type RemoteConnection struct {
+	Name        string `json:"name"`        // Display name / Görünen ad
+	Protocol    string `json:"protocol"`    // sftp, ftp or ftps / Protokol
+	Host        string `json:"host"`        // Server host name / Sunucu adı
+	Port        int    `json:"port"`        // Server port, 0 for the default / Sunucu bağlantı noktası, 0 varsayılan
+	Username    string `json:"username"`    // Login name / Kullanıcı adı
+	Password    string `json:"password"`    // Password, encrypted in the config / Parola, yapılandırmada şifreli
+	KeyFile     string `json:"keyFile"`     // SSH private key for SFTP / SFTP için SSH özel anahtarı
+	HasPassword bool   `json:"hasPassword"` // A password is stored / Bir parola kayıtlı
+}
+
+// GetRemoteConnections returns the saved remote destinations without their passwords
+// Kayıtlı uzak hedefleri parolaları olmadan döndürür
+func (a *App) GetRemoteConnections() []RemoteConnection {
+	remotes := a.currentRemotes()
+	for i := range remotes {
+		remotes[i].HasPassword = remotes[i].Password != ""
+		remotes[i].Password = ""
+	}
+	return remotes
+}
+
+// currentRemotes returns a copy of the remote destinations with their passwords
+// Uzak hedeflerin parolalarıyla birlikte bir kopyasını döndürür
+func (a *App) currentRemotes() []RemoteConnection {
+	a.remotesMu.Lock()
+	defer a.remotesMu.Unlock()
+	return append([]RemoteConnection{}, a.remotes...)
+}
+
+// SaveRemoteConnections validates and stores the remote destinations
+// An empty password keeps the one stored for the same name
+// Boş bir parola aynı ad için kayıtlı olanı korur
+func (a *App) SaveRemoteConnections(remotes []RemoteConnection) error {
+	seen := make(map[string]bool)
+	for _, remote := range remotes {
+		if err := validateRemoteConnection(remote); err != nil {
+			log.Printf("Rejected remote connection %s: %v", remote.Name, err)
+			return err
+		}
+		if seen[remote.Name] {
+			return fmt.Errorf("duplicate connection name: %s", remote.Name)
+		}
+		seen[remote.Name] = true
+	}
+
+	remotes = append([]RemoteConnection{}, remotes...)
+	a.remotesMu.Lock()
+	for i := range remotes {
+		remote := &remotes[i]
+		if remote.Password != "" || !remote.HasPassword {
+			a.forgetSealedSecret(credentialRemotePrefix + remote.Name)
+		}
+		if remote.Password == "" && remote.HasPassword {
+			for _, existing := range a.remotes {
+				if existing.Name == remote.Name {
+					remote.Password = existing.Password
+				}
+			}
+		}
+		remote.HasPassword = false
+	}
+	a.remotes = remotes
+	a.remotesMu.Unlock()
+	a.saveConfig()
+	return nil
+}
+
+// validateRemoteConnection checks the fields of a remote destination
+// Uzak bir hedefin alanlarını kontrol eder
+func validateRemoteConnection(remote RemoteConnection) error {
+	switch {
+	case strings.TrimSpace(remote.Name) == "":
+		return fmt.Errorf("connection name is required")
+	case remote.Protocol != "sftp" && remote.Protocol != "ftp" && remote.Protocol != "ftps":
+		return fmt.Errorf("protocol must be sftp, ftp or ftps")
+	case remote.Host == "":
+		return fmt.Errorf("host is required")
+	case strings.HasPrefix(remote.Host, "-"), strings.HasPrefix(remote.Username, "-"):
+		// sftp would read them as options
+		// sftp bunları seçenek olarak okurdu
+		return fmt.Errorf("host and username can't start with -")
+	case remote.Port < 0 || remote.Port > 65535:
+		return fmt.Errorf("port must be between 0 and 65535")
+	}
+	return nil
+}
+
+// isRemoteDestination reports whether a destination is an sftp, ftp, ftps or rclone location
+// Bir hedefin sftp, ftp, ftps veya rclone konumu olup olmadığını bildirir
+func isRemoteDestination(destination string) bool {
+	if isRcloneDestination(destination) {
+		return true
+	}
+	for _, scheme := range []string{"sftp://", "ftp://", "ftps://"} {
+		if strings.HasPrefix(strings.ToLower(destination), scheme) {
+			return true
+		}
+	}
+	return false
+}
+
+// findRemoteConnection returns the saved login matching a destination URL
+// Bir hedef adresiyle eşleşen kayıtlı oturum bilgisini döndürür
+func (a *App) findRemoteConnection(target *url.URL) (RemoteConnection, error) {
+	for _, remote := range a.currentRemotes() {
+		if remote.Protocol != strings.ToLower(target.Scheme) || !strings.EqualFold(remote.Host, target.Hostname()) {
+			continue
+		}
+		if target.User != nil && target.User.Username() != remote.Username {
+			continue
+		}
+		if port := target.Port(); port != "" && port != strconv.Itoa(remote.Port) {
+			continue
+		}
+		return remote, nil
+	}
+	return RemoteConnection{}, fmt.Errorf("no saved connection for %s://%s", target.Scheme, target.Host)
+}
+
+// transferToRemote sends a staged output to an SFTP or FTP destination
+// Failed transfers are retried, FTP resumes and SFTP uses reput where possible
+// Başarısız aktarımlar yeniden denenir, FTP kaldığı yerden devam eder, SFTP mümkünse reput kullanır
+func (a *App) transferToRemote(ctx context.Context, jobID, src, destination string) error {
+	if isRcloneDestination(destination) {
+		return a.rcloneTransfer(ctx, jobID, src, destination)
+	}
+
+	target, err := url.Parse(destination)
+	if err != nil {
+		return fmt.Errorf("invalid destination %s: %v", destination, err)
+	}
+	remote, err := a.findRemoteConnection(target)
+	if err != nil {
+		return err
+	}
+
+	started := time.Now()
+	throttle := a.newProgressThrottle()
+	progress := func(sent, total int64) {
+		if !throttle.allow(sent >= total) {
+			return
+		}
+		speed := ""
+		if elapsed := time.Since(started).Seconds(); elapsed > 0 {
+			speed = fmt.Sprintf("%.1f MB/s", float64(sent)/elapsed/1024/1024)
+		}
+		percent := 100.0
+		if total > 0 {
+			percent = float64(sent) / float64(total) * 100
+		}
+		a.emitProgress(jobID, "transfer", percent, speed)
+	}
+
+	for attempt := 0; ; attempt++ {
+		if remote.Protocol == "sftp" {
+			err = a.sftpUpload(ctx, remote, src, target.Path, progress)
+		} else {
+			err = ftpUpload(ctx, remote, src, target.Path, progress)
+		}
+		if err == nil || ctx.Err() != nil || attempt >= a.currentPreferences().CopyRetries {
+			break
+		}
+		log.Printf("Retrying transfer of %s (attempt %d of %d): %v", src, attempt+1, a.currentPreferences().CopyRetries, err)
+		select {
+		case <-ctx.Done():
+		case <-time.After(time.Duration(attempt+1) * 2 * time.Second):
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(src); err != nil {
+		log.Printf("Error removing staged output %s: %v", src, err)
+	}
+	log.Printf("Transferred %s to %s", src, destination)
+	return nil
+}
+
+// sftpUpload runs the OpenSSH sftp client in batch mode
+// Passwords are answered by this app acting as SSH_ASKPASS, keys need no help
+// Parolalar SSH_ASKPASS gibi davranan bu uygulama tarafından yanıtlanır, anahtarlar yardıma ihtiyaç duymaz
+func (a *App) sftpUpload(ctx context.Context, remote RemoteConnection, localPath, remotePath string, progress func(sent, total int64)) error {
+	sftpPath, err := exec.LookPath("sftp")
+	if err != nil {
+		return fmt.Errorf("sftp client not found, install OpenSSH: %v", err)
+	}
+	stat, err := os.Stat(localPath)
+	if err != nil {
+		return err
+	}
+
+	// Create the folders, then resume the upload, falling back to a fresh one
+	// Klasörleri oluştur, ardından yüklemeye devam et, olmazsa baştan yükle
+	var mkdirs strings.Builder
+	current := ""
+	for _, part := range strings.Split(strings.Trim(path.Dir(remotePath), "/"), "/") {
+		if part != "" {
+			current += "/" + part
+			fmt.Fprintf(&mkdirs, "-mkdir %s\n", sftpQuote(current))
+		}
+	}
+	partPath := remotePath + partialSuffix
+	finish := fmt.Sprintf("-rm %s\nrename %s %s\n", sftpQuote(remotePath), sftpQuote(partPath), sftpQuote(remotePath))
+
+	progress(0, stat.Size())
+	batch := mkdirs.String() + fmt.Sprintf("reput %s %s\n", sftpQuote(localPath), sftpQuote(partPath)) + finish
+	if err = a.runSFTP(ctx, sftpPath, remote, batch); err != nil && ctx.Err() == nil {
+		log.Printf("Resuming SFTP upload failed, uploading %s again: %v", localPath, err)
+		batch = mkdirs.String() + fmt.Sprintf("put %s %s\n", sftpQuote(localPath), sftpQuote(partPath)) + finish
+		err = a.runSFTP(ctx, sftpPath, remote, batch)
+	}
+	if err != nil {
+		return err
+	}
+
+	// sftp batch mode has no progress output, report the end only
+	// sftp toplu kipinde ilerleme çıktısı yoktur, yalnızca sonu bildir
+	progress(stat.Size(), stat.Size())
+	return nil
+}
+
+// runSFTP runs a batch of sftp commands against a remote
+// Uzak bir sunucuya karşı bir grup sftp komutu çalıştırır
+func (a *App) runSFTP(ctx context.Context, sftpPath string, remote RemoteConnection, batch string) error {
+	// Our BatchMode comes first so the one added by -b doesn't disable password prompts
+	// -b ile eklenen BatchMode parola istemlerini kapatmasın diye bizimki önce gelir
+	args := []string{"-oStrictHostKeyChecking=accept-new"}
+	if remote.Password != "" {
+		args = append(args, "-oBatchMode=no", "-oNumberOfPasswordPrompts=1")
+	}
+	if remote.Port != 0 {
+		args = append(args, "-P", strconv.Itoa(remote.Port))
+	}
+	if remote.KeyFile != "" {
+		args = append(args, "-i", remote.KeyFile)
+	}
+	login := remote.Host
+	if remote.Username != "" {
+		login = remote.Username + "@" + remote.Host
+	}
+	args = append(args, "-b", "-", login)
+
+	cmd := exec.CommandContext(ctx, sftpPath, args...)
+	cmd.WaitDelay = 5 * time.Second
+	cmd.Stdin = strings.NewReader(batch)
+	if remote.Password != "" {
+		self, err := os.Executable()
+		if err != nil {
+			return err
+		}
+		cmd.Env = append(os.Environ(),
+			"SSH_ASKPASS="+self,
+			"SSH_ASKPASS_REQUIRE=force",
+			"DISPLAY=:0",
+			askpassEnv+"="+remote.Password)
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := runner.Run(cmd); err != nil {
+		return fmt.Errorf("sftp failed: %v: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
+
+// sftpQuote quotes a path for an sftp batch file
+// Bir yolu sftp toplu dosyası için tırnak içine alır
+func sftpQuote(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
+
+// answerAskpass prints the SSH password when the app was started as SSH_ASKPASS
+// Uygulama SSH_ASKPASS olarak başlatıldıysa SSH parolasını yazdırır
+func answerAskpass() bool {
+	password, ok := os.LookupEnv(askpassEnv)
+	if !ok {
+		return false
+	}
+	fmt.Println(password)
+	return true
+}
@@ -0,0 +1,276 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"AV1-video-converter/internal/events"
+)
+
+// remoteConverterTimeout limits a request to the remote converter, the event stream excluded
+// Uzak dönüştürücüye yapılan bir isteği sınırlar, olay akışı hariç
+const remoteConverterTimeout = 30 * time.Second
+
+// remoteReconnectDelay is the wait before the event stream of the remote converter is reopened
+// Uzak dönüştürücünün olay akışı yeniden açılmadan önceki bekleme süresi
+const remoteReconnectDelay = 5 * time.Second
+
+// RemoteConverter struct
+// Represents a headless instance the desktop app controls through its API
+// Masaüstü uygulamasının API'si üzerinden yönettiği başsız bir örneği temsil eder
+type RemoteConverter struct {
+	URL       string `json:"url"`       // Base URL of the API, e.g. http://nas:8765 / API'nin temel adresi, örn. http://nas:8765
+	APIKey    string `json:"apiKey"`    // API key, encrypted in the config / API anahtarı, yapılandırmada şifreli
+	HasAPIKey bool   `json:"hasApiKey"` // A key is stored / Bir anahtar kayıtlı
+}
+
+// GetRemoteConverter returns the connected remote converter without its key
+// Bağlı uzak dönüştürücüyü anahtarı olmadan döndürür
+func (a *App) GetRemoteConverter() RemoteConverter {
+	converter := a.currentRemoteConverter()
+	converter.HasAPIKey = converter.APIKey != ""
+	converter.APIKey = ""
+	return converter
+}
+
+// ConnectRemoteConverter checks and stores a remote converter and follows its events
+// An empty key keeps the stored one, remote events reach the frontend as remote:event
+// Boş bir anahtar kayıtlı olanı korur, uzak olaylar ön yüze remote:event olarak ulaşır
+func (a *App) ConnectRemoteConverter(converter RemoteConverter) error {
+	converter.URL = strings.TrimRight(strings.TrimSpace(converter.URL), "/")
+	if converter.APIKey == "" && converter.HasAPIKey {
+		converter.APIKey = a.currentRemoteConverter().APIKey
+	}
+	converter.HasAPIKey = false
+	if err := validateRemoteConverter(converter); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(a.baseContext(), remoteConverterTimeout)
+	defer cancel()
+	if err := remoteConverterRequest(ctx, converter, http.MethodGet, "/api/jobs", nil, nil); err != nil {
+		log.Printf("Error connecting to remote converter %s: %v", converter.URL, err)
+		return err
+	}
+
+	a.remoteConverterMu.Lock()
+	a.remoteConverter = converter
+	a.remoteConverterMu.Unlock()
+	a.saveConfig()
+	a.startRemoteConverterEvents()
+	log.Printf("Connected to remote converter %s", converter.URL)
+	return nil
+}
+
+// DisconnectRemoteConverter forgets the remote converter
+// Uzak dönüştürücüyü unutur
+func (a *App) DisconnectRemoteConverter() {
+	a.stopRemoteConverterEvents()
+	a.remoteConverterMu.Lock()
+	a.remoteConverter = RemoteConverter{}
+	a.remoteConverterMu.Unlock()
+	a.forgetSealedSecret("remoteConverter")
+	a.saveConfig()
+}
+
+// GetRemoteJobs returns the queue of the remote converter
+// Uzak dönüştürücünün kuyruğunu döndürür
+func (a *App) GetRemoteJobs() ([]Job, error) {
+	var jobs []Job
+	err := a.remoteConverterCall(http.MethodGet, "/api/jobs", nil, &jobs)
+	return jobs, err
+}
+
+// GetRemoteHistory returns the conversion history of the remote converter
+// Uzak dönüştürücünün dönüştürme geçmişini döndürür
+func (a *App) GetRemoteHistory() ([]HistoryEntry, error) {
+	var history []HistoryEntry
+	err := a.remoteConverterCall(http.MethodGet, "/api/history", nil, &history)
+	return history, err
+}
+
+// GetRemoteWatchFolders returns the watch folders of the remote converter
+// Uzak dönüştürücünün izleme klasörlerini döndürür
+func (a *App) GetRemoteWatchFolders() ([]WatchFolder, error) {
+	var folders []WatchFolder
+	err := a.remoteConverterCall(http.MethodGet, "/api/folders", nil, &folders)
+	return folders, err
+}
+
+// EnqueueRemoteJob queues a file on the remote converter, the paths are the server's
+// Uzak dönüştürücüde bir dosyayı kuyruğa ekler, yollar sunucunun yollarıdır
+func (a *App) EnqueueRemoteJob(inputPath, outputFolder, profile string) (Job, error) {
+	var job Job
+	err := a.remoteConverterCall(http.MethodPost, "/api/jobs", enqueueRequest{
+		InputPath:    inputPath,
+		OutputFolder: outputFolder,
+		Profile:      profile,
+	}, &job)
+	return job, err
+}
+
+// CancelRemoteJob stops a running job on the remote converter
+// Uzak dönüştürücüde çalışan bir işi durdurur
+func (a *App) CancelRemoteJob(jobID string) error {
+	return a.remoteConverterCall(http.MethodPost, "/api/jobs/"+url.PathEscape(jobID)+"/cancel", nil, nil)
+}
+
+// validateRemoteConverter checks the address and key of a remote converter
+// Uzak bir dönüştürücünün adresini ve anahtarını kontrol eder
+func validateRemoteConverter(converter RemoteConverter) error {
+	target, err := url.Parse(converter.URL)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return fmt.Errorf("remote converter URL must look like http://host:8765")
+	}
+	if len(converter.APIKey) < minAPIKeyLength {
+		return fmt.Errorf("API key must be at least %d characters", minAPIKeyLength)
+	}
+	return nil
+}
+
+// currentRemoteConverter returns a copy of the remote converter settings
+// Uzak dönüştürücü ayarlarının bir kopyasını döndürür
+func (a *App) currentRemoteConverter() RemoteConverter {
+	a.remoteConverterMu.Lock()
+	defer a.remoteConverterMu.Unlock()
+	return a.remoteConverter
+}
+
+// remoteConverterCall sends a request to the connected remote converter
+// Bağlı uzak dönüştürücüye bir istek gönderir
+func (a *App) remoteConverterCall(method, path string, body, out interface{}) error {
+	converter := a.currentRemoteConverter()
+	if converter.URL == "" {
+		return fmt.Errorf("no remote converter connected")
+	}
+	ctx, cancel := context.WithTimeout(a.baseContext(), remoteConverterTimeout)
+	defer cancel()
+	return remoteConverterRequest(ctx, converter, method, path, body, out)
+}
+
+// remoteConverterRequest sends a JSON request to a remote converter and decodes the answer into out
+// Uzak bir dönüştürücüye JSON isteği gönderir ve yanıtı out içine çözer
+func remoteConverterRequest(ctx context.Context, converter RemoteConverter, method, path string, body, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, converter.URL+path, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Api-Key", converter.APIKey)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("remote converter unreachable: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		var apiErr struct {
+			Error string `json:"error"`
+		}
+		if json.NewDecoder(io.LimitReader(resp.Body, 64*1024)).Decode(&apiErr) == nil && apiErr.Error != "" {
+			return fmt.Errorf("remote converter: %s", apiErr.Error)
+		}
+		return fmt.Errorf("remote converter answered %s", resp.Status)
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// startRemoteConverterEvents follows the event stream of the connected remote converter
+// Reconnects after remoteReconnectDelay until the converter is disconnected
+// Dönüştürücünün bağlantısı kesilene kadar remoteReconnectDelay sonrasında yeniden bağlanır
+func (a *App) startRemoteConverterEvents() {
+	a.stopRemoteConverterEvents()
+	converter := a.currentRemoteConverter()
+	if converter.URL == "" {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(a.baseContext())
+	a.remoteConverterMu.Lock()
+	a.remoteConverterCancel = cancel
+	a.remoteConverterMu.Unlock()
+
+	go func() {
+		defer a.recoverCrash("watchRemoteConverter")
+		for {
+			err := a.followRemoteConverter(ctx, converter)
+			if ctx.Err() != nil {
+				return
+			}
+			log.Printf("Remote converter event stream ended: %v", err)
+			a.events.Emit("remote:disconnected", map[string]interface{}{
+				"url":   converter.URL,
+				"error": fmt.Sprint(err),
+			})
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(remoteReconnectDelay):
+			}
+		}
+	}()
+}
+
+// stopRemoteConverterEvents stops following the remote converter
+// Uzak dönüştürücüyü izlemeyi durdurur
+func (a *App) stopRemoteConverterEvents() {
+	a.remoteConverterMu.Lock()
+	cancel := a.remoteConverterCancel
+	a.remoteConverterCancel = nil
+	a.remoteConverterMu.Unlock()
+	if cancel != nil {
+		cancel()
+	}
+}
+
+// followRemoteConverter passes the remote timeline events to the frontend until the stream breaks
+// They are wrapped so local listeners and API watchers never mistake them for local jobs
+// Yerel dinleyiciler ve API izleyicileri onları yerel işlerle karıştırmasın diye sarılırlar
+func (a *App) followRemoteConverter(ctx context.Context, converter RemoteConverter) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, converter.URL+"/api/watch", nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Api-Key", converter.APIKey)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("remote converter answered %s", resp.Status)
+	}
+
+	decoder := json.NewDecoder(resp.Body)
+	for {
+		var envelope events.Envelope
+		if err := decoder.Decode(&envelope); err != nil {
+			return err
+		}
+		a.events.Emit("remote:event", map[string]interface{}{
+			"url":      converter.URL,
+			"envelope": envelope,
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"path/filepath"
+	"strings"
+)
+
+// maxRenditions limits the outputs of a job, each one is a separate encoder instance
+// Bir işin çıktılarını sınırlar, her biri ayrı bir kodlayıcı örneğidir
+const maxRenditions = 4
+
+// Rendition struct
+// Represents one output of a job, e.g. a 4K archive copy and a 720p phone copy
+// Bir işin tek çıktısını temsil eder, örn. 4K arşiv kopyası ve 720p telefon kopyası
+type Rendition struct {
+	Name     string             `json:"name"`     // Suffix of the output file / Çıktı dosyasının son eki
+	Settings ConversionSettings `json:"settings"` // Settings of the output / Çıktının ayarları
+}
+
+// SetJobRenditions replaces the outputs of a queued job
+// All renditions are encoded from a single decode of the source
+// Tüm sürümler kaynağın tek bir kod çözümünden kodlanır
+func (a *App) SetJobRenditions(jobID string, renditions []Rendition) error {
+	if len(renditions) > maxRenditions {
+		return fmt.Errorf("at most %d renditions are supported", maxRenditions)
+	}
+
+	seen := make(map[string]bool)
+	for i, rendition := range renditions {
+		name := sanitizeFileName(strings.TrimSpace(rendition.Name))
+		if name == "" {
+			return fmt.Errorf("rendition %d has no name", i+1)
+		}
+		if seen[strings.ToLower(name)] {
+			return fmt.Errorf("duplicate rendition name: %s", name)
+		}
+		seen[strings.ToLower(name)] = true
+		renditions[i].Name = name
+
+		if len(rendition.Settings.Zones) > 0 && len(renditions) > 1 {
+			return fmt.Errorf("rendition %s: quality zones can't be combined with other renditions", name)
+		}
+		if errs := a.validateSettingsForBuild(rendition.Settings); len(errs) > 0 {
+			log.Printf("Rejected rendition %s: %v", name, errs)
+			return fmt.Errorf("rendition %s: %w", name, errs)
+		}
+	}
+
+	job, ok := a.getJob(jobID)
+	if !ok {
+		return fmt.Errorf("unknown job: %s", jobID)
+	}
+	if job.Status == "running" {
+		return fmt.Errorf("job %s is running", jobID)
+	}
+	a.updateJob(jobID, func(job *Job) {
+		job.Renditions = renditions
+	})
+	log.Printf("Job %s has %d renditions", jobID, len(renditions))
+	return nil
+}
+
+// jobOutputs returns the outputs of a job, named after the sanitized source name
+// A job without renditions has a single output using the job settings
+// Sürümü olmayan bir işin, iş ayarlarını kullanan tek bir çıktısı vardır
+func jobOutputs(job Job, baseName string) []planOutput {
+	if len(job.Renditions) == 0 {
+		return []planOutput{{
+			Path:     joinDestination(job.OutputFolder, outputFileName(job, job.Settings, baseName, "")),
+			Settings: job.Settings,
+		}}
+	}
+
+	outputs := make([]planOutput, 0, len(job.Renditions))
+	for _, rendition := range job.Renditions {
+		outputs = append(outputs, planOutput{
+			Name:     rendition.Name,
+			Path:     joinDestination(job.OutputFolder, outputFileName(job, rendition.Settings, baseName, rendition.Name)),
+			Settings: rendition.Settings,
+		})
+	}
+	return outputs
+}
+
+// outputFileName names an output after the source, its tag and the rendition
+// Audio jobs are tagged with their codec and written with its extension
+// Ses işleri kodekleriyle etiketlenir ve onun uzantısıyla yazılır
+func outputFileName(job Job, settings ConversionSettings, baseName, rendition string) string {
+	tag, extension := outputTag(settings), settings.Container
+	if job.AudioOnly {
+		tag, extension = musicOutputName(settings)
+	}
+	name := baseName + "_" + tag
+	if rendition != "" {
+		name += "_" + rendition
+	}
+	return name + "." + extension
+}
+
+// joinDestination appends a file name to a local folder or a remote URL
+// Yerel bir klasöre veya uzak bir adrese dosya adı ekler
+func joinDestination(folder, fileName string) string {
+	if isRcloneDestination(folder) {
+		if strings.HasSuffix(folder, ":") {
+			return folder + fileName
+		}
+		return strings.TrimSuffix(folder, "/") + "/" + fileName
+	}
+	if isRemoteDestination(folder) {
+		return strings.TrimSuffix(folder, "/") + "/" + url.PathEscape(fileName)
+	}
+	return filepath.Join(folder, fileName)
+}
+
+// outputPaths lists the files of the outputs
+// Çıktıların dosyalarını listeler
+func outputPaths(outputs []planOutput) []string {
+	paths := make([]string, 0, len(outputs))
+	for _, output := range outputs {
+		paths = append(paths, output.finalPath())
+	}
+	return paths
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// replaceBackupSuffix marks the original while the encoded file takes its place
+// Kodlanmış dosya yerini alırken orijinali işaretler
+const replaceBackupSuffix = ".orig"
+
+// libraryRefreshTimeout limits how long a media server may take to answer
+// Bir medya sunucusunun yanıt vermesi için tanınan süreyi sınırlar
+const libraryRefreshTimeout = 30 * time.Second
+
+// containerExtensions lists the source extensions each container may keep
+// Her kapsayıcının koruyabileceği kaynak uzantılarını listeler
+var containerExtensions = map[string][]string{
+	"mp4":  {".mp4", ".m4v"},
+	"mov":  {".mov"},
+	"mkv":  {".mkv"},
+	"webm": {".webm"},
+}
+
+// replacementTarget returns the path the encoded file takes over
+// The source name is kept if asked and its extension fits the container
+// İstenirse ve uzantısı kapsayıcıya uyuyorsa kaynak adı korunur
+func replacementTarget(sourcePath string, settings ConversionSettings) string {
+	ext := filepath.Ext(sourcePath)
+	if settings.ReplaceKeepName && containsString(containerExtensions[settings.Container], strings.ToLower(ext)) {
+		return sourcePath
+	}
+	return strings.TrimSuffix(sourcePath, ext) + "." + settings.Container
+}
+
+// verifyReplacement checks the encoded file before it replaces the source
+// The output must be AV1 and as long as the source
+// Çıktı AV1 olmalı ve kaynakla aynı uzunlukta olmalıdır
+func (a *App) verifyReplacement(encodedPath string, source VideoInfo) error {
+	info, err := a.getVideoInfo(encodedPath)
+	if err != nil {
+		return fmt.Errorf("failed to probe encoded file: %v", err)
+	}
+	if info.Codec != "av1" {
+		return fmt.Errorf("encoded file has codec %s instead of av1", info.Codec)
+	}
+
+	// Allow a second or 1% of drift from container rounding
+	// Kapsayıcı yuvarlamasından kaynaklı bir saniye veya %1 sapmaya izin ver
+	tolerance := math.Max(1, source.DurationSeconds*0.01)
+	if math.Abs(info.DurationSeconds-source.DurationSeconds) > tolerance {
+		return fmt.Errorf("encoded file lasts %s but the source lasts %s", formatSeconds(info.DurationSeconds), formatSeconds(source.DurationSeconds))
+	}
+	return nil
+}
+
+// replaceOriginal swaps the encoded file into the place of the source
+// The source is moved aside first so a failed swap can be rolled back
+// Başarısız bir değişimin geri alınabilmesi için kaynak önce kenara taşınır
+func replaceOriginal(sourcePath, encodedPath, targetPath string, keepBackup bool) error {
+	if targetPath != sourcePath {
+		if _, err := os.Stat(targetPath); err == nil {
+			return fmt.Errorf("%s already exists", targetPath)
+		}
+	}
+
+	backupPath := sourcePath + replaceBackupSuffix
+	if _, err := os.Stat(backupPath); err == nil {
+		return fmt.Errorf("backup %s already exists", backupPath)
+	}
+	if err := os.Rename(sourcePath, backupPath); err != nil {
+		return fmt.Errorf("failed to move the original aside: %v", err)
+	}
+	if err := os.Rename(encodedPath, targetPath); err != nil {
+		if restoreErr := os.Rename(backupPath, sourcePath); restoreErr != nil {
+			log.Printf("Error restoring %s from %s: %v", sourcePath, backupPath, restoreErr)
+		}
+		return fmt.Errorf("failed to move the encoded file into place: %v", err)
+	}
+
+	if keepBackup {
+		log.Printf("Replaced %s with %s, original kept as %s", sourcePath, targetPath, backupPath)
+		return nil
+	}
+	if err := os.Remove(backupPath); err != nil {
+		log.Printf("Error removing original %s: %v", backupPath, err)
+	}
+	log.Printf("Replaced %s with %s", sourcePath, targetPath)
+	return nil
+}
+
+// refreshLibrary asks the media server to rescan after a replacement
+// {path} in the URL is replaced with the escaped folder of the file
+// URL'deki {path}, dosyanın kaçışlı klasörüyle değiştirilir
+func (a *App) refreshLibrary(ctx context.Context, jobID, filePath string) {
+	rawURL := a.currentPreferences().LibraryRefreshURL
+	if rawURL == "" {
+		return
+	}
+	rawURL = strings.ReplaceAll(rawURL, "{path}", url.QueryEscape(filepath.Dir(filePath)))
+
+	method := a.currentPreferences().LibraryRefreshMethod
+	if method == "" {
+		method = http.MethodPost
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, libraryRefreshTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, method, rawURL, nil)
+	if err != nil {
+		log.Printf("Error creating library refresh request: %v", err)
+		return
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		log.Printf("Error refreshing library: %v", err)
+		a.addJobEvent(jobID, "refresh", fmt.Sprintf("library refresh failed: %v", err))
+		return
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		log.Printf("Library refresh returned %s", resp.Status)
+		a.addJobEvent(jobID, "refresh", "library refresh returned "+resp.Status)
+		return
+	}
+	a.addJobEvent(jobID, "refresh", "library refresh requested")
+}
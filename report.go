@@ -0,0 +1,171 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// ReportRow struct
+// Represents one conversion in an exported report
+// Dışa aktarılan bir rapordaki tek bir dönüştürmeyi temsil eder
+type ReportRow struct {
+	File        string  `json:"file"`           // Source file name / Kaynak dosya adı
+	Output      string  `json:"output"`         // Output path / Çıktı yolu
+	Status      string  `json:"status"`         // completed or failed / completed veya failed
+	Duration    float64 `json:"duration"`       // Source duration in seconds / Saniye cinsinden kaynak süresi
+	Encoder     string  `json:"encoder"`        // Video encoder / Video kodlayıcı
+	CRF         int     `json:"crf"`            // Quality value / Kalite değeri
+	Preset      string  `json:"preset"`         // Encoder preset / Kodlayıcı ön ayarı
+	InputSize   int64   `json:"inputSize"`      // Source size in bytes / Bayt cinsinden kaynak boyutu
+	OutputSize  int64   `json:"outputSize"`     // Output size in bytes / Bayt cinsinden çıktı boyutu
+	Ratio       float64 `json:"ratio"`          // Output size / source size / Çıktı boyutu / kaynak boyutu
+	VMAF        float64 `json:"vmaf,omitempty"` // VMAF score, left out when not measured / VMAF puanı, ölçülmediyse yazılmaz
+	TimeTaken   float64 `json:"timeTaken"`      // Conversion time in seconds / Saniye cinsinden dönüştürme süresi
+	ConvertedAt string  `json:"convertedAt"`    // Finish time / Bitiş zamanı
+}
+
+// ExportReport writes the history between two dates to a CSV or JSON file
+// Dates are YYYY-MM-DD and inclusive, empty means unbounded
+// Tarihler YYYY-AA-GG biçiminde ve dahildir, boşsa sınırsızdır
+func (a *App) ExportReport(format, from, to string) (string, error) {
+	if format != "csv" && format != "json" {
+		return "", fmt.Errorf("unsupported report format: %s", format)
+	}
+	start, end, err := parseDateRange(from, to)
+	if err != nil {
+		return "", err
+	}
+
+	// Collect the rows in range
+	// Aralıktaki satırları topla
+	history, err := a.GetHistory()
+	if err != nil {
+		return "", err
+	}
+	var rows []ReportRow
+	for _, entry := range history {
+		if entry.FinishedAt.Before(start) || (!end.IsZero() && !entry.FinishedAt.Before(end)) {
+			continue
+		}
+		rows = append(rows, reportRow(entry))
+	}
+
+	path, err := a.saveFileDialog("Export Report", "av1-report."+format,
+		fileFilter{name: "Report Files", pattern: "*." + format})
+	if err != nil || path == "" {
+		return "", err
+	}
+
+	if format == "json" {
+		err = writeJSONReport(path, rows)
+	} else {
+		err = writeCSVReport(path, rows)
+	}
+	if err != nil {
+		log.Printf("Error writing report: %v", err)
+		return "", fmt.Errorf("failed to write report: %v", err)
+	}
+
+	log.Printf("Exported %d report rows to %s", len(rows), path)
+	return path, nil
+}
+
+// reportRow converts a history entry to a report row
+// Bir geçmiş kaydını rapor satırına dönüştürür
+func reportRow(entry HistoryEntry) ReportRow {
+	row := ReportRow{
+		File:        filepath.Base(entry.InputPath),
+		Output:      entry.OutputPath,
+		Status:      entry.Status,
+		Duration:    entry.Duration,
+		Encoder:     entry.Settings.Encoder,
+		CRF:         entry.Settings.CRF,
+		Preset:      entry.Settings.Preset,
+		InputSize:   entry.InputSize,
+		OutputSize:  entry.OutputSize,
+		VMAF:        entry.VMAF,
+		TimeTaken:   entry.FinishedAt.Sub(entry.StartedAt).Seconds(),
+		ConvertedAt: entry.FinishedAt.Format(time.RFC3339),
+	}
+	if entry.InputSize > 0 {
+		row.Ratio = float64(entry.OutputSize) / float64(entry.InputSize)
+	}
+	return row
+}
+
+// parseDateRange parses the inclusive report date range
+// Dahil rapor tarih aralığını ayrıştırır
+func parseDateRange(from, to string) (time.Time, time.Time, error) {
+	var start, end time.Time
+	var err error
+	if from != "" {
+		if start, err = time.ParseInLocation("2006-01-02", from, time.Local); err != nil {
+			return start, end, fmt.Errorf("invalid start date: %v", err)
+		}
+	}
+	if to != "" {
+		if end, err = time.ParseInLocation("2006-01-02", to, time.Local); err != nil {
+			return start, end, fmt.Errorf("invalid end date: %v", err)
+		}
+		end = end.AddDate(0, 0, 1)
+	}
+	return start, end, nil
+}
+
+// writeJSONReport writes the rows as a JSON array
+// Satırları bir JSON dizisi olarak yazar
+func writeJSONReport(path string, rows []ReportRow) error {
+	data, err := json.MarshalIndent(rows, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// writeCSVReport writes the rows as CSV with a header line
+// Satırları başlık satırıyla CSV olarak yazar
+func writeCSVReport(path string, rows []ReportRow) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	writer.Write([]string{"file", "output", "status", "duration", "encoder", "crf", "preset",
+		"input_size", "output_size", "ratio", "vmaf", "time_taken", "converted_at"})
+	for _, row := range rows {
+		writer.Write([]string{
+			row.File,
+			row.Output,
+			row.Status,
+			strconv.FormatFloat(row.Duration, 'f', 2, 64),
+			row.Encoder,
+			strconv.Itoa(row.CRF),
+			row.Preset,
+			strconv.FormatInt(row.InputSize, 10),
+			strconv.FormatInt(row.OutputSize, 10),
+			strconv.FormatFloat(row.Ratio, 'f', 4, 64),
+			formatVMAF(row.VMAF),
+			strconv.FormatFloat(row.TimeTaken, 'f', 1, 64),
+			row.ConvertedAt,
+		})
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// formatVMAF leaves the cell empty for outputs whose quality wasn't measured
+// Kalitesi ölçülmeyen çıktılar için hücreyi boş bırakır
+func formatVMAF(score float64) string {
+	if score == 0 {
+		return ""
+	}
+	return strconv.FormatFloat(score, 'f', 2, 64)
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// TestReportVMAF exports measured scores and leaves the column empty for unmeasured outputs
+// Ölçülen puanları dışa aktarır ve ölçülmeyen çıktılar için sütunu boş bırakır
+func TestReportVMAF(t *testing.T) {
+	finished := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	rows := []ReportRow{
+		reportRow(HistoryEntry{InputPath: "/in/archive.mkv", Status: "completed", VMAF: 97.456, FinishedAt: finished}),
+		reportRow(HistoryEntry{InputPath: "/in/plain.mkv", Status: "completed", FinishedAt: finished}),
+	}
+	path := filepath.Join(t.TempDir(), "report.csv")
+	if err := writeCSVReport(path, rows); err != nil {
+		t.Fatalf("writeCSVReport: %v", err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	records, err := csv.NewReader(file).ReadAll()
+	if err != nil || len(records) != 3 {
+		t.Fatalf("read %d records, %v", len(records), err)
+	}
+	column := -1
+	for i, name := range records[0] {
+		if name == "vmaf" {
+			column = i
+		}
+	}
+	if column < 0 {
+		t.Fatalf("no vmaf column in %v", records[0])
+	}
+	if got := records[1][column]; got != "97.46" {
+		t.Errorf("measured vmaf = %q, want 97.46", got)
+	}
+	if got := records[2][column]; got != "" {
+		t.Errorf("unmeasured vmaf = %q, want an empty cell", got)
+	}
+}
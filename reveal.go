@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	goruntime "runtime"
+)
+
+// OpenOutputFile opens a converted file with the default application
+// Lets the frontend offer a "Play" button after completion
+// Ön yüzün tamamlandıktan sonra "Oynat" düğmesi sunmasını sağlar
+func (a *App) OpenOutputFile(path string) error {
+	if _, err := os.Stat(path); err != nil {
+		return fmt.Errorf("file not found: %v", err)
+	}
+
+	var cmd *exec.Cmd
+	switch goruntime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", path)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", path)
+	default:
+		cmd = exec.Command("xdg-open", path)
+	}
+	return startDetached(cmd)
+}
+
+// RevealInFolder shows a converted file selected in the file manager
+// Lets the frontend offer a "Show in Finder" button after completion
+// Ön yüzün tamamlandıktan sonra "Finder'da Göster" düğmesi sunmasını sağlar
+func (a *App) RevealInFolder(path string) error {
+	if _, err := os.Stat(path); err != nil {
+		return fmt.Errorf("file not found: %v", err)
+	}
+
+	switch goruntime.GOOS {
+	case "darwin":
+		return startDetached(exec.Command("open", "-R", path))
+	case "windows":
+		return startDetached(exec.Command("explorer", "/select,", path))
+	}
+
+	// Ask the file manager over D-Bus to select the file, fall back to opening the folder
+	// Dosya yöneticisinden D-Bus üzerinden dosyayı seçmesini iste, olmazsa klasörü aç
+	fileURL := (&url.URL{Scheme: "file", Path: path}).String()
+	err := exec.Command("dbus-send", "--session", "--dest=org.freedesktop.FileManager1",
+		"--type=method_call", "/org/freedesktop/FileManager1",
+		"org.freedesktop.FileManager1.ShowItems",
+		"array:string:"+fileURL, "string:").Run()
+	if err == nil {
+		return nil
+	}
+	log.Printf("FileManager1 not available, opening folder instead: %v", err)
+	return startDetached(exec.Command("xdg-open", filepath.Dir(path)))
+}
+
+// startDetached starts a helper process without waiting for it
+// Bir yardımcı işlemi beklemeden başlatır
+func startDetached(cmd *exec.Cmd) error {
+	if err := cmd.Start(); err != nil {
+		log.Printf("Error running %v: %v", cmd.Args, err)
+		return fmt.Errorf("failed to run %s: %v", filepath.Base(cmd.Path), err)
+	}
+	go cmd.Wait()
+	return nil
+}
@@ -0,0 +1,214 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"log"
+	"path/filepath"
+	goruntime "runtime"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// av1SizeFactors estimates the AV1 output size relative to the source per codec
+// Conservative averages for visually similar quality, actual results vary by content
+// Görsel olarak benzer kalite için temkinli ortalamalar, gerçek sonuçlar içeriğe göre değişir
+var av1SizeFactors = map[string]float64{
+	"h264":       0.5,
+	"hevc":       0.75,
+	"vp9":        0.8,
+	"mpeg2video": 0.3,
+	"mpeg4":      0.35,
+	"msmpeg4v3":  0.35,
+	"vc1":        0.35,
+	"wmv3":       0.35,
+}
+
+// defaultAV1SizeFactor is used for codecs missing from av1SizeFactors
+// av1SizeFactors içinde olmayan kodekler için kullanılır
+const defaultAV1SizeFactor = 0.5
+
+// ScanRules struct
+// Represents the thresholds a file must pass to be worth converting
+// Bir dosyanın dönüştürülmeye değmesi için geçmesi gereken eşikleri temsil eder
+type ScanRules struct {
+	Codecs     []string `json:"codecs"`     // Source codecs to include, empty for any non-AV1 / Dahil edilecek kaynak kodekler, boşsa AV1 dışı hepsi
+	MinBitrate int      `json:"minBitrate"` // Minimum overall bitrate in kbps / Kbps cinsinden en düşük toplam bit hızı
+	MinSize    int      `json:"minSize"`    // Minimum file size in MB / MB cinsinden en küçük dosya boyutu
+	MinHeight  int      `json:"minHeight"`  // Minimum video height / En düşük video yüksekliği
+}
+
+// ScanCandidate struct
+// Represents a file worth converting and its expected savings
+// Dönüştürülmeye değer bir dosyayı ve beklenen tasarrufu temsil eder
+type ScanCandidate struct {
+	Video            VideoInfo `json:"video"`            // Probed source, ready to queue / Kuyruğa hazır incelenmiş kaynak
+	SizeBytes        int64     `json:"sizeBytes"`        // Source size in bytes / Bayt cinsinden kaynak boyutu
+	EstimatedBytes   int64     `json:"estimatedBytes"`   // Estimated AV1 size in bytes / Bayt cinsinden tahmini AV1 boyutu
+	EstimatedSavings int64     `json:"estimatedSavings"` // Estimated bytes saved / Tahmini kazanılan bayt
+}
+
+// ScanResult struct
+// Represents the outcome of a library scan
+// Bir kütüphane taramasının sonucunu temsil eder
+type ScanResult struct {
+	Root             string          `json:"root"`             // Scanned folder / Taranan klasör
+	FilesScanned     int             `json:"filesScanned"`     // Video files probed / İncelenen video dosyaları
+	Candidates       []ScanCandidate `json:"candidates"`       // Files worth converting, biggest savings first / En büyük tasarruf önce
+	TotalBytes       int64           `json:"totalBytes"`       // Size of the candidates / Adayların boyutu
+	EstimatedSavings int64           `json:"estimatedSavings"` // Estimated bytes saved in total / Toplam tahmini kazanılan bayt
+	Errors           []string        `json:"errors"`           // Files that couldn't be read / Okunamayan dosyalar
+	Cancelled        bool            `json:"cancelled"`        // Scan was stopped early / Tarama erken durduruldu
+}
+
+// Scan walks a media library and lists the files worth converting to AV1
+// Progress is reported with "scan:progress" events, CancelScan stops it
+// İlerleme "scan:progress" olaylarıyla bildirilir, CancelScan onu durdurur
+func (a *App) Scan(rootDir string, rules ScanRules) (ScanResult, error) {
+	if rules.MinBitrate < 0 || rules.MinSize < 0 || rules.MinHeight < 0 {
+		return ScanResult{}, fmt.Errorf("scan thresholds must not be negative")
+	}
+
+	ctx, cancel := context.WithCancel(a.baseContext())
+	a.scanMu.Lock()
+	if a.scanCancel != nil {
+		a.scanMu.Unlock()
+		cancel()
+		return ScanResult{}, fmt.Errorf("a scan is already running")
+	}
+	a.scanCancel = cancel
+	a.scanMu.Unlock()
+	defer func() {
+		a.scanMu.Lock()
+		a.scanCancel = nil
+		a.scanMu.Unlock()
+		cancel()
+	}()
+
+	result := ScanResult{Root: rootDir}
+	sizes := make(map[string]int64)
+	var files []string
+	err := filepath.WalkDir(rootDir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", path, err))
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if entry.IsDir() || !a.isVideoFile(path) {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", path, err))
+			return nil
+		}
+		if info.Size() < int64(rules.MinSize)*1024*1024 {
+			return nil
+		}
+		sizes[path] = info.Size()
+		files = append(files, path)
+		return nil
+	})
+	if err != nil && ctx.Err() == nil {
+		log.Printf("Error scanning %s: %v", rootDir, err)
+		return ScanResult{}, fmt.Errorf("failed to scan %s: %v", rootDir, err)
+	}
+	log.Printf("Scanning %d video files under %s", len(files), rootDir)
+
+	// Probe in parallel at the background priority, the probe cache makes rescans cheap
+	// Arka plan önceliğinde paralel incele, inceleme önbelleği yeniden taramaları ucuzlatır
+	probeCtx := a.backgroundContext(ctx)
+	workers := goruntime.NumCPU()
+	if workers > maxProbeWorkers {
+		workers = maxProbeWorkers
+	}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	paths := make(chan string)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer a.recoverCrash("Scan")
+			for path := range paths {
+				info, err := a.probeVideoInfo(probeCtx, path)
+				mu.Lock()
+				result.FilesScanned++
+				if err != nil {
+					result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", path, err))
+				} else if candidate, ok := scanCandidate(info, sizes[path], rules); ok {
+					result.Candidates = append(result.Candidates, candidate)
+				}
+				a.events.Emit("scan:progress", map[string]interface{}{
+					"scanned":    result.FilesScanned,
+					"total":      len(files),
+					"candidates": len(result.Candidates),
+					"path":       path,
+				})
+				mu.Unlock()
+			}
+		}()
+	}
+	for _, path := range files {
+		if ctx.Err() != nil {
+			break
+		}
+		paths <- path
+	}
+	close(paths)
+	wg.Wait()
+	a.probeCache.save()
+
+	sort.Slice(result.Candidates, func(i, j int) bool {
+		return result.Candidates[i].EstimatedSavings > result.Candidates[j].EstimatedSavings
+	})
+	for _, candidate := range result.Candidates {
+		result.TotalBytes += candidate.SizeBytes
+		result.EstimatedSavings += candidate.EstimatedSavings
+	}
+	result.Cancelled = ctx.Err() != nil
+	log.Printf("Scan of %s: %d of %d files are candidates, about %d MB to save", rootDir, len(result.Candidates), result.FilesScanned, result.EstimatedSavings/1024/1024)
+	return result, nil
+}
+
+// CancelScan stops a running library scan, the partial result is still returned
+// Çalışan bir kütüphane taramasını durdurur, kısmi sonuç yine de döndürülür
+func (a *App) CancelScan() {
+	a.scanMu.Lock()
+	defer a.scanMu.Unlock()
+	if a.scanCancel != nil {
+		log.Printf("Cancelling library scan")
+		a.scanCancel()
+	}
+}
+
+// scanCandidate checks a probed file against the rules and estimates its savings
+// İncelenmiş bir dosyayı kurallara göre kontrol eder ve tasarrufunu tahmin eder
+func scanCandidate(info VideoInfo, size int64, rules ScanRules) (ScanCandidate, bool) {
+	codec := strings.ToLower(info.Codec)
+	if codec == "av1" {
+		return ScanCandidate{}, false
+	}
+	if len(rules.Codecs) > 0 && !containsString(rules.Codecs, codec) {
+		return ScanCandidate{}, false
+	}
+	if info.Bitrate < rules.MinBitrate || info.Height < rules.MinHeight {
+		return ScanCandidate{}, false
+	}
+
+	factor, ok := av1SizeFactors[codec]
+	if !ok {
+		factor = defaultAV1SizeFactor
+	}
+	estimated := int64(float64(size) * factor)
+	return ScanCandidate{
+		Video:            info,
+		SizeBytes:        size,
+		EstimatedBytes:   estimated,
+		EstimatedSavings: size - estimated,
+	}, true
+}
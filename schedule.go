@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"path/filepath"
+	"sync"
+)
+
+// maxParallelJobs is the highest parallel job limit the preferences accept
+// Tercihlerin kabul ettiği en yüksek paralel iş sınırıdır
+const maxParallelJobs = 16
+
+// errCalibrationRunning is returned when a calibration is asked for while one runs
+// Bir kalibrasyon çalışırken yenisi istendiğinde döndürülür
+var errCalibrationRunning = errors.New("a calibration is already running")
+
+// jobGate struct
+// Admits jobs up to the parallel job limit and holds all of them back while a calibration runs
+// İşleri paralel iş sınırına kadar kabul eder ve bir kalibrasyon çalışırken hepsini bekletir
+type jobGate struct {
+	mu          sync.Mutex
+	running     int
+	calibrating bool
+	changed     chan struct{}
+}
+
+// acquire waits for a free slot, the returned function gives it back
+// The limit is read again on every change, onWait is called once with the reason when the job has to wait
+// Sınır her değişiklikte yeniden okunur, işin beklemesi gerektiğinde onWait nedenle birlikte bir kez çağrılır
+func (g *jobGate) acquire(ctx context.Context, maxRunning func() int, onWait func(reason string)) (func(), error) {
+	waited := false
+	for {
+		g.mu.Lock()
+		if g.changed == nil {
+			g.changed = make(chan struct{})
+		}
+		reason := ""
+		switch limit := maxRunning(); {
+		case g.calibrating:
+			reason = "a calibration is running"
+		case g.running >= limit:
+			reason = fmt.Sprintf("%d of %d parallel jobs are running", g.running, limit)
+		default:
+			g.running++
+			g.mu.Unlock()
+			return g.release, nil
+		}
+		changed := g.changed
+		g.mu.Unlock()
+
+		if !waited {
+			waited = true
+			onWait(reason)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("conversion cancelled: %w", context.Cause(ctx))
+		case <-changed:
+		}
+	}
+}
+
+// release gives the slot of a finished job back and wakes the waiting jobs
+// Biten bir işin yerini geri verir ve bekleyen işleri uyandırır
+func (g *jobGate) release() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.running--
+	g.wake()
+}
+
+// limitChanged lets the waiting jobs check a new parallel job limit
+// Bekleyen işlerin yeni paralel iş sınırını kontrol etmesini sağlar
+func (g *jobGate) limitChanged() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.wake()
+}
+
+// beginCalibration stops new jobs from starting, it fails while jobs or another calibration run
+// Yeni işlerin başlamasını durdurur, işler veya başka bir kalibrasyon çalışırken başarısız olur
+func (g *jobGate) beginCalibration() error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.calibrating {
+		return errCalibrationRunning
+	}
+	if g.running > 0 {
+		return fmt.Errorf("calibration needs an idle machine, wait for the running encodes")
+	}
+	g.calibrating = true
+	return nil
+}
+
+// endCalibration lets the held back jobs start again
+// Bekletilen işlerin yeniden başlamasına izin verir
+func (g *jobGate) endCalibration() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.calibrating = false
+	g.wake()
+}
+
+// wake signals a change to the waiting jobs, the caller must hold mu
+// Bekleyen işlere bir değişikliği bildirir, çağıran mu kilidini tutmalıdır
+func (g *jobGate) wake() {
+	if g.changed != nil {
+		close(g.changed)
+	}
+	g.changed = make(chan struct{})
+}
+
+// parallelJobs returns how many jobs may encode at once
+// Aynı anda kaç işin kodlanabileceğini döndürür
+func (a *App) parallelJobs() int {
+	if jobs := a.currentPreferences().ParallelJobs; jobs > 0 {
+		return jobs
+	}
+	return 1
+}
+
+// admitJob holds a queued job back until it may start, the returned function frees its slot
+// The job isn't running and its timeout hasn't started while it waits for destination space, a calibration or a slot
+// İş hedef alanı, bir kalibrasyonu veya bir yeri beklerken çalışmıyordur ve zaman aşımı başlamamıştır
+func (a *App) admitJob(ctx context.Context, job Job) (func(), error) {
+	if folder, ok := jobDestination(job); ok {
+		if err := a.waitForDestinationSpace(ctx, job.ID, folder); err != nil {
+			return nil, err
+		}
+	}
+	return a.slots.acquire(ctx, a.parallelJobs, func(reason string) {
+		log.Printf("Job %s waits: %s", job.ID, reason)
+		a.addJobEvent(job.ID, "queue", "waiting, "+reason)
+	})
+}
+
+// jobDestination returns the local folder a job writes to, false for remote destinations
+// Bir işin yazdığı yerel klasörü döndürür, uzak hedefler için false
+func jobDestination(job Job) (string, bool) {
+	folder := job.OutputFolder
+	if job.Settings.ReplaceOriginal && !isURLInput(job.InputPath) {
+		folder = filepath.Dir(job.InputPath)
+	}
+	if folder == "" || isRemoteDestination(folder) {
+		return "", false
+	}
+	return folder, true
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"AV1-video-converter/internal/runner"
+)
+
+// TestJobGateLimit admits jobs up to the limit and the next one once a slot is given back
+// İşleri sınıra kadar kabul eder, bir yer geri verildiğinde sıradakini kabul eder
+func TestJobGateLimit(t *testing.T) {
+	var gate jobGate
+	limit := func() int { return 2 }
+	ctx := context.Background()
+	first, _ := gate.acquire(ctx, limit, func(string) { t.Error("first job waited") })
+	gate.acquire(ctx, limit, func(string) { t.Error("second job waited") })
+
+	admitted := make(chan struct{})
+	waited := make(chan string, 1)
+	go func() {
+		gate.acquire(ctx, limit, func(reason string) { waited <- reason })
+		close(admitted)
+	}()
+	if reason := <-waited; reason != "2 of 2 parallel jobs are running" {
+		t.Errorf("wait reason = %q", reason)
+	}
+	first()
+	select {
+	case <-admitted:
+	case <-time.After(time.Second):
+		t.Fatal("third job wasn't admitted after a slot was freed")
+	}
+}
+
+// TestJobGateCalibration holds jobs back while a calibration runs and runs one calibration at a time
+// Bir kalibrasyon çalışırken işleri bekletir ve aynı anda tek kalibrasyon çalıştırır
+func TestJobGateCalibration(t *testing.T) {
+	var gate jobGate
+	limit := func() int { return 4 }
+	if err := gate.beginCalibration(); err != nil {
+		t.Fatalf("beginCalibration: %v", err)
+	}
+	if err := gate.beginCalibration(); !errors.Is(err, errCalibrationRunning) {
+		t.Errorf("second beginCalibration = %v, want errCalibrationRunning", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := gate.acquire(ctx, limit, func(string) {}); err == nil {
+		t.Error("a job started during the calibration")
+	}
+	gate.endCalibration()
+
+	release, err := gate.acquire(context.Background(), limit, func(string) { t.Error("job waited after the calibration") })
+	if err != nil {
+		t.Fatalf("acquire after the calibration: %v", err)
+	}
+	if err := gate.beginCalibration(); err == nil {
+		t.Error("a calibration started next to a running job")
+	}
+	release()
+}
+
+// TestConvertWaitsQueued keeps a held back job queued and cancellable, without starting FFmpeg
+// Bekletilen bir işi FFmpeg'i başlatmadan kuyrukta ve iptal edilebilir tutar
+func TestConvertWaitsQueued(t *testing.T) {
+	a := newTestApp(t, &runner.Fake{Statuses: frames(100)})
+	if err := a.slots.beginCalibration(); err != nil {
+		t.Fatal(err)
+	}
+	defer a.slots.endCalibration()
+
+	done := make(chan error, 1)
+	go func() { done <- a.ConvertVideo(a.jobID) }()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		job, _ := a.getJob(a.jobID)
+		if len(job.Timeline) > 0 {
+			if job.Status != "queued" {
+				t.Errorf("status while waiting = %s, want queued", job.Status)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("the job never started waiting")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if err := a.RemoveJob(a.jobID); err == nil {
+		t.Error("a waiting job was removed")
+	}
+	if err := a.CancelConversion(a.jobID); err != nil {
+		t.Fatalf("CancelConversion: %v", err)
+	}
+	if err := <-done; !errors.Is(err, errCancelledByUser) {
+		t.Errorf("ConvertVideo = %v, want a user cancellation", err)
+	}
+	if len(a.started) != 0 {
+		t.Error("FFmpeg started for a job that never left the queue")
+	}
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// encryptedPrefix marks a config value encrypted with the local key
+// Yerel anahtarla şifrelenmiş bir yapılandırma değerini işaretler
+const encryptedPrefix = "enc:v1:"
+
+// secretKeySize is the AES-256 key size
+// AES-256 anahtar boyutudur
+const secretKeySize = 32
+
+// keychainService names the entry the secret key is stored under in the OS keychain
+// Gizli anahtarın işletim sistemi anahtar zincirinde saklandığı girdiyi adlandırır
+const keychainService = "md-av1-converter"
+
+// errKeychainUnavailable is returned when the OS keychain holds no key or can't be used
+// İşletim sistemi anahtar zinciri anahtar tutmadığında veya kullanılamadığında döndürülür
+var errKeychainUnavailable = errors.New("keychain unavailable")
+
+// secretKey returns the key used to encrypt secrets in the config file
+// The OS keychain is preferred, secret.key next to the config is the fallback
+// A new key is only made while the config holds no encrypted values, a failing keychain is asked again on the next call
+// İşletim sistemi anahtar zinciri tercih edilir, yapılandırmanın yanındaki secret.key yedektir
+func (a *App) secretKey() ([]byte, error) {
+	a.secretMu.Lock()
+	defer a.secretMu.Unlock()
+	if a.secretKeyValue != nil {
+		return a.secretKeyValue, nil
+	}
+
+	if key, err := keychainLoad(a.appDir); err == nil && len(key) == secretKeySize {
+		a.secretKeyValue, a.secretStorage = key, "keychain"
+		return key, nil
+	}
+
+	// Use the key of secret.key, make one only when nothing was encrypted yet
+	// secret.key anahtarını kullan, yalnızca henüz hiçbir şey şifrelenmediyse bir tane oluştur
+	keyPath := filepath.Join(a.appDir, "secret.key")
+	key, err := os.ReadFile(keyPath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err != nil || len(key) != secretKeySize {
+		if a.configHasEncryptedValues() {
+			return nil, fmt.Errorf("the config holds encrypted values but neither the OS keychain nor %s has their key", keyPath)
+		}
+		key = make([]byte, secretKeySize)
+		if _, err := rand.Read(key); err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(keyPath, key, 0600); err != nil {
+			return nil, err
+		}
+	}
+
+	// Move the key into the keychain, secret.key is only removed once the keychain gives it back
+	// Anahtarı anahtar zincirine taşı, secret.key yalnızca anahtar zinciri onu geri verdiğinde silinir
+	if err := keychainStore(a.appDir, key); err != nil {
+		log.Printf("OS keychain unavailable, keeping the secret key in %s: %v", keyPath, err)
+	} else if stored, err := keychainLoad(a.appDir); err != nil || !bytes.Equal(stored, key) {
+		log.Printf("OS keychain didn't return the stored secret key, keeping it in %s", keyPath)
+	} else {
+		if err := os.Remove(keyPath); err != nil && !os.IsNotExist(err) {
+			log.Printf("Error removing %s after moving it to the keychain: %v", keyPath, err)
+		}
+		a.secretKeyValue, a.secretStorage = key, "keychain"
+		return key, nil
+	}
+	a.secretKeyValue, a.secretStorage = key, "file"
+	return key, nil
+}
+
+// configHasEncryptedValues reports whether the config file holds values encrypted with an earlier key
+// Yapılandırma dosyasının daha önceki bir anahtarla şifrelenmiş değerler tutup tutmadığını bildirir
+func (a *App) configHasEncryptedValues() bool {
+	data, err := os.ReadFile(a.configPath)
+	return err == nil && bytes.Contains(data, []byte(encryptedPrefix))
+}
+
+// openSecret decrypts a stored credential, its ciphertext is kept for saveConfig when that fails
+// Saklanan bir kimlik bilgisinin şifresini çözer, başarısız olursa şifreli metni saveConfig için saklanır
+func (a *App) openSecret(id, value string) (string, error) {
+	plain, err := a.decryptSecret(value)
+	a.secretMu.Lock()
+	defer a.secretMu.Unlock()
+	if err != nil {
+		if a.sealedSecrets == nil {
+			a.sealedSecrets = make(map[string]string)
+		}
+		a.sealedSecrets[id] = value
+		return "", err
+	}
+	delete(a.sealedSecrets, id)
+	return plain, nil
+}
+
+// sealSecret encrypts a credential for the config file
+// An empty credential whose stored value couldn't be decrypted keeps that value, so a missing key never wipes it
+// Saklanan değeri çözülemeyen boş bir kimlik bilgisi o değeri korur, böylece eksik bir anahtar onu asla silmez
+func (a *App) sealSecret(id, plain string) string {
+	a.secretMu.Lock()
+	kept := a.sealedSecrets[id]
+	a.secretMu.Unlock()
+	if plain == "" {
+		return kept
+	}
+	sealed, err := a.encryptSecret(plain)
+	if err != nil {
+		log.Printf("Error encrypting %s, keeping the stored value: %v", id, err)
+		return kept
+	}
+	return sealed
+}
+
+// forgetSealedSecret drops the kept ciphertext of a credential the user set or cleared
+// Kullanıcının ayarladığı veya temizlediği bir kimlik bilgisinin saklanan şifreli metnini bırakır
+func (a *App) forgetSealedSecret(id string) {
+	a.secretMu.Lock()
+	defer a.secretMu.Unlock()
+	delete(a.sealedSecrets, id)
+}
+
+// encryptSecret encrypts a value with AES-GCM for storage in the config
+// Bir değeri yapılandırmada saklamak için AES-GCM ile şifreler
+func (a *App) encryptSecret(plain string) (string, error) {
+	if plain == "" {
+		return "", nil
+	}
+	gcm, err := a.secretCipher()
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := gcm.Seal(nonce, nonce, []byte(plain), nil)
+	return encryptedPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// decryptSecret reverses encryptSecret, plain values are returned unchanged
+// encryptSecret işlemini tersine çevirir, düz değerler olduğu gibi döndürülür
+func (a *App) decryptSecret(value string) (string, error) {
+	if !strings.HasPrefix(value, encryptedPrefix) {
+		return value, nil
+	}
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value, encryptedPrefix))
+	if err != nil {
+		return "", err
+	}
+	gcm, err := a.secretCipher()
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return "", fmt.Errorf("encrypted value is too short")
+	}
+	plain, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt value, was secret.key replaced? %v", err)
+	}
+	return string(plain), nil
+}
+
+// secretCipher creates the AES-GCM cipher of the local key
+// Yerel anahtarın AES-GCM şifreleyicisini oluşturur
+func (a *App) secretCipher() (cipher.AEAD, error) {
+	key, err := a.secretKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load secret key: %v", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestMissingSecretKeyKeepsValues refuses to make a new key for encrypted values and saves their ciphertext back
+// Şifreli değerler için yeni bir anahtar oluşturmayı reddeder ve şifreli metinlerini geri kaydeder
+func TestMissingSecretKeyKeepsValues(t *testing.T) {
+	a := NewApp()
+	a.appDir = t.TempDir()
+	a.configPath = filepath.Join(a.appDir, "config.json")
+	sealed := encryptedPrefix + "c2VhbGVkIGJ5IGEgbG9zdCBrZXkgYW5kIGxvbmcgZW5vdWdo"
+	config := appConfig{Settings: defaultSettings(), Preferences: defaultPreferences()}
+	config.Preferences.APIKey = sealed
+	data, _ := json.Marshal(config)
+	if err := os.WriteFile(a.configPath, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := a.secretKey(); err == nil {
+		t.Fatal("secretKey made a key although the config holds encrypted values")
+	}
+	if _, err := os.Stat(filepath.Join(a.appDir, "secret.key")); !os.IsNotExist(err) {
+		t.Errorf("secret.key was written: %v", err)
+	}
+
+	a.loadConfig()
+	if key := a.currentPreferences().APIKey; key != "" {
+		t.Errorf("API key = %q, want it empty while it can't be decrypted", key)
+	}
+	a.saveConfig()
+	data, err := os.ReadFile(a.configPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var saved appConfig
+	if err := json.Unmarshal(data, &saved); err != nil {
+		t.Fatal(err)
+	}
+	if saved.Preferences.APIKey != sealed {
+		t.Errorf("saved API key = %q, want the stored ciphertext", saved.Preferences.APIKey)
+	}
+}
@@ -0,0 +1,564 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"AV1-video-converter/internal/command"
+)
+
+// ConversionSettings struct
+// Represents the encoder settings used for a conversion
+// Bir dönüştürme için kullanılan kodlayıcı ayarlarını temsil eder
+type ConversionSettings struct {
+	Encoder                 string         `json:"encoder"`                 // FFmpeg video encoder name / FFmpeg video kodlayıcı adı
+	CRF                     int            `json:"crf"`                     // Constant quality value / Sabit kalite değeri
+	Preset                  string         `json:"preset"`                  // Encoder speed preset / Kodlayıcı hız ön ayarı
+	PixelFormat             string         `json:"pixelFormat"`             // Output pixel format, empty keeps source / Çıktı piksel formatı, boşsa kaynak korunur
+	Level                   string         `json:"level"`                   // AV1 level, empty means auto / AV1 seviyesi, boşsa otomatik
+	Container               string         `json:"container"`               // Output container (mp4, mkv, webm) / Çıktı kapsayıcısı
+	MaxWidth                int            `json:"maxWidth"`                // Maximum output width, 0 for none / En büyük çıktı genişliği, 0 sınırsız
+	MaxHeight               int            `json:"maxHeight"`               // Maximum output height, 0 for none / En büyük çıktı yüksekliği, 0 sınırsız
+	MaxBitrate              int            `json:"maxBitrate"`              // Maximum video bitrate in kbps, 0 for none / Kbps cinsinden en yüksek video bit hızı
+	MaxFileSize             int            `json:"maxFileSize"`             // Target maximum file size in MB, 0 for none / MB cinsinden en büyük dosya boyutu hedefi
+	AudioCodec              string         `json:"audioCodec"`              // Audio encoder or "copy" / Ses kodlayıcı veya "copy"
+	AudioBitrate            int            `json:"audioBitrate"`            // Audio bitrate in kbps / Kbps cinsinden ses bit hızı
+	InverseTelecine         bool           `json:"inverseTelecine"`         // Remove 3:2 pulldown / 3:2 pulldown'u kaldır
+	PreserveTimestamps      bool           `json:"preserveTimestamps"`      // Copy source times and permissions / Kaynak zamanlarını ve izinlerini kopyala
+	CopySidecars            bool           `json:"copySidecars"`            // Copy subtitles, NFO and artwork next to the output / Altyazı, NFO ve görselleri çıktının yanına kopyala
+	KeepAudioLanguages      []string       `json:"keepAudioLanguages"`      // Audio languages to keep, empty keeps all / Tutulacak ses dilleri, boşsa hepsi
+	KeepSubtitleLanguages   []string       `json:"keepSubtitleLanguages"`   // Subtitle languages to keep, empty keeps all / Tutulacak altyazı dilleri, boşsa hepsi
+	DefaultAudioLanguage    string         `json:"defaultAudioLanguage"`    // Language of the default audio track, empty keeps source flags / Varsayılan ses parçasının dili
+	DefaultSubtitleLanguage string         `json:"defaultSubtitleLanguage"` // Language of the default subtitle, "none" for no default / Varsayılan altyazının dili, "none" varsayılan yok
+	ForcedSubtitleLanguage  string         `json:"forcedSubtitleLanguage"`  // Language of the forced subtitle / Zorunlu altyazının dili
+	AudioFallbackCodec      string         `json:"audioFallbackCodec"`      // Codec for audio MP4 can't hold / MP4'ün tutamadığı ses için kodek
+	AudioFallbackBitrate    int            `json:"audioFallbackBitrate"`    // Bitrate for transcoded audio, 0 picks by channels / Yeniden kodlanan ses için bit hızı, 0 kanala göre seçer
+	Zones                   []Zone         `json:"zones"`                   // Time ranges encoded with their own quality / Kendi kalitesiyle kodlanan zaman aralıkları
+	AutoRelaxZones          bool           `json:"autoRelaxZones"`          // Detect black and static ranges and encode them at a higher CRF / Siyah ve durağan aralıkları algıla ve daha yüksek CRF ile kodla
+	RelaxCRFOffset          int            `json:"relaxCRFOffset"`          // CRF added to detected ranges, 0 for the default / Algılanan aralıklara eklenen CRF, 0 varsayılan
+	ReplaceOriginal         bool           `json:"replaceOriginal"`         // Encode next to the source and swap it in / Kaynağın yanına kodla ve yerine koy
+	ReplaceKeepName         bool           `json:"replaceKeepName"`         // Keep the source file name when the container allows / Kapsayıcı izin verirse kaynak dosya adını koru
+	KeepOriginalBackup      bool           `json:"keepOriginalBackup"`      // Keep the replaced source as .orig / Değiştirilen kaynağı .orig olarak koru
+	DynamicHDR              string         `json:"dynamicHDR"`              // Dolby Vision/HDR10+ handling: static, extract or fail / Dolby Vision/HDR10+ işleme: static, extract veya fail
+	CoverArt                string         `json:"coverArt"`                // Embedded cover: source, image or frame, empty for none / Gömülü kapak: source, image veya frame, boşsa yok
+	CoverArtPath            string         `json:"coverArtPath"`            // JPEG or PNG used by the image cover mode / image kapak modunun kullandığı JPEG veya PNG
+	ChecksumManifest        string         `json:"checksumManifest"`        // Manifest next to each output: json or sfv, empty for none / Her çıktının yanındaki bildirim: json veya sfv, boşsa yok
+	ChecksumBatch           bool           `json:"checksumBatch"`           // Append outputs to checksums.sha256 in their folder / Çıktıları klasörlerindeki checksums.sha256 dosyasına ekle
+	Archival                string         `json:"archival"`                // Preservation profile: lossless or nearlossless, empty for none / Koruma profili: lossless veya nearlossless, boşsa yok
+	LanguageTags            map[int]string `json:"languageTags"`            // Language written for source stream indexes, e.g. 1=tur / Kaynak akış dizinleri için yazılan dil, örn. 1=tur
+	MusicCodec              string         `json:"musicCodec"`              // Encoder for audio-only inputs, empty for Opus / Yalnızca ses girişleri için kodlayıcı, boşsa Opus
+	MusicBitrate            int            `json:"musicBitrate"`            // Bitrate of audio-only outputs in kbps, 0 picks by channels / Kbps cinsinden yalnızca ses çıktılarının bit hızı, 0 kanala göre seçer
+	AudioOffset             int            `json:"audioOffset"`             // Audio delay in milliseconds, negative plays it earlier / Milisaniye cinsinden ses gecikmesi, negatif daha erken çalar
+	ColorOverrides          ColorOverrides `json:"colorOverrides"`          // Real colorimetry of a mistagged source / Yanlış etiketlenmiş bir kaynağın gerçek renk bilgisi
+	AnalyzeSource           bool           `json:"analyzeSource"`           // Report black, frozen and corrupt segments before encoding / Kodlamadan önce siyah, donmuş ve bozuk bölümleri bildir
+}
+
+// ValidationError struct
+// Represents a single invalid setting
+// Geçersiz tek bir ayarı temsil eder
+type ValidationError struct {
+	Field   string `json:"field"`   // Name of the invalid field / Geçersiz alanın adı
+	Value   string `json:"value"`   // Rejected value / Reddedilen değer
+	Message string `json:"message"` // Human readable reason / Okunabilir neden
+}
+
+// ValidationErrors is returned when settings fail validation
+// Ayarlar doğrulamadan geçemediğinde döndürülür
+type ValidationErrors []ValidationError
+
+func (v ValidationErrors) Error() string {
+	messages := make([]string, 0, len(v))
+	for _, e := range v {
+		messages = append(messages, fmt.Sprintf("%s: %s", e.Field, e.Message))
+	}
+	return "invalid settings: " + strings.Join(messages, "; ")
+}
+
+// encoderSpec struct
+// Describes the real constraints of an AV1 encoder or an intermediate codec
+// Bir AV1 kodlayıcısının veya ara kodekin gerçek kısıtlamalarını tanımlar
+type encoderSpec struct {
+	qualityFlag      string   // FFmpeg flag for the quality value / Kalite değeri için FFmpeg bayrağı
+	qualityMin       int      // Minimum quality value / En düşük kalite değeri
+	qualityMax       int      // Maximum quality value / En yüksek kalite değeri
+	presetFlag       string   // FFmpeg flag for the preset / Ön ayar için FFmpeg bayrağı
+	presets          []string // Accepted preset values / Kabul edilen ön ayar değerleri
+	pixelFormats     []string // Accepted pixel formats / Kabul edilen piksel formatları
+	levels           bool     // Encoder accepts a level / Kodlayıcı seviye kabul eder
+	intermediate     string   // Output name tag of an edit-friendly intermediate, empty for AV1 / Düzenlemeye uygun ara kodekin çıktı adı etiketi, AV1 için boş
+	memoryFrames     int      // Frame sized buffers the encoder holds, for the memory estimate / Kodlayıcının tuttuğu kare boyutlu tamponlar, bellek tahmini için
+	threadFrames     int      // Extra frame buffers per thread / İş parçacığı başına ek kare tamponları
+	presetsFastFirst bool     // Presets are listed fastest first, otherwise slowest first / Ön ayarlar en hızlıdan başlayarak listelenir, aksi halde en yavaştan
+}
+
+// encoderSpecs lists the supported AV1 encoders and intermediate codecs
+// Desteklenen AV1 kodlayıcılarını ve ara kodekleri listeler
+var encoderSpecs = map[string]encoderSpec{
+	"libsvtav1": {
+		qualityFlag: "-crf", qualityMin: 0, qualityMax: 63,
+		presetFlag: "-preset", presets: intRange(-1, 13),
+		pixelFormats: []string{"yuv420p", "yuv420p10le"},
+		levels:       true,
+		memoryFrames: 400, threadFrames: 4,
+	},
+	"libaom-av1": {
+		qualityFlag: "-crf", qualityMin: 0, qualityMax: 63,
+		presetFlag: "-cpu-used", presets: intRange(0, 8),
+		pixelFormats: []string{"yuv420p", "yuv422p", "yuv444p", "yuv420p10le", "yuv422p10le", "yuv444p10le"},
+		memoryFrames: 250, threadFrames: 2,
+	},
+	"librav1e": {
+		qualityFlag: "-qp", qualityMin: 0, qualityMax: 255,
+		presetFlag: "-speed", presets: intRange(0, 10),
+		pixelFormats: []string{"yuv420p", "yuv422p", "yuv444p", "yuv420p10le", "yuv422p10le", "yuv444p10le"},
+		memoryFrames: 200, threadFrames: 2,
+	},
+	"av1_nvenc": {
+		qualityFlag: "-cq", qualityMin: 0, qualityMax: 51,
+		presetFlag: "-preset", presets: []string{"p1", "p2", "p3", "p4", "p5", "p6", "p7"},
+		pixelFormats:     []string{"yuv420p", "nv12", "p010le"},
+		levels:           true,
+		memoryFrames:     24,
+		presetsFastFirst: true,
+	},
+	"av1_qsv": {
+		qualityFlag: "-global_quality", qualityMin: 1, qualityMax: 51,
+		presetFlag: "-preset", presets: []string{"veryfast", "faster", "fast", "medium", "slow", "slower", "veryslow"},
+		pixelFormats:     []string{"nv12", "p010le"},
+		levels:           true,
+		memoryFrames:     24,
+		presetsFastFirst: true,
+	},
+	"av1_amf": {
+		qualityFlag: "-qp_i", qualityMin: 0, qualityMax: 255,
+		presetFlag: "-quality", presets: []string{"speed", "balanced", "quality"},
+		pixelFormats:     []string{"yuv420p", "nv12", "p010le"},
+		levels:           true,
+		memoryFrames:     24,
+		presetsFastFirst: true,
+	},
+
+	// Intermediates have no quality value, the preset picks the profile
+	// Ara kodeklerin kalite değeri yoktur, ön ayar profili seçer
+	"prores_ks": {
+		presetFlag: "-profile:v", presets: intRange(0, 5),
+		pixelFormats: []string{"yuv422p10le", "yuv444p10le", "yuva444p10le"},
+		intermediate: "prores",
+		memoryFrames: 12, threadFrames: 1,
+	},
+	"dnxhd": {
+		presetFlag: "-profile:v", presets: []string{"dnxhr_lb", "dnxhr_sq", "dnxhr_hq", "dnxhr_hqx", "dnxhr_444"},
+		pixelFormats: []string{"yuv422p", "yuv422p10le", "yuv444p10le"},
+		intermediate: "dnxhr",
+		memoryFrames: 12, threadFrames: 1,
+	},
+}
+
+// intermediateContainers lists the containers edit-friendly intermediates may use
+// Düzenlemeye uygun ara kodeklerin kullanabileceği kapsayıcıları listeler
+var intermediateContainers = []string{"mov", "mkv"}
+
+// containerAudioCodecs lists the audio codecs allowed in each container
+// Her kapsayıcıda izin verilen ses kodeklerini listeler
+var containerAudioCodecs = map[string][]string{
+	"mp4":  {"copy", "aac", "libopus"},
+	"mov":  {"copy", "aac", "pcm_s16le", "pcm_s24le"},
+	"mkv":  {"copy", "aac", "libopus", "flac"},
+	"webm": {"libopus"},
+}
+
+// fallbackAudioCodecs lists codecs used for audio MP4 can't hold
+// MP4'ün tutamadığı sesler için kullanılan kodekleri listeler
+var fallbackAudioCodecs = []string{"aac", "libopus", "ac3", "eac3"}
+
+// languageCodeRegex matches ISO 639 language codes
+// ISO 639 dil kodlarıyla eşleşir
+var languageCodeRegex = regexp.MustCompile(`^[A-Za-z]{2,3}$`)
+
+// av1Levels lists the levels defined by the AV1 specification
+// AV1 spesifikasyonunda tanımlanan seviyeleri listeler
+var av1Levels = []string{"2.0", "2.1", "3.0", "3.1", "4.0", "4.1", "5.0", "5.1", "5.2", "5.3", "6.0", "6.1", "6.2", "6.3"}
+
+// defaultSettings returns the settings used before any are saved
+// Hiçbir ayar kaydedilmeden önce kullanılan ayarları döndürür
+func defaultSettings() ConversionSettings {
+	return ConversionSettings{
+		Encoder:            "libsvtav1",
+		CRF:                30,
+		Preset:             "6",
+		Container:          "mp4",
+		AudioCodec:         "copy",
+		AudioFallbackCodec: "aac",
+		DynamicHDR:         "static",
+	}
+}
+
+// GetSettings returns the current conversion settings
+// Retrieves the settings used for new conversions
+// Yeni dönüştürmeler için kullanılan ayarları alır
+func (a *App) GetSettings() ConversionSettings {
+	a.configMu.RLock()
+	defer a.configMu.RUnlock()
+	return a.settings
+}
+
+// SaveSettings validates and stores the conversion settings
+// Rejects invalid settings and persists valid ones to the config file
+// Geçersiz ayarları reddeder ve geçerli olanları yapılandırma dosyasına kaydeder
+func (a *App) SaveSettings(settings ConversionSettings) error {
+	if errs := a.validateSettingsForBuild(settings); len(errs) > 0 {
+		log.Printf("Rejected settings: %v", errs)
+		return errs
+	}
+	a.configMu.Lock()
+	a.settings = settings
+	a.configMu.Unlock()
+	a.saveConfig()
+	return nil
+}
+
+// ValidateSettings checks the settings against the encoder constraints
+// Returns every invalid field so the frontend can highlight them
+// Ön yüzün vurgulayabilmesi için geçersiz tüm alanları döndürür
+func (a *App) ValidateSettings(settings ConversionSettings) []ValidationError {
+	return a.validateSettingsForBuild(settings)
+}
+
+// validateSettings checks the settings against the selected encoder's limits
+// Ayarları seçilen kodlayıcının sınırlarına göre kontrol eder
+func validateSettings(settings ConversionSettings) ValidationErrors {
+	var errs ValidationErrors
+
+	spec, ok := encoderSpecs[settings.Encoder]
+	if !ok {
+		return append(errs, ValidationError{
+			Field:   "encoder",
+			Value:   settings.Encoder,
+			Message: "unsupported encoder",
+		})
+	}
+
+	// Check the quality range, intermediates have none
+	// Kalite aralığını kontrol et, ara kodeklerde yoktur
+	if spec.qualityFlag != "" && (settings.CRF < spec.qualityMin || settings.CRF > spec.qualityMax) {
+		errs = append(errs, ValidationError{
+			Field:   "crf",
+			Value:   strconv.Itoa(settings.CRF),
+			Message: fmt.Sprintf("must be between %d and %d for %s", spec.qualityMin, spec.qualityMax, settings.Encoder),
+		})
+	}
+
+	// Check the preset
+	// Ön ayarı kontrol et
+	if !containsString(spec.presets, settings.Preset) {
+		errs = append(errs, ValidationError{
+			Field:   "preset",
+			Value:   settings.Preset,
+			Message: fmt.Sprintf("must be one of %s for %s", strings.Join(spec.presets, ", "), settings.Encoder),
+		})
+	}
+
+	// Check the pixel format
+	// Piksel formatını kontrol et
+	if settings.PixelFormat != "" && !containsString(spec.pixelFormats, settings.PixelFormat) {
+		errs = append(errs, ValidationError{
+			Field:   "pixelFormat",
+			Value:   settings.PixelFormat,
+			Message: fmt.Sprintf("must be one of %s for %s", strings.Join(spec.pixelFormats, ", "), settings.Encoder),
+		})
+	}
+
+	// Check the level
+	// Seviyeyi kontrol et
+	if settings.Level != "" {
+		if !spec.levels {
+			errs = append(errs, ValidationError{
+				Field:   "level",
+				Value:   settings.Level,
+				Message: fmt.Sprintf("%s does not accept a level", settings.Encoder),
+			})
+		} else if !containsString(av1Levels, settings.Level) {
+			errs = append(errs, ValidationError{
+				Field:   "level",
+				Value:   settings.Level,
+				Message: "must be a valid AV1 level (2.0 - 6.3)",
+			})
+		}
+	}
+
+	// Check the container and its audio codec
+	// Kapsayıcıyı ve ses kodekini kontrol et
+	audioCodecs, ok := containerAudioCodecs[settings.Container]
+	if !ok {
+		errs = append(errs, ValidationError{
+			Field:   "container",
+			Value:   settings.Container,
+			Message: "must be one of mp4, mov, mkv, webm",
+		})
+	} else if !containsString(audioCodecs, settings.AudioCodec) {
+		errs = append(errs, ValidationError{
+			Field:   "audioCodec",
+			Value:   settings.AudioCodec,
+			Message: fmt.Sprintf("must be one of %s for %s", strings.Join(audioCodecs, ", "), settings.Container),
+		})
+	}
+
+	// Check the codec used for MP4-incompatible audio
+	// MP4 uyumsuz ses için kullanılan kodeki kontrol et
+	if !containsString(fallbackAudioCodecs, settings.AudioFallbackCodec) {
+		errs = append(errs, ValidationError{
+			Field:   "audioFallbackCodec",
+			Value:   settings.AudioFallbackCodec,
+			Message: fmt.Sprintf("must be one of %s", strings.Join(fallbackAudioCodecs, ", ")),
+		})
+	}
+
+	// Check the audio offset, anything larger is a wrong file rather than drift
+	// Ses kaymasını kontrol et, daha büyüğü kaymadan çok yanlış bir dosyadır
+	if settings.AudioOffset < -maxAudioOffset || settings.AudioOffset > maxAudioOffset {
+		errs = append(errs, ValidationError{
+			Field:   "audioOffset",
+			Value:   strconv.Itoa(settings.AudioOffset),
+			Message: fmt.Sprintf("must be between -%d and %d ms", maxAudioOffset, maxAudioOffset),
+		})
+	}
+
+	// Check the color overrides
+	// Renk geçersiz kılmalarını kontrol et
+	errs = append(errs, validateColorOverrides(settings.ColorOverrides)...)
+
+	// Check the encoder of audio-only inputs, empty means Opus
+	// Yalnızca ses girişlerinin kodlayıcısını kontrol et, boş Opus demektir
+	if _, ok := findMusicFormat(settings.MusicCodec); !ok {
+		errs = append(errs, ValidationError{
+			Field:   "musicCodec",
+			Value:   settings.MusicCodec,
+			Message: fmt.Sprintf("must be one of %s", strings.Join(musicEncoders(), ", ")),
+		})
+	}
+
+	// Check how dynamic HDR metadata is handled, empty means static
+	// Dinamik HDR meta verisinin nasıl işlendiğini kontrol et, boş static demektir
+	if settings.DynamicHDR != "" && !containsString(dynamicHDRModes, settings.DynamicHDR) {
+		errs = append(errs, ValidationError{
+			Field:   "dynamicHDR",
+			Value:   settings.DynamicHDR,
+			Message: fmt.Sprintf("must be one of %s", strings.Join(dynamicHDRModes, ", ")),
+		})
+	}
+
+	// Check the cover art source, a user image must be a JPEG or PNG
+	// Kapak görseli kaynağını kontrol et, kullanıcı resmi JPEG veya PNG olmalıdır
+	if settings.CoverArt != "" && !containsString(coverArtModes, settings.CoverArt) {
+		errs = append(errs, ValidationError{
+			Field:   "coverArt",
+			Value:   settings.CoverArt,
+			Message: fmt.Sprintf("must be one of %s", strings.Join(coverArtModes, ", ")),
+		})
+	}
+	if settings.CoverArt == "image" && !containsString([]string{".jpg", ".jpeg", ".png"}, strings.ToLower(filepath.Ext(settings.CoverArtPath))) {
+		errs = append(errs, ValidationError{
+			Field:   "coverArtPath",
+			Value:   settings.CoverArtPath,
+			Message: "must be a JPEG or PNG image",
+		})
+	}
+
+	// Check the checksum manifest format
+	// Sağlama toplamı bildirim biçimini kontrol et
+	if settings.ChecksumManifest != "" && !containsString(checksumManifestModes, settings.ChecksumManifest) {
+		errs = append(errs, ValidationError{
+			Field:   "checksumManifest",
+			Value:   settings.ChecksumManifest,
+			Message: fmt.Sprintf("must be one of %s", strings.Join(checksumManifestModes, ", ")),
+		})
+	}
+
+	// Check the limits
+	// Sınırları kontrol et
+	limits := []struct {
+		field string
+		value int
+	}{
+		{"maxWidth", settings.MaxWidth},
+		{"maxHeight", settings.MaxHeight},
+		{"maxBitrate", settings.MaxBitrate},
+		{"maxFileSize", settings.MaxFileSize},
+		{"audioBitrate", settings.AudioBitrate},
+		{"audioFallbackBitrate", settings.AudioFallbackBitrate},
+		{"musicBitrate", settings.MusicBitrate},
+		{"relaxCRFOffset", settings.RelaxCRFOffset},
+	}
+	for _, limit := range limits {
+		if limit.value < 0 {
+			errs = append(errs, ValidationError{
+				Field:   limit.field,
+				Value:   strconv.Itoa(limit.value),
+				Message: "must not be negative",
+			})
+		}
+	}
+	// Check the language rules
+	// Dil kurallarını kontrol et
+	for _, rule := range []struct {
+		field string
+		codes []string
+	}{
+		{"keepAudioLanguages", settings.KeepAudioLanguages},
+		{"keepSubtitleLanguages", settings.KeepSubtitleLanguages},
+	} {
+		for _, code := range rule.codes {
+			if !languageCodeRegex.MatchString(strings.TrimSpace(code)) {
+				errs = append(errs, ValidationError{
+					Field:   rule.field,
+					Value:   code,
+					Message: "must be a 2 or 3 letter language code",
+				})
+			}
+		}
+	}
+
+	// Check the language re-tagging
+	// Dil yeniden etiketlemesini kontrol et
+	for index, code := range settings.LanguageTags {
+		if index < 0 || !languageCodeRegex.MatchString(code) {
+			errs = append(errs, ValidationError{
+				Field:   "languageTags",
+				Value:   fmt.Sprintf("%d=%s", index, code),
+				Message: "must map a stream index to a 2 or 3 letter language code",
+			})
+		}
+	}
+
+	// Check the disposition rules
+	// İşaret kurallarını kontrol et
+	for _, rule := range []struct {
+		field string
+		code  string
+	}{
+		{"defaultAudioLanguage", settings.DefaultAudioLanguage},
+		{"defaultSubtitleLanguage", settings.DefaultSubtitleLanguage},
+		{"forcedSubtitleLanguage", settings.ForcedSubtitleLanguage},
+	} {
+		if rule.code != "" && rule.code != "none" && !languageCodeRegex.MatchString(rule.code) {
+			errs = append(errs, ValidationError{
+				Field:   rule.field,
+				Value:   rule.code,
+				Message: `must be a 2 or 3 letter language code or "none"`,
+			})
+		}
+	}
+
+	// Check what an intermediate can't do, it is meant for editing, not for replacing files
+	// Bir ara kodekin yapamadıklarını kontrol et, dosya değiştirmek için değil düzenleme içindir
+	if spec.intermediate != "" {
+		if !containsString(intermediateContainers, settings.Container) {
+			errs = append(errs, ValidationError{
+				Field:   "container",
+				Value:   settings.Container,
+				Message: fmt.Sprintf("must be one of %s for %s", strings.Join(intermediateContainers, ", "), settings.Encoder),
+			})
+		}
+		if len(settings.Zones) > 0 || settings.AutoRelaxZones || settings.ReplaceOriginal || settings.Archival != "" {
+			errs = append(errs, ValidationError{
+				Field:   "encoder",
+				Value:   settings.Encoder,
+				Message: "quality zones, replacing originals and archiving need an AV1 encoder",
+			})
+		}
+	}
+
+	// Check the quality zones
+	// Kalite bölgelerini kontrol et
+	errs = append(errs, validateZones(settings, spec)...)
+
+	// Check that an archival profile keeps the picture untouched
+	// Bir arşiv profilinin görüntüye dokunmadığını kontrol et
+	errs = append(errs, validateArchival(settings)...)
+
+	if settings.MaxWidth%2 != 0 || settings.MaxHeight%2 != 0 {
+		errs = append(errs, ValidationError{
+			Field:   "maxWidth",
+			Value:   fmt.Sprintf("%dx%d", settings.MaxWidth, settings.MaxHeight),
+			Message: "dimensions must be even",
+		})
+	}
+
+	return errs
+}
+
+// encoderArgs builds the FFmpeg video encoder arguments for the settings
+// Ayarlar için FFmpeg video kodlayıcı argümanlarını oluşturur
+func encoderArgs(settings ConversionSettings) []string {
+	spec := encoderSpecs[settings.Encoder]
+	pixelFormat := settings.PixelFormat
+	if pixelFormat == "" {
+		pixelFormat = intermediatePixelFormat(settings)
+	}
+	return command.EncoderArgs(command.Encoder{
+		Codec:       settings.Encoder,
+		QualityFlag: spec.qualityFlag,
+		Quality:     settings.CRF,
+		PresetFlag:  spec.presetFlag,
+		Preset:      settings.Preset,
+		Level:       settings.Level,
+		PixelFormat: pixelFormat,
+		Lossless:    settings.Archival == "lossless",
+	})
+}
+
+// intermediatePixelFormat returns the pixel format an intermediate profile requires
+// FFmpeg would otherwise pick 8-bit 4:2:2 for 10-bit and 4:4:4 profiles and fail
+// Aksi halde FFmpeg 10 bit ve 4:4:4 profiller için 8 bit 4:2:2 seçer ve başarısız olur
+func intermediatePixelFormat(settings ConversionSettings) string {
+	switch settings.Encoder {
+	case "prores_ks":
+		if settings.Preset == "4" || settings.Preset == "5" {
+			return "yuv444p10le"
+		}
+		return "yuv422p10le"
+	case "dnxhd":
+		switch settings.Preset {
+		case "dnxhr_hqx":
+			return "yuv422p10le"
+		case "dnxhr_444":
+			return "yuv444p10le"
+		}
+		return "yuv422p"
+	}
+	return ""
+}
+
+// outputTag returns the tag output file names carry for the encoder
+// Çıktı dosya adlarının kodlayıcı için taşıdığı etiketi döndürür
+func outputTag(settings ConversionSettings) string {
+	if tag := encoderSpecs[settings.Encoder].intermediate; tag != "" {
+		return tag
+	}
+	return "av1"
+}
+
+// intRange returns the integers from min to max as strings
+// min ile max arasındaki tam sayıları metin olarak döndürür
+func intRange(min, max int) []string {
+	values := make([]string, 0, max-min+1)
+	for i := min; i <= max; i++ {
+		values = append(values, strconv.Itoa(i))
+	}
+	return values
+}
+
+// containsString reports whether the list contains the value
+// Listenin değeri içerip içermediğini bildirir
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// sidecarExtensions lists the files that belong to a video in media libraries
+// Medya kütüphanelerinde bir videoya ait olan dosyaları listeler
+var sidecarExtensions = []string{".srt", ".ass", ".ssa", ".vtt", ".sub", ".idx", ".nfo", ".jpg", ".jpeg", ".png"}
+
+// folderArtNames lists folder-level artwork used by Plex and Jellyfin
+// Plex ve Jellyfin tarafından kullanılan klasör düzeyi görselleri listeler
+var folderArtNames = []string{"poster", "folder", "cover", "fanart", "backdrop"}
+
+// copySidecars copies the sidecar files of the source next to the output
+// Files are renamed so they keep matching the output name
+// Dosyalar çıktı adıyla eşleşmeye devam etmeleri için yeniden adlandırılır
+func (a *App) copySidecars(inputPath, outputPath string) []string {
+	sourceDir := filepath.Dir(inputPath)
+	sourceBase := strings.TrimSuffix(filepath.Base(inputPath), filepath.Ext(inputPath))
+	outputDir := filepath.Dir(outputPath)
+	outputBase := strings.TrimSuffix(filepath.Base(outputPath), filepath.Ext(outputPath))
+
+	entries, err := os.ReadDir(sourceDir)
+	if err != nil {
+		log.Printf("Error reading source folder for sidecars: %v", err)
+		return nil
+	}
+
+	// Videos whose name extends the source's, e.g. "Movie-2.mkv" or "Movie.Part2.mkv" next to "Movie.mkv"
+	// Adı kaynağınkini uzatan videolar, örn. "Movie.mkv" yanında "Movie-2.mkv" veya "Movie.Part2.mkv"
+	var siblings []string
+	for _, entry := range entries {
+		name := entry.Name()
+		stem := strings.TrimSuffix(name, filepath.Ext(name))
+		if !entry.IsDir() && a.isVideoFile(name) && stem != sourceBase && sidecarOf(name, sourceBase) {
+			siblings = append(siblings, stem)
+		}
+	}
+
+	var copied []string
+	for _, entry := range entries {
+		name := entry.Name()
+		ext := strings.ToLower(filepath.Ext(name))
+		if entry.IsDir() || !containsString(sidecarExtensions, ext) {
+			continue
+		}
+
+		// "Movie.en.srt" and "Movie-poster.jpg" follow the video name,
+		// "poster.jpg" belongs to the folder and becomes "<output>-poster.jpg"
+		// "poster.jpg" klasöre aittir ve "<çıktı>-poster.jpg" olur
+		var targetName string
+		stem := strings.TrimSuffix(name, filepath.Ext(name))
+		switch {
+		case sidecarOf(name, sourceBase):
+			if sibling := siblingOwner(name, siblings); sibling != "" {
+				log.Printf("Sidecar %s belongs to %s, skipping", name, sibling)
+				continue
+			}
+			targetName = outputBase + strings.TrimPrefix(name, sourceBase)
+		case containsString(folderArtNames, strings.ToLower(stem)):
+			targetName = outputBase + "-" + strings.ToLower(stem) + ext
+		default:
+			continue
+		}
+
+		target := filepath.Join(outputDir, targetName)
+		if _, err := os.Stat(target); err == nil {
+			log.Printf("Sidecar already exists, skipping: %s", target)
+			continue
+		}
+		if err := copyFile(filepath.Join(sourceDir, name), target); err != nil {
+			log.Printf("Error copying sidecar %s: %v", name, err)
+			continue
+		}
+		log.Printf("Copied sidecar: %s", target)
+		copied = append(copied, target)
+	}
+	return copied
+}
+
+// sidecarOf reports whether a file name follows a video name, e.g. "Movie.en.srt" for "Movie"
+// Bir dosya adının bir video adını izleyip izlemediğini bildirir, örn. "Movie" için "Movie.en.srt"
+func sidecarOf(name, videoBase string) bool {
+	return strings.HasPrefix(name, videoBase+".") || strings.HasPrefix(name, videoBase+"-")
+}
+
+// siblingOwner returns the sibling video a sidecar follows, empty if none does
+// Bir yan dosyanın izlediği kardeş videoyu döndürür, hiçbiri izlemiyorsa boş
+func siblingOwner(name string, siblings []string) string {
+	for _, sibling := range siblings {
+		if sidecarOf(name, sibling) {
+			return sibling
+		}
+	}
+	return ""
+}
+
+// copyFile copies a file's contents to a new path
+// Bir dosyanın içeriğini yeni bir yola kopyalar
+func copyFile(sourcePath, targetPath string) error {
+	source, err := os.Open(sourcePath)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	target, err := os.Create(targetPath)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(target, source); err != nil {
+		target.Close()
+		os.Remove(targetPath)
+		return err
+	}
+	return target.Close()
+}
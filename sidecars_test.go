@@ -0,0 +1,32 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+// TestCopySidecarsSkipsSiblings leaves the sidecars of "Movie-2.mkv" and "Movie.Part2.mkv" to those videos
+// "Movie-2.mkv" ve "Movie.Part2.mkv" yan dosyalarını o videolara bırakır
+func TestCopySidecarsSkipsSiblings(t *testing.T) {
+	source, output := t.TempDir(), t.TempDir()
+	for _, name := range []string{
+		"Movie.mkv", "Movie.en.srt", "Movie-poster.jpg", "poster.jpg",
+		"Movie-2.mkv", "Movie-2.srt", "Movie.Part2.mkv", "Movie.Part2.en.srt",
+	} {
+		if err := os.WriteFile(filepath.Join(source, name), nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	copied := NewApp().copySidecars(filepath.Join(source, "Movie.mkv"), filepath.Join(output, "Movie-av1.mkv"))
+	for i, path := range copied {
+		copied[i] = filepath.Base(path)
+	}
+	slices.Sort(copied)
+	want := []string{"Movie-av1-poster.jpg", "Movie-av1.en.srt"}
+	if !slices.Equal(copied, want) {
+		t.Errorf("copied %v, want %v", copied, want)
+	}
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	// sleepCheckInterval is how often the clocks are compared to notice a system sleep
+	// Bir sistem uykusunu fark etmek için saatlerin karşılaştırılma sıklığı
+	sleepCheckInterval = 15 * time.Second
+
+	// sleepThreshold is the smallest gap between the clocks treated as a sleep
+	// Uyku olarak kabul edilen saatler arasındaki en küçük fark
+	sleepThreshold = time.Minute
+
+	// wakeGracePeriod is how long an encode may take to move again after a wake
+	// Bir kodlamanın uyanmadan sonra yeniden ilerlemesi için tanınan süre
+	wakeGracePeriod = 2 * time.Minute
+)
+
+// watchSleep notices the system waking from sleep and resumes the queue
+// The monotonic clock stops while the system sleeps and the wall clock doesn't, the gap is the sleep
+// Sistem uyurken monoton saat durur, duvar saati durmaz, aradaki fark uykudur
+func (a *App) watchSleep(ctx context.Context) {
+	ticker := time.NewTicker(sleepCheckInterval)
+	defer ticker.Stop()
+
+	last := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if slept := now.Round(0).Sub(last.Round(0)) - now.Sub(last); slept > sleepThreshold {
+				a.handleWake(ctx, last.Round(0), slept)
+			}
+			last = now
+		}
+	}
+}
+
+// handleWake checks the running jobs and puts the jobs that failed during the sleep back into the queue
+// The queue is reported again so the frontend or the server queue moves on
+// Ön yüz veya sunucu kuyruğu ilerlesin diye kuyruk yeniden bildirilir
+func (a *App) handleWake(ctx context.Context, sleptAt time.Time, slept time.Duration) {
+	wokeAt := time.Now()
+	a.sleepMu.Lock()
+	a.lastWake = wokeAt
+	a.sleepMu.Unlock()
+	log.Printf("System woke after sleeping for %s", slept.Round(time.Second))
+
+	running, requeued := 0, 0
+	for _, job := range a.GetJobs() {
+		switch {
+		case job.Status == "running":
+			running++
+			a.addJobEvent(job.ID, "sleep", fmt.Sprintf("system slept for %s", slept.Round(time.Second)))
+			go func(jobID string) {
+				defer a.recoverCrash("revalidateAfterWake")
+				a.revalidateAfterWake(ctx, jobID, wokeAt)
+			}(job.ID)
+		case job.Status == "failed" && failedSince(job, sleptAt):
+			if err := a.requeueJob(job, "queued again after the system slept"); err != nil {
+				log.Printf("Error requeueing job %s after sleep: %v", job.ID, err)
+				continue
+			}
+			requeued++
+		}
+	}
+
+	a.events.Emit("system:woke", map[string]interface{}{
+		"sleptSeconds": slept.Seconds(),
+		"running":      running,
+		"requeued":     requeued,
+	})
+	a.emitQueueUpdated()
+}
+
+// failedSince reports whether a job failed after the given time for another reason than the user
+// Bir işin verilen zamandan sonra kullanıcı dışında bir nedenle başarısız olup olmadığını bildirir
+func failedSince(job Job, since time.Time) bool {
+	if len(job.Timeline) == 0 {
+		return false
+	}
+	last := job.Timeline[len(job.Timeline)-1]
+	return last.Stage == "failed" && !last.Time.Before(since) && job.Error != "" &&
+		!strings.Contains(job.Error, errCancelledByUser.Error())
+}
+
+// revalidateAfterWake restarts a running job whose output vanished or that doesn't move after a wake
+// Network shares often drop their files over a sleep, FFmpeg then hangs or writes nowhere
+// Ağ paylaşımları uyku sırasında dosyalarını sıklıkla düşürür, FFmpeg o zaman takılır veya hiçbir yere yazmaz
+func (a *App) revalidateAfterWake(ctx context.Context, jobID string, wokeAt time.Time) {
+	job, ok := a.getJob(jobID)
+	if !ok || job.Status != "running" {
+		return
+	}
+	for _, path := range job.writing {
+		if _, err := os.Stat(path); err != nil {
+			a.restartAfterWake(jobID, fmt.Sprintf("partial output %s is gone after the sleep", path))
+			return
+		}
+	}
+
+	select {
+	case <-ctx.Done():
+		return
+	case <-time.After(wakeGracePeriod):
+	}
+
+	// Only encodes are judged, other phases don't report their progress
+	// Yalnızca kodlamalar değerlendirilir, diğer aşamalar ilerlemelerini bildirmez
+	job, ok = a.getJob(jobID)
+	if ok && job.Status == "running" && !job.advancedAt.IsZero() && job.advancedAt.Before(wokeAt) {
+		a.restartAfterWake(jobID, fmt.Sprintf("no progress for %s after the sleep", wakeGracePeriod))
+	}
+}
+
+// restartAfterWake cancels the current attempt of a job so ConvertVideo starts it again
+// Bir işin mevcut denemesini iptal eder, böylece ConvertVideo onu yeniden başlatır
+func (a *App) restartAfterWake(jobID, reason string) {
+	var restart context.CancelCauseFunc
+	a.jobs.Update(jobID, func(job *Job) { restart = job.restart })
+	if restart == nil {
+		return
+	}
+	log.Printf("Restarting job %s: %s", jobID, reason)
+	a.addJobEvent(jobID, "sleep", reason+", restarting")
+	restart(errSuspended)
+}
+
+// interruptedBySleep reports whether an attempt started at start failed because of a system sleep
+// Failures shortly after a wake count too, FFmpeg often dies on a dropped network share
+// Uyanmadan kısa süre sonraki hatalar da sayılır, FFmpeg düşen bir ağ paylaşımında sıklıkla ölür
+func (a *App) interruptedBySleep(start time.Time, err error) bool {
+	if errors.Is(err, errSuspended) {
+		return true
+	}
+	a.sleepMu.Lock()
+	wokeAt := a.lastWake
+	a.sleepMu.Unlock()
+	return wokeAt.After(start) && time.Since(wokeAt) < wakeGracePeriod
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"regexp"
+	"strings"
+	"time"
+
+	"AV1-video-converter/internal/events"
+	"AV1-video-converter/internal/runner"
+)
+
+// sourceReportArtifact is the file the source report is stored in next to the job logs
+// Kaynak raporunun iş loglarının yanında saklandığı dosya
+const sourceReportArtifact = "source_report.json"
+
+// maxDecodeErrorSamples limits the decoder messages kept in a report
+// Bir raporda tutulan kod çözücü mesajlarını sınırlar
+const maxDecodeErrorSamples = 20
+
+// sourceCheckFilter finds black and frozen video, shorter ranges than the zone analysis cares about
+// Siyah ve donmuş videoyu bulur, bölge analizinin önemsediğinden daha kısa aralıklar
+const sourceCheckFilter = "scale=320:-2,blackdetect=d=1:pic_th=0.98:pix_th=0.10,freezedetect=n=0.001:d=5"
+
+// decodeErrorRegex matches decoder complaints such as "[h264 @ 0x...] error while decoding MB"
+// "[h264 @ 0x...] error while decoding MB" gibi kod çözücü şikayetleriyle eşleşir
+var decodeErrorRegex = regexp.MustCompile(`(?i)^\[[\w-]+ @ 0x[0-9a-f]+\] .*(error|corrupt|invalid|missing|concealing|non-existing)`)
+
+// MediaIssue struct
+// Represents a problematic range of the source
+// Kaynağın sorunlu bir aralığını temsil eder
+type MediaIssue struct {
+	Kind  string  `json:"kind"`  // black or frozen / black veya frozen
+	Start float64 `json:"start"` // Start in seconds / Saniye cinsinden başlangıç
+	End   float64 `json:"end"`   // End in seconds / Saniye cinsinden bitiş
+}
+
+// SourceReport struct
+// Represents the problems found by decoding the whole source
+// Kaynağın tamamının kodu çözülerek bulunan sorunları temsil eder
+type SourceReport struct {
+	Path         string       `json:"path"`         // Checked file / Kontrol edilen dosya
+	CheckedAt    time.Time    `json:"checkedAt"`    // Time of the check / Kontrol zamanı
+	Issues       []MediaIssue `json:"issues"`       // Black and frozen ranges / Siyah ve donmuş aralıklar
+	DecodeErrors int          `json:"decodeErrors"` // Decoder error messages / Kod çözücü hata mesajları
+	ErrorSamples []string     `json:"errorSamples"` // First decoder messages / İlk kod çözücü mesajları
+}
+
+// summary describes the report in one line, empty when nothing was found
+// Raporu tek satırda açıklar, hiçbir şey bulunmadıysa boştur
+func (r SourceReport) summary() string {
+	counts := make(map[string]int)
+	for _, issue := range r.Issues {
+		counts[issue.Kind]++
+	}
+	var parts []string
+	if n := counts["black"]; n > 0 {
+		parts = append(parts, fmt.Sprintf("%d black segments", n))
+	}
+	if n := counts["frozen"]; n > 0 {
+		parts = append(parts, fmt.Sprintf("%d frozen segments", n))
+	}
+	if r.DecodeErrors > 0 {
+		parts = append(parts, fmt.Sprintf("%d decode errors", r.DecodeErrors))
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return "source has " + strings.Join(parts, ", ")
+}
+
+// AnalyzeJobSource checks the source of a job for black, frozen and corrupt segments
+// Works before or after the conversion, the report is attached to the job and its logs
+// Dönüştürmeden önce veya sonra çalışır, rapor işe ve loglarına eklenir
+func (a *App) AnalyzeJobSource(jobID string) (SourceReport, error) {
+	job, ok := a.getJob(jobID)
+	if !ok {
+		return SourceReport{}, fmt.Errorf("unknown job: %s", jobID)
+	}
+	if isURLInput(job.InputPath) {
+		return SourceReport{}, fmt.Errorf("only local sources can be analysed")
+	}
+	return a.analyzeJobSource(a.backgroundContext(a.baseContext()), job.ID, job.InputPath)
+}
+
+// analyzeJobSource runs the check on the source of a job and records its outcome
+// filePath is the local copy when a URL input was downloaded
+// filePath, bir URL girişi indirildiğinde yerel kopyadır
+func (a *App) analyzeJobSource(ctx context.Context, jobID, filePath string) (SourceReport, error) {
+	info, err := a.probeVideoInfo(ctx, filePath)
+	if err != nil {
+		return SourceReport{}, fmt.Errorf("failed to probe input: %v", err)
+	}
+	endPhase := a.startPhase(jobID, "analysis")
+	report, err := a.checkSource(ctx, filePath, info)
+	endPhase()
+	if err != nil {
+		return SourceReport{}, err
+	}
+
+	a.updateJob(jobID, func(job *Job) {
+		job.SourceReport = &report
+	})
+	a.writeSourceReport(jobID, report)
+	if summary := report.summary(); summary != "" {
+		log.Printf("Job %s: %s", jobID, summary)
+		a.addJobEvent(jobID, "analysis", summary)
+		a.emitJobEvent(events.JobWarning, jobID, JobWarningEvent{Message: summary})
+	} else {
+		a.addJobEvent(jobID, "analysis", "no problems found in the source")
+	}
+	return report, nil
+}
+
+// checkSource decodes the whole source once, running the detectors on the video
+// Audio is decoded too so broken audio packets are counted
+// Bozuk ses paketleri de sayılsın diye ses de çözülür
+func (a *App) checkSource(ctx context.Context, filePath string, info VideoInfo) (SourceReport, error) {
+	args := []string{"-hide_banner", "-nostats", "-i", filePath}
+	if !info.AudioOnly {
+		args = append(args, "-map", "0:v:0", "-vf", sourceCheckFilter)
+	}
+	args = append(args, "-map", "0:a?", "-f", "null", "-")
+	cmd := exec.CommandContext(ctx, a.ffmpegPath, args...)
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := a.waitForEncodes(ctx); err != nil {
+		return SourceReport{}, err
+	}
+	if err := runner.RunAt(cmd, runner.PriorityOf(ctx)); err != nil {
+		log.Printf("Error analysing %s: %v", filePath, err)
+		return SourceReport{}, fmt.Errorf("source analysis failed: %v", err)
+	}
+
+	report := parseSourceReport(stderr.String(), info.DurationSeconds)
+	report.Path, report.CheckedAt = filePath, time.Now()
+	return report, nil
+}
+
+// parseSourceReport reads the detected ranges and decoder errors from the FFmpeg output
+// Algılanan aralıkları ve kod çözücü hatalarını FFmpeg çıktısından okur
+func parseSourceReport(output string, duration float64) SourceReport {
+	report := SourceReport{Issues: []MediaIssue{}, ErrorSamples: []string{}}
+	for _, r := range parseRelaxRanges(output, duration) {
+		kind := r.label
+		if kind == "static" {
+			kind = "frozen"
+		}
+		report.Issues = append(report.Issues, MediaIssue{Kind: kind, Start: r.start, End: r.end})
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !decodeErrorRegex.MatchString(line) {
+			continue
+		}
+		report.DecodeErrors++
+		if len(report.ErrorSamples) < maxDecodeErrorSamples {
+			report.ErrorSamples = append(report.ErrorSamples, line)
+		}
+	}
+	return report
+}
+
+// writeSourceReport stores the report of a job next to its logs
+// Bir işin raporunu loglarının yanına kaydeder
+func (a *App) writeSourceReport(jobID string, report SourceReport) {
+	path, err := a.jobLogPath(jobID, sourceReportArtifact)
+	if err != nil {
+		log.Printf("Error creating log folder of job %s: %v", jobID, err)
+		return
+	}
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		log.Printf("Error marshalling source report: %v", err)
+		return
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		log.Printf("Error writing source report: %v", err)
+	}
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"strings"
+
+	"AV1-video-converter/internal/command"
+)
+
+// StreamInfo is a single stream of a media file, see command.Stream
+// Bir medya dosyasının tek bir akışıdır, bkz. command.Stream
+type StreamInfo = command.Stream
+
+// probeStreams lists the streams of a media file using FFprobe
+// FFprobe kullanarak bir medya dosyasının akışlarını listeler
+func (a *App) probeStreams(filePath string) ([]StreamInfo, error) {
+	result, err := a.probeFile(a.baseContext(), filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	streams := make([]StreamInfo, 0, len(result.Streams))
+	for _, s := range result.Streams {
+		language := strings.ToLower(s.Tags.Language)
+		if language == "" {
+			language = "und"
+		}
+		streams = append(streams, StreamInfo{
+			Index:       s.Index,
+			Type:        s.CodecType,
+			Codec:       s.CodecName,
+			Language:    language,
+			Title:       s.Tags.Title,
+			Channels:    s.Channels,
+			Default:     s.Disposition.Default == 1,
+			Forced:      s.Disposition.Forced == 1,
+			AttachedPic: s.Disposition.AttachedPic == 1,
+		})
+	}
+	return streams, nil
+}
+
+// streamOptions returns the stream rules of the settings
+// Ayarların akış kurallarını döndürür
+func streamOptions(settings ConversionSettings) command.StreamOptions {
+	return command.StreamOptions{
+		Container:               settings.Container,
+		AudioCodec:              settings.AudioCodec,
+		AudioBitrate:            settings.AudioBitrate,
+		AudioFallbackCodec:      settings.AudioFallbackCodec,
+		AudioFallbackBitrate:    settings.AudioFallbackBitrate,
+		KeepAudioLanguages:      settings.KeepAudioLanguages,
+		KeepSubtitleLanguages:   settings.KeepSubtitleLanguages,
+		DefaultAudioLanguage:    settings.DefaultAudioLanguage,
+		DefaultSubtitleLanguage: settings.DefaultSubtitleLanguage,
+		ForcedSubtitleLanguage:  settings.ForcedSubtitleLanguage,
+		LanguageTags:            settings.LanguageTags,
+	}
+}
+
+// selectStreams picks the streams to keep according to the language rules of the settings
+// Ayarların dil kurallarına göre tutulacak akışları seçer
+func selectStreams(settings ConversionSettings, streams []StreamInfo) (video *StreamInfo, audio, subtitles []StreamInfo) {
+	return command.SelectStreams(streamOptions(settings), streams)
+}
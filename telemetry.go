@@ -0,0 +1,184 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/exec"
+	goruntime "runtime"
+	"strings"
+	"time"
+)
+
+// telemetryInterval is the time between two usage reports
+// İki kullanım raporu arasındaki süredir
+const telemetryInterval = 7 * 24 * time.Hour
+
+// telemetryTimeout limits how long sending a report may take
+// Bir raporun gönderilmesinin ne kadar sürebileceğini sınırlar
+const telemetryTimeout = 30 * time.Second
+
+// TelemetryReport struct
+// Represents the anonymous usage statistics sent when opted in
+// No paths, names or identifiers are included, only counts and averages
+// Yol, ad veya tanımlayıcı içermez, yalnızca sayılar ve ortalamalar
+type TelemetryReport struct {
+	AppVersion   string         `json:"appVersion"`   // Application version / Uygulama sürümü
+	OS           string         `json:"os"`           // Operating system / İşletim sistemi
+	Arch         string         `json:"arch"`         // CPU architecture / CPU mimarisi
+	CPUModel     string         `json:"cpuModel"`     // CPU model name / CPU model adı
+	CPUCores     int            `json:"cpuCores"`     // Logical CPU count / Mantıksal CPU sayısı
+	From         time.Time      `json:"from"`         // Start of the reported period / Raporlanan dönemin başlangıcı
+	To           time.Time      `json:"to"`           // End of the reported period / Raporlanan dönemin sonu
+	Encodes      int            `json:"encodes"`      // Completed conversions / Tamamlanan dönüştürmeler
+	Failures     int            `json:"failures"`     // Failed conversions / Başarısız dönüştürmeler
+	Encoders     map[string]int `json:"encoders"`     // Conversions per encoder / Kodlayıcı başına dönüştürme
+	Presets      map[string]int `json:"presets"`      // Conversions per encoder:preset / Kodlayıcı:ön ayar başına dönüştürme
+	Containers   map[string]int `json:"containers"`   // Conversions per container / Kapsayıcı başına dönüştürme
+	AverageSpeed float64        `json:"averageSpeed"` // Mean speed as a multiple of real time / Gerçek zamanın katı olarak ortalama hız
+}
+
+// GetTelemetryPreview returns exactly what the next usage report would send
+// Sonraki kullanım raporunun göndereceği şeyi aynen döndürür
+func (a *App) GetTelemetryPreview() TelemetryReport {
+	return a.buildTelemetryReport(time.Now())
+}
+
+// SendTelemetry sends the usage report now if telemetry is enabled
+// Telemetri etkinse kullanım raporunu şimdi gönderir
+func (a *App) SendTelemetry() error {
+	if !a.currentPreferences().TelemetryEnabled {
+		return fmt.Errorf("usage statistics are disabled")
+	}
+	return a.sendTelemetry(a.baseContext())
+}
+
+// sendTelemetryIfDue sends the usage report once the interval has passed
+// Aralık geçtikten sonra kullanım raporunu gönderir
+func (a *App) sendTelemetryIfDue() {
+	if !a.currentPreferences().TelemetryEnabled || time.Since(a.telemetrySentAt) < telemetryInterval {
+		return
+	}
+	if err := a.sendTelemetry(a.baseContext()); err != nil {
+		log.Printf("Error sending usage statistics: %v", err)
+	}
+}
+
+// sendTelemetry posts the usage report and remembers when it was sent
+// Kullanım raporunu gönderir ve ne zaman gönderildiğini hatırlar
+func (a *App) sendTelemetry(ctx context.Context) error {
+	now := time.Now()
+	report := a.buildTelemetryReport(now)
+	if report.Encodes == 0 && report.Failures == 0 {
+		log.Printf("No conversions since the last usage report, nothing to send")
+		return nil
+	}
+
+	data, err := json.Marshal(report)
+	if err != nil {
+		return fmt.Errorf("failed to encode report: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(ctx, telemetryTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.currentPreferences().TelemetryURL, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send report: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("report rejected: %s", resp.Status)
+	}
+
+	log.Printf("Usage statistics sent: %d conversions", report.Encodes)
+	a.telemetrySentAt = now
+	a.saveConfig()
+	return nil
+}
+
+// buildTelemetryReport aggregates the history since the last report
+// Son rapordan bu yana geçmişi toplar
+func (a *App) buildTelemetryReport(now time.Time) TelemetryReport {
+	report := TelemetryReport{
+		AppVersion: appVersion,
+		OS:         goruntime.GOOS,
+		Arch:       goruntime.GOARCH,
+		CPUModel:   cpuModel(),
+		CPUCores:   goruntime.NumCPU(),
+		From:       a.telemetrySentAt,
+		To:         now,
+		Encoders:   make(map[string]int),
+		Presets:    make(map[string]int),
+		Containers: make(map[string]int),
+	}
+
+	var speedSum float64
+	var speedCount int
+	history, _ := a.GetHistory()
+	for _, entry := range history {
+		if !entry.FinishedAt.After(a.telemetrySentAt) || entry.FinishedAt.After(now) {
+			continue
+		}
+		if entry.Status != "completed" {
+			report.Failures++
+			continue
+		}
+		report.Encodes++
+		report.Encoders[entry.Settings.Encoder]++
+		report.Presets[entry.Settings.Encoder+":"+entry.Settings.Preset]++
+		report.Containers[entry.Settings.Container]++
+		if elapsed := entry.FinishedAt.Sub(entry.StartedAt).Seconds(); elapsed > 0 && entry.Duration > 0 {
+			speedSum += entry.Duration / elapsed
+			speedCount++
+		}
+	}
+	if speedCount > 0 {
+		report.AverageSpeed = speedSum / float64(speedCount)
+	}
+	return report
+}
+
+// cpuModel returns the CPU model name using platform tools
+// Platform araçlarını kullanarak CPU model adını döndürür
+func cpuModel() string {
+	switch goruntime.GOOS {
+	case "darwin":
+		out, err := exec.Command("sysctl", "-n", "machdep.cpu.brand_string").Output()
+		if err != nil {
+			log.Printf("Error reading CPU model: %v", err)
+			return "unknown"
+		}
+		return firstLine(string(out))
+	case "windows":
+		out, err := exec.Command("powershell", "-NoProfile", "-Command",
+			"Get-CimInstance Win32_Processor | Select-Object -ExpandProperty Name").Output()
+		if err != nil {
+			log.Printf("Error reading CPU model: %v", err)
+			return "unknown"
+		}
+		return firstLine(string(out))
+	default:
+		file, err := os.Open("/proc/cpuinfo")
+		if err != nil {
+			log.Printf("Error reading CPU model: %v", err)
+			return "unknown"
+		}
+		defer file.Close()
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			if name, value, ok := strings.Cut(scanner.Text(), ":"); ok && strings.TrimSpace(name) == "model name" {
+				return strings.TrimSpace(value)
+			}
+		}
+	}
+	return "unknown"
+}
@@ -0,0 +1,225 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	goruntime "runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"AV1-video-converter/internal/events"
+	"AV1-video-converter/internal/runner"
+)
+
+// thermalPollInterval is how often a paused job checks the temperature
+// Duraklatılmış bir işin sıcaklığı kontrol etme sıklığıdır
+const thermalPollInterval = 10 * time.Second
+
+// cpuSensors are the hwmon drivers that report the CPU temperature
+// CPU sıcaklığını bildiren hwmon sürücüleridir
+var cpuSensors = map[string]bool{
+	"coretemp":    true,
+	"k10temp":     true,
+	"zenpower":    true,
+	"cpu_thermal": true,
+	"soc_thermal": true,
+}
+
+// GetCPUTemperature returns the hottest CPU sensor in degrees Celsius
+// Linux reads hwmon or thermal zones, Windows the ACPI thermal zone, macOS has none without root
+// Linux hwmon veya termal bölgeleri, Windows ACPI termal bölgesini okur, macOS'ta root olmadan yoktur
+func (a *App) GetCPUTemperature() (float64, error) {
+	switch goruntime.GOOS {
+	case "linux":
+		return linuxTemperature()
+	case "windows":
+		return windowsTemperature()
+	}
+	return 0, fmt.Errorf("CPU temperature is not available on %s", goruntime.GOOS)
+}
+
+// linuxTemperature reads the CPU sensors of hwmon, then the thermal zones
+// Önce hwmon'un CPU sensörlerini, ardından termal bölgeleri okur
+func linuxTemperature() (float64, error) {
+	var hottest float64
+	found := false
+	read := func(path string) {
+		if value, err := readCounter(path); err == nil {
+			if celsius := float64(value) / 1000; !found || celsius > hottest {
+				hottest, found = celsius, true
+			}
+		}
+	}
+
+	hwmons, _ := filepath.Glob("/sys/class/hwmon/hwmon*")
+	for _, hwmon := range hwmons {
+		name, err := os.ReadFile(filepath.Join(hwmon, "name"))
+		if err != nil || !cpuSensors[strings.TrimSpace(string(name))] {
+			continue
+		}
+		inputs, _ := filepath.Glob(filepath.Join(hwmon, "temp*_input"))
+		for _, input := range inputs {
+			read(input)
+		}
+	}
+	if found {
+		return hottest, nil
+	}
+
+	zones, _ := filepath.Glob("/sys/class/thermal/thermal_zone*")
+	for _, zone := range zones {
+		kind, err := os.ReadFile(filepath.Join(zone, "type"))
+		if err != nil {
+			continue
+		}
+		if kind := strings.ToLower(strings.TrimSpace(string(kind))); kind == "x86_pkg_temp" || strings.Contains(kind, "cpu") || strings.Contains(kind, "soc") {
+			read(filepath.Join(zone, "temp"))
+		}
+	}
+	if !found {
+		return 0, fmt.Errorf("no CPU temperature sensor found")
+	}
+	return hottest, nil
+}
+
+// windowsTemperature reads the ACPI thermal zone, reported in tenths of a kelvin
+// Onda bir kelvin olarak bildirilen ACPI termal bölgesini okur
+func windowsTemperature() (float64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	out, err := exec.CommandContext(ctx, "powershell", "-NoProfile", "-Command",
+		"Get-CimInstance -Namespace root/wmi MSAcpi_ThermalZoneTemperature | Select-Object -ExpandProperty CurrentTemperature").Output()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read the thermal zone: %v", err)
+	}
+	var hottest float64
+	found := false
+	for _, line := range strings.Fields(string(out)) {
+		tenths, err := strconv.ParseFloat(line, 64)
+		if err != nil {
+			continue
+		}
+		if celsius := tenths/10 - 273.15; !found || celsius > hottest {
+			hottest, found = celsius, true
+		}
+	}
+	if !found {
+		return 0, fmt.Errorf("no CPU temperature sensor found")
+	}
+	return hottest, nil
+}
+
+// thermalLimits returns the temperature jobs pause at and the one they resume at, false while the limit is off
+// İşlerin duraklatıldığı ve devam ettiği sıcaklıkları döndürür, sınır kapalıyken false
+func (a *App) thermalLimits() (float64, float64, bool) {
+	preferences := a.currentPreferences()
+	limit := float64(preferences.ThermalLimit)
+	if limit <= 0 {
+		return 0, 0, false
+	}
+	resume := float64(preferences.ThermalResume)
+	if resume <= 0 || resume >= limit {
+		resume = limit - 10
+	}
+	return limit, resume, true
+}
+
+// waitForCooling holds a job back while the CPU is above the thermal limit
+// It resumes once the temperature drops to the resume threshold or can't be read
+// Sıcaklık devam eşiğine düştüğünde veya okunamadığında devam eder
+func (a *App) waitForCooling(ctx context.Context, jobID string) error {
+	limit, resume, ok := a.thermalLimits()
+	if !ok {
+		return nil
+	}
+	temperature, err := a.GetCPUTemperature()
+	if err != nil || temperature < limit {
+		return nil
+	}
+
+	log.Printf("Job %s paused: CPU at %.0f°C, waiting for %.0f°C", jobID, temperature, resume)
+	message := fmt.Sprintf("paused at %.0f°C", temperature)
+	a.addJobEvent(jobID, "thermal", message)
+	a.emitJobEvent(events.JobPaused, jobID, JobPausedEvent{
+		Reason:      "thermal",
+		Message:     message,
+		Temperature: temperature,
+		ResumeAt:    resume,
+	})
+
+	ticker := time.NewTicker(thermalPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("conversion cancelled: %w", context.Cause(ctx))
+		case <-ticker.C:
+			temperature, err = a.GetCPUTemperature()
+			if err != nil || temperature <= resume {
+				log.Printf("Job %s resumed: CPU at %.0f°C", jobID, temperature)
+				message := fmt.Sprintf("resumed at %.0f°C", temperature)
+				a.addJobEvent(jobID, "thermal", message)
+				a.emitJobEvent(events.JobResumed, jobID, JobResumedEvent{Reason: "thermal", Message: message})
+				return nil
+			}
+		}
+	}
+}
+
+// thermalGuard suspends a running FFmpeg while the CPU is above the thermal limit
+// monitorProgress checks it on every thermal poll and stops its stall timer while FFmpeg is suspended
+// monitorProgress her ısıl yoklamada onu kontrol eder ve FFmpeg askıdayken takılma zamanlayıcısını durdurur
+type thermalGuard struct {
+	app       *App
+	jobID     string
+	ffmpeg    runner.FFmpegRunner
+	suspended bool
+}
+
+// check reads the temperature, suspends or resumes FFmpeg and reports whether it is suspended
+// Sıcaklığı okur, FFmpeg'i askıya alır veya devam ettirir ve askıda olup olmadığını bildirir
+func (g *thermalGuard) check() bool {
+	limit, resume, ok := g.app.thermalLimits()
+	temperature, err := g.app.GetCPUTemperature()
+	switch {
+	case !g.suspended && ok && err == nil && temperature >= limit:
+		if err := g.ffmpeg.Suspend(); err != nil {
+			log.Printf("Error suspending FFmpeg of job %s: %v", g.jobID, err)
+			return false
+		}
+		g.suspended = true
+		log.Printf("Job %s suspended: CPU at %.0f°C, waiting for %.0f°C", g.jobID, temperature, resume)
+		message := fmt.Sprintf("suspended at %.0f°C", temperature)
+		g.app.addJobEvent(g.jobID, "thermal", message)
+		g.app.emitJobEvent(events.JobPaused, g.jobID, JobPausedEvent{
+			Reason:      "thermal",
+			Message:     message,
+			Temperature: temperature,
+			ResumeAt:    resume,
+		})
+	case g.suspended && (!ok || err != nil || temperature <= resume):
+		g.release()
+		log.Printf("Job %s resumed: CPU at %.0f°C", g.jobID, temperature)
+		message := fmt.Sprintf("resumed at %.0f°C", temperature)
+		g.app.addJobEvent(g.jobID, "thermal", message)
+		g.app.emitJobEvent(events.JobResumed, g.jobID, JobResumedEvent{Reason: "thermal", Message: message})
+	}
+	return g.suspended
+}
+
+// release resumes a suspended FFmpeg, so a cancelled job isn't left stopped
+// Askıdaki bir FFmpeg'i devam ettirir, böylece iptal edilen bir iş durdurulmuş kalmaz
+func (g *thermalGuard) release() {
+	if !g.suspended {
+		return
+	}
+	g.suspended = false
+	if err := g.ffmpeg.Resume(); err != nil {
+		log.Printf("Error resuming FFmpeg of job %s: %v", g.jobID, err)
+	}
+}
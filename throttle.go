@@ -0,0 +1,32 @@
+package main
+
+import "time"
+
+// progressThrottle struct
+// Coalesces progress events so fast encodes and copies don't flood the Wails bridge
+// Hızlı kodlama ve kopyalamaların Wails köprüsünü doldurmaması için ilerleme olaylarını birleştirir
+type progressThrottle struct {
+	interval time.Duration // Minimum time between events, 0 for none / Olaylar arasındaki en kısa süre, 0 sınırsız
+	last     time.Time     // Time of the last event / Son olayın zamanı
+}
+
+// newProgressThrottle creates a throttle for the configured event rate
+// Yapılandırılmış olay hızı için bir sınırlayıcı oluşturur
+func (a *App) newProgressThrottle() *progressThrottle {
+	throttle := &progressThrottle{}
+	if rate := a.currentPreferences().ProgressEventRate; rate > 0 {
+		throttle.interval = time.Second / time.Duration(rate)
+	}
+	return throttle
+}
+
+// allow reports whether an event may be sent now, final events always pass
+// Bir olayın şimdi gönderilip gönderilemeyeceğini bildirir, son olaylar her zaman geçer
+func (t *progressThrottle) allow(final bool) bool {
+	now := time.Now()
+	if !final && t.interval > 0 && now.Sub(t.last) < t.interval {
+		return false
+	}
+	t.last = now
+	return true
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+
+	"AV1-video-converter/internal/runner"
+)
+
+// Scene thumbnail limits, the first frame is always included
+// Sahne küçük resmi sınırları, ilk kare her zaman dahildir
+const (
+	sceneThreshold      = 0.3
+	maxSceneThumbnails  = 24
+	sceneThumbnailWidth = 320
+)
+
+// showinfoTimeRegex matches the timestamp the showinfo filter prints per frame
+// showinfo filtresinin kare başına yazdırdığı zaman damgasıyla eşleşir
+var showinfoTimeRegex = regexp.MustCompile(`Parsed_showinfo.*pts_time:\s*([\d.]+)`)
+
+// SceneThumbnail struct
+// Represents a frame at a scene change
+// Bir sahne değişimindeki kareyi temsil eder
+type SceneThumbnail struct {
+	Time float64 `json:"time"` // Frame time in seconds / Saniye cinsinden kare zamanı
+	URL  string  `json:"url"`  // Image URL for the frontend / Ön yüz için resim URL'si
+}
+
+// GetSceneThumbnails returns a strip of frames at the scene changes of a file
+// Frames are cached per file version, so reopening a queued file is instant
+// Kareler dosya sürümü başına önbelleğe alınır, kuyruktaki bir dosyayı yeniden açmak anında olur
+func (a *App) GetSceneThumbnails(path string) ([]SceneThumbnail, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("file not found: %v", err)
+	}
+	key := sha256.Sum256([]byte(fmt.Sprintf("%s|%d|%d", path, info.Size(), info.ModTime().UnixNano())))
+	dir := filepath.Join(a.workCacheDir("av1-thumbnails"), hex.EncodeToString(key[:8]))
+	indexPath := filepath.Join(dir, "scenes.json")
+
+	times, err := readSceneIndex(indexPath)
+	if err != nil {
+		if times, err = a.extractSceneFrames(a.backgroundContext(a.baseContext()), path, dir); err != nil {
+			os.RemoveAll(dir)
+			return nil, err
+		}
+		if data, err := json.Marshal(times); err == nil {
+			if err := os.WriteFile(indexPath, data, 0644); err != nil {
+				log.Printf("Error writing scene index %s: %v", indexPath, err)
+			}
+		}
+	}
+
+	thumbnails := make([]SceneThumbnail, 0, len(times))
+	for i, time := range times {
+		url, err := a.media.register(filepath.Join(dir, fmt.Sprintf("scene_%03d.jpg", i+1)))
+		if err != nil {
+			return nil, err
+		}
+		thumbnails = append(thumbnails, SceneThumbnail{Time: time, URL: url})
+	}
+	return thumbnails, nil
+}
+
+// extractSceneFrames writes the scene change frames as JPEGs and returns their times
+// Scene scores are computed on downscaled frames, which is much faster and just as reliable
+// Sahne puanları küçültülmüş karelerde hesaplanır, bu çok daha hızlı ve aynı derecede güvenilirdir
+func (a *App) extractSceneFrames(ctx context.Context, path, dir string) ([]float64, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create thumbnail folder: %v", err)
+	}
+	filter := fmt.Sprintf("scale=%d:-2,select='eq(n\\,0)+gt(scene\\,%g)',showinfo", sceneThumbnailWidth, sceneThreshold)
+	cmd := exec.CommandContext(ctx, a.ffmpegPath,
+		"-hide_banner", "-nostats",
+		"-i", path,
+		"-map", "0:v:0",
+		"-vf", filter,
+		"-vsync", "vfr",
+		"-frames:v", strconv.Itoa(maxSceneThumbnails),
+		"-q:v", "4",
+		filepath.Join(dir, "scene_%03d.jpg"))
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := a.waitForEncodes(ctx); err != nil {
+		return nil, err
+	}
+	if err := runner.RunAt(cmd, runner.PriorityOf(ctx)); err != nil {
+		log.Printf("Error extracting scenes of %s: %v", path, err)
+		return nil, fmt.Errorf("scene detection failed: %v", err)
+	}
+
+	var times []float64
+	for _, match := range showinfoTimeRegex.FindAllStringSubmatch(stderr.String(), -1) {
+		time, _ := strconv.ParseFloat(match[1], 64)
+		times = append(times, time)
+	}
+	if len(times) == 0 {
+		return nil, fmt.Errorf("no frames found in %s", path)
+	}
+	return times, nil
+}
+
+// readSceneIndex reads the frame times of a cached strip
+// Önbelleğe alınmış bir şeridin kare zamanlarını okur
+func readSceneIndex(indexPath string) ([]float64, error) {
+	data, err := os.ReadFile(indexPath)
+	if err != nil {
+		return nil, err
+	}
+	var times []float64
+	if err := json.Unmarshal(data, &times); err != nil {
+		return nil, err
+	}
+	return times, nil
+}
@@ -0,0 +1,16 @@
+package main
+
+import "AV1-video-converter/internal/timecode"
+
+// FormatTimecode returns the SMPTE timecode of a position for the frame rate of a source
+// 29.97 and 59.94 sources get drop-frame timecodes, like editing software shows them
+// 29.97 ve 59.94 kaynaklar, kurgu yazılımlarının gösterdiği gibi drop-frame zaman kodu alır
+func (a *App) FormatTimecode(seconds, frameRate float64) string {
+	return timecode.Format(seconds, frameRate)
+}
+
+// ParseTimecode reads a timecode, clock time or seconds typed for a zone boundary
+// Bir bölge sınırı için yazılan zaman kodunu, saati veya saniyeyi okur
+func (a *App) ParseTimecode(value string, frameRate float64) (float64, error) {
+	return timecode.Parse(value, frameRate)
+}
@@ -0,0 +1,163 @@
+package main
+
+import "AV1-video-converter/internal/events"
+
+// JobAddedEvent is the payload of job.added
+// Video is set when the backend queued the job itself, e.g. a watch folder or Sonarr/Radarr import
+// Video, işi arka uç kendisi kuyruğa eklediğinde ayarlanır, örn. bir izleme klasörü veya Sonarr/Radarr içe aktarması
+type JobAddedEvent struct {
+	Job   Job        `json:"job"`             // The new job / Yeni iş
+	Video *VideoInfo `json:"video,omitempty"` // Probed source of a backend job / Bir arka uç işinin incelenen kaynağı
+}
+
+// JobStartedEvent is the payload of job.started
+// job.started olayının yüküdür
+type JobStartedEvent struct {
+	InputPath string `json:"inputPath"` // Source file / Kaynak dosya
+}
+
+// JobProgressEvent is the payload of job.progress
+// job.progress olayının yüküdür
+type JobProgressEvent struct {
+	Phase   string  `json:"phase"`   // download, encode, copy or transfer / Aşama
+	Percent float64 `json:"percent"` // Progress of the phase, 0-100 / Aşamanın ilerlemesi, 0-100
+	Speed   string  `json:"speed"`   // Encode speed or transfer rate, may be empty / Kodlama hızı veya aktarım hızı, boş olabilir
+}
+
+// JobPhaseEvent is the payload of job.phase
+// job.phase olayının yüküdür
+type JobPhaseEvent struct {
+	Phase   string  `json:"phase"`   // Phase name, see JobPhase / Aşama adı, bkz. JobPhase
+	Seconds float64 `json:"seconds"` // Time spent in the phase / Aşamada geçen süre
+}
+
+// JobLogEvent is the payload of job.log
+// job.log olayının yüküdür
+type JobLogEvent struct {
+	Stage   string `json:"stage"`   // Stage of the timeline entry / Zaman çizelgesi kaydının aşaması
+	Message string `json:"message"` // What happened / Ne olduğu
+}
+
+// JobWarningEvent is the payload of job.warning
+// job.warning olayının yüküdür
+type JobWarningEvent struct {
+	Message string `json:"message"` // Warning shown to the user / Kullanıcıya gösterilen uyarı
+}
+
+// JobStalledEvent is the payload of job.stalled
+// job.stalled olayının yüküdür
+type JobStalledEvent struct {
+	Percent float64 `json:"percent"` // Progress when it stalled / Takıldığı andaki ilerleme
+	Seconds int     `json:"seconds"` // Seconds without progress / İlerlemesiz geçen saniye
+	Recover bool    `json:"recover"` // The stalled attempt is retried / Takılan deneme yeniden denenir
+}
+
+// JobRetryEvent is the payload of job.retry
+// job.retry olayının yüküdür
+type JobRetryEvent struct {
+	Attempt int    `json:"attempt"` // Retry number, starting at 1 / 1'den başlayan yeniden deneme numarası
+	Reason  string `json:"reason"`  // stalled, sleep or hardware / stalled, sleep veya hardware
+}
+
+// JobCompletedEvent is the payload of job.completed
+// job.completed olayının yüküdür
+type JobCompletedEvent struct {
+	OutputPath  string       `json:"outputPath"`       // First output / İlk çıktı
+	OutputPaths []string     `json:"outputPaths"`      // Every output, one per rendition / Her çıktı, yorum başına bir
+	Energy      *EnergyUsage `json:"energy,omitempty"` // Estimated energy of the encode / Kodlamanın tahmini enerjisi
+}
+
+// JobFailedEvent is the payload of job.failed
+// job.failed olayının yüküdür
+type JobFailedEvent struct {
+	Error string `json:"error"` // Failure reason / Hata nedeni
+}
+
+// JobPausedEvent is the payload of job.paused
+// job.paused olayının yüküdür
+type JobPausedEvent struct {
+	Reason      string  `json:"reason"`                // thermal or space / thermal veya space
+	Message     string  `json:"message"`               // Why the job waits / İşin neden beklediği
+	Temperature float64 `json:"temperature,omitempty"` // CPU temperature of a thermal pause / Isıl duraklamanın CPU sıcaklığı
+	ResumeAt    float64 `json:"resumeAt,omitempty"`    // Temperature the job resumes at / İşin devam edeceği sıcaklık
+	Folder      string  `json:"folder,omitempty"`      // Destination of a space pause / Alan duraklamasının hedefi
+	FreeGB      float64 `json:"freeGB,omitempty"`      // Free space of the destination / Hedefin boş alanı
+	MinFreeGB   int     `json:"minFreeGB,omitempty"`   // Watermark the job waits for / İşin beklediği eşik
+}
+
+// JobResumedEvent is the payload of job.resumed
+// job.resumed olayının yüküdür
+type JobResumedEvent struct {
+	Reason  string `json:"reason"`  // Reason of the pause that ended / Biten duraklamanın nedeni
+	Message string `json:"message"` // What let the job go on / İşin devam etmesini sağlayan şey
+}
+
+// UploadProgressEvent is the payload of upload.progress
+// upload.progress olayının yüküdür
+type UploadProgressEvent struct {
+	Path    string  `json:"path"`    // Output being uploaded / Yüklenen çıktı
+	Percent float64 `json:"percent"` // Parts uploaded, 0-100 / Yüklenen parçalar, 0-100
+}
+
+// UploadCompletedEvent is the payload of upload.completed
+// upload.completed olayının yüküdür
+type UploadCompletedEvent struct {
+	Path   string `json:"path"`   // Uploaded output / Yüklenen çıktı
+	Bucket string `json:"bucket"` // Bucket it went to / Gittiği depo
+	Key    string `json:"key"`    // Key in the bucket / Depodaki anahtar
+}
+
+// UploadFailedEvent is the payload of upload.failed
+// upload.failed olayının yüküdür
+type UploadFailedEvent struct {
+	Path  string `json:"path"`  // Output that wasn't uploaded / Yüklenemeyen çıktı
+	Error string `json:"error"` // Failure reason / Hata nedeni
+}
+
+// QueueUpdatedEvent is the payload of queue.updated
+// queue.updated olayının yüküdür
+type QueueUpdatedEvent struct {
+	Queued    int `json:"queued"`    // Jobs waiting / Bekleyen işler
+	Running   int `json:"running"`   // Jobs converting / Dönüştürülen işler
+	Completed int `json:"completed"` // Jobs finished successfully / Başarıyla biten işler
+	Failed    int `json:"failed"`    // Jobs that failed / Başarısız olan işler
+}
+
+// QueueSortedEvent is the payload of queue.sorted
+// queue.sorted olayının yüküdür
+type QueueSortedEvent struct {
+	Key        string   `json:"key"`        // Sort key / Sıralama anahtarı
+	Descending bool     `json:"descending"` // Largest first / Büyükten küçüğe
+	JobIDs     []string `json:"jobIds"`     // Queued jobs in their new order / Yeni sıralarıyla kuyruktaki işler
+}
+
+// emitJobEvent sends a timeline event about a job
+// Bir iş hakkında bir zaman çizelgesi olayı gönderir
+func (a *App) emitJobEvent(name, jobID string, data interface{}) {
+	events.Emit(a.events, name, jobID, data)
+}
+
+// emitProgress sends the progress of a job phase
+// Bir iş aşamasının ilerlemesini gönderir
+func (a *App) emitProgress(jobID, phase string, percent float64, speed string) {
+	a.emitJobEvent(events.JobProgress, jobID, JobProgressEvent{Phase: phase, Percent: percent, Speed: speed})
+}
+
+// emitQueueUpdated sends the job counts of the queue
+// Kuyruğun iş sayılarını gönderir
+func (a *App) emitQueueUpdated() {
+	var counts QueueUpdatedEvent
+	for _, job := range a.GetJobs() {
+		switch job.Status {
+		case "queued":
+			counts.Queued++
+		case "running":
+			counts.Running++
+		case "completed":
+			counts.Completed++
+		case "failed":
+			counts.Failed++
+		}
+	}
+	events.Emit(a.events, events.QueueUpdated, "", counts)
+}
@@ -0,0 +1,476 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"AV1-video-converter/internal/events"
+)
+
+// Multipart upload limits of S3, parts are at least 5 MB and at most 10000
+// S3'ün çok parçalı yükleme sınırları, parçalar en az 5 MB ve en fazla 10000 adet
+const (
+	uploadPartSize = 16 * 1024 * 1024
+	uploadMaxParts = 10000
+)
+
+// uploadPartRetries is how often a failed part is sent again before the upload fails
+// Başarısız bir parçanın yükleme başarısız olmadan önce kaç kez yeniden gönderildiğidir
+const uploadPartRetries = 3
+
+// uploadRetryDelay is the wait before the first retry of a part, it grows with every attempt
+// Bir parçanın ilk yeniden denemesinden önceki beklemedir, her denemede artar
+var uploadRetryDelay = 2 * time.Second
+
+// UploadConfig struct
+// Represents the S3-compatible bucket finished outputs are uploaded to
+// Bitmiş çıktıların yüklendiği S3 uyumlu depoyu temsil eder
+type UploadConfig struct {
+	Enabled           bool   `json:"enabled"`           // Upload finished outputs / Bitmiş çıktıları yükle
+	Endpoint          string `json:"endpoint"`          // e.g. https://s3.eu-central-1.amazonaws.com / Uç nokta
+	Region            string `json:"region"`            // Signing region, empty for us-east-1 / İmzalama bölgesi
+	Bucket            string `json:"bucket"`            // Bucket name / Depo adı
+	AccessKeyID       string `json:"accessKeyId"`       // Access key / Erişim anahtarı
+	SecretAccessKey   string `json:"secretAccessKey"`   // Secret key / Gizli anahtar
+	PathStyle         bool   `json:"pathStyle"`         // Use endpoint/bucket/key URLs / endpoint/bucket/key adresleri kullan
+	Prefix            string `json:"prefix"`            // Key prefix template, e.g. "av1/{year}/{month}" / Anahtar öneki şablonu
+	DeleteAfterUpload bool   `json:"deleteAfterUpload"` // Remove the local output once uploaded / Yüklendikten sonra yerel çıktıyı sil
+}
+
+// uploadState struct
+// Represents the progress of a multipart upload, saved in the app folder to resume it later
+// Daha sonra devam etmek için uygulama klasörüne kaydedilen çok parçalı yükleme ilerlemesini temsil eder
+type uploadState struct {
+	JobID      string         `json:"jobId"`
+	OutputPath string         `json:"outputPath"`
+	Key        string         `json:"key"`
+	UploadID   string         `json:"uploadId"`
+	Size       int64          `json:"size"`
+	ModTime    time.Time      `json:"modTime"`
+	PartSize   int64          `json:"partSize"`
+	ETags      map[int]string `json:"etags"`
+}
+
+// GetUploadConfig returns the bucket upload configuration
+// The secret key is left out, saving an empty one keeps the stored key
+// Gizli anahtar dahil edilmez, boş kaydetmek saklanan anahtarı korur
+func (a *App) GetUploadConfig() UploadConfig {
+	config := a.currentUpload()
+	config.SecretAccessKey = ""
+	return config
+}
+
+// SaveUploadConfig validates and stores the bucket upload configuration
+// Depo yükleme yapılandırmasını doğrular ve kaydeder
+func (a *App) SaveUploadConfig(config UploadConfig) error {
+	if config.SecretAccessKey == "" {
+		config.SecretAccessKey = a.currentUpload().SecretAccessKey
+	} else {
+		a.forgetSealedSecret("upload")
+	}
+	if err := validateUploadConfig(config); err != nil {
+		log.Printf("Rejected upload config: %v", err)
+		return err
+	}
+	a.updateUpload(func(upload *UploadConfig) { *upload = config })
+	a.saveConfig()
+	return nil
+}
+
+// currentUpload returns a copy of the bucket upload configuration
+// Depo yükleme yapılandırmasının bir kopyasını döndürür
+func (a *App) currentUpload() UploadConfig {
+	a.uploadMu.Lock()
+	defer a.uploadMu.Unlock()
+	return a.upload
+}
+
+// updateUpload changes the bucket upload configuration under the lock
+// Depo yükleme yapılandırmasını kilit altında değiştirir
+func (a *App) updateUpload(update func(upload *UploadConfig)) {
+	a.uploadMu.Lock()
+	defer a.uploadMu.Unlock()
+	update(&a.upload)
+}
+
+// validateUploadConfig checks that an enabled upload can reach a bucket
+// Etkin bir yüklemenin bir depoya ulaşabildiğini kontrol eder
+func validateUploadConfig(config UploadConfig) error {
+	if !config.Enabled {
+		return nil
+	}
+	endpoint, err := url.Parse(config.Endpoint)
+	if err != nil || (endpoint.Scheme != "https" && endpoint.Scheme != "http") || endpoint.Host == "" {
+		return fmt.Errorf("endpoint must be an http or https URL")
+	}
+	if config.Bucket == "" || config.AccessKeyID == "" || config.SecretAccessKey == "" {
+		return fmt.Errorf("bucket, access key and secret key are required")
+	}
+	return nil
+}
+
+// uploadOutput uploads a finished output and reports the result as events
+// An upload left by a cancelled job or a crash is resumed under the key it started with
+// Upload failures don't fail the job, the output is still on disk and RetryUpload can send it again
+// Yükleme hataları işi başarısız kılmaz, çıktı hâlâ diskte ve RetryUpload onu yeniden gönderebilir
+func (a *App) uploadOutput(ctx context.Context, job Job, outputPath string) error {
+	config := a.currentUpload()
+	key := uploadKey(config.Prefix, job, outputPath, time.Now())
+	if state, ok := loadUploadState(a.uploadStatePath(outputPath)); ok {
+		key = state.Key
+	}
+	log.Printf("Uploading %s to %s/%s", outputPath, config.Bucket, key)
+
+	if err := a.uploadFile(ctx, config, job.ID, outputPath, key); err != nil {
+		log.Printf("Error uploading %s: %v", outputPath, err)
+		a.emitJobEvent(events.UploadFailed, job.ID, UploadFailedEvent{Path: outputPath, Error: err.Error()})
+		return err
+	}
+
+	log.Printf("Uploaded %s to %s/%s", outputPath, config.Bucket, key)
+	a.emitJobEvent(events.UploadCompleted, job.ID, UploadCompletedEvent{Path: outputPath, Bucket: config.Bucket, Key: key})
+	if config.DeleteAfterUpload {
+		if err := os.Remove(outputPath); err != nil {
+			log.Printf("Error removing uploaded output %s: %v", outputPath, err)
+		}
+	}
+	return nil
+}
+
+// RetryUpload uploads the outputs of a finished job again, parts already in the bucket aren't sent twice
+// Bitmiş bir işin çıktılarını yeniden yükler, depoda olan parçalar iki kez gönderilmez
+func (a *App) RetryUpload(jobID string) error {
+	job, ok := a.getJob(jobID)
+	if !ok {
+		return fmt.Errorf("unknown job: %s", jobID)
+	}
+	if job.Status != "completed" {
+		return fmt.Errorf("job %s hasn't completed", jobID)
+	}
+	if !a.currentUpload().Enabled {
+		return fmt.Errorf("bucket upload is disabled")
+	}
+	paths := job.OutputPaths
+	if len(paths) == 0 {
+		paths = []string{job.OutputPath}
+	}
+	for _, outputPath := range paths {
+		if err := a.uploadOutput(a.baseContext(), job, outputPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resumeUploads finishes the uploads a shutdown or crash interrupted
+// Uploads of outputs that are gone are aborted so the bucket doesn't keep their parts
+// Artık olmayan çıktıların yüklemeleri, depo parçalarını tutmasın diye iptal edilir
+func (a *App) resumeUploads() {
+	files, err := filepath.Glob(filepath.Join(a.appDir, "uploads", "*.json"))
+	if err != nil || len(files) == 0 {
+		return
+	}
+	config := a.currentUpload()
+	for _, statePath := range files {
+		state, ok := loadUploadState(statePath)
+		if !ok {
+			os.Remove(statePath)
+			continue
+		}
+		if _, err := os.Stat(state.OutputPath); err != nil || !config.Enabled {
+			log.Printf("Dropping the unfinished upload of %s", state.OutputPath)
+			a.abortUpload(a.baseContext(), config, statePath, state)
+			continue
+		}
+		a.uploadOutput(a.baseContext(), Job{ID: state.JobID}, state.OutputPath)
+	}
+}
+
+// uploadKey expands the prefix template and appends the file name
+// Supports {year}, {month}, {day}, {jobId}, {encoder} and {source}
+// {year}, {month}, {day}, {jobId}, {encoder} ve {source} desteklenir
+func uploadKey(prefix string, job Job, outputPath string, now time.Time) string {
+	source := strings.TrimSuffix(filepath.Base(job.InputPath), filepath.Ext(job.InputPath))
+	expanded := strings.NewReplacer(
+		"{year}", now.Format("2006"),
+		"{month}", now.Format("01"),
+		"{day}", now.Format("02"),
+		"{jobId}", job.ID,
+		"{encoder}", job.Settings.Encoder,
+		"{source}", sanitizeFileName(source),
+	).Replace(prefix)
+	return strings.TrimPrefix(path.Join(expanded, filepath.Base(outputPath)), "/")
+}
+
+// uploadStatePath returns where the progress of an output's upload is saved, outside the user's library
+// Bir çıktının yükleme ilerlemesinin kaydedildiği yeri döndürür, kullanıcının kitaplığının dışında
+func (a *App) uploadStatePath(outputPath string) string {
+	return filepath.Join(a.appDir, "uploads", sha256Hex([]byte(outputPath))[:32]+".json")
+}
+
+// uploadFile sends a file as a multipart upload, resuming a saved one if possible
+// Failed parts are retried, once they run out the multipart upload is aborted so the bucket doesn't keep it
+// A cancelled upload keeps its progress for the next attempt
+// Başarısız parçalar yeniden denenir, denemeler bitince depo tutmasın diye çok parçalı yükleme iptal edilir
+func (a *App) uploadFile(ctx context.Context, config UploadConfig, jobID, filePath, key string) error {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	stat, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	statePath := a.uploadStatePath(filePath)
+	state, ok := loadUploadState(statePath)
+	if ok && (state.Key != key || state.Size != stat.Size() || !state.ModTime.Equal(stat.ModTime())) {
+		log.Printf("Output %s changed since its upload started, starting over", filePath)
+		a.abortUpload(ctx, config, statePath, state)
+		ok = false
+	}
+	if !ok {
+		partSize := int64(uploadPartSize)
+		if minimum := stat.Size()/uploadMaxParts + 1; minimum > partSize {
+			partSize = minimum
+		}
+		uploadID, err := createMultipartUpload(ctx, config, key)
+		if err != nil {
+			return err
+		}
+		state = uploadState{
+			JobID: jobID, OutputPath: filePath, Key: key, UploadID: uploadID,
+			Size: stat.Size(), ModTime: stat.ModTime(), PartSize: partSize, ETags: map[int]string{},
+		}
+		saveUploadState(statePath, state)
+	} else {
+		log.Printf("Resuming upload of %s with %d parts done", filePath, len(state.ETags))
+	}
+
+	parts := int((stat.Size() + state.PartSize - 1) / state.PartSize)
+	if parts == 0 {
+		parts = 1
+	}
+	buffer := make([]byte, state.PartSize)
+	for part := 1; part <= parts; part++ {
+		if _, done := state.ETags[part]; done {
+			continue
+		}
+		n, err := file.ReadAt(buffer, int64(part-1)*state.PartSize)
+		if err != nil && err != io.EOF {
+			return err
+		}
+		etag, err := uploadPartWithRetries(ctx, config, key, state.UploadID, part, buffer[:n])
+		if err != nil {
+			err = fmt.Errorf("part %d of %d: %w", part, parts, err)
+			if ctx.Err() == nil {
+				a.abortUpload(ctx, config, statePath, state)
+			}
+			return err
+		}
+		state.ETags[part] = etag
+		saveUploadState(statePath, state)
+
+		a.emitJobEvent(events.UploadProgress, jobID, UploadProgressEvent{
+			Path:    filePath,
+			Percent: float64(len(state.ETags)) / float64(parts) * 100,
+		})
+	}
+
+	if err := completeMultipartUpload(ctx, config, key, state); err != nil {
+		if ctx.Err() == nil {
+			a.abortUpload(ctx, config, statePath, state)
+		}
+		return err
+	}
+	os.Remove(statePath)
+	return nil
+}
+
+// abortUpload drops a multipart upload in the bucket and its saved progress
+// Depodaki çok parçalı bir yüklemeyi ve kayıtlı ilerlemesini bırakır
+func (a *App) abortUpload(ctx context.Context, config UploadConfig, statePath string, state uploadState) {
+	query := url.Values{"uploadId": {state.UploadID}}
+	if _, err := s3Request(ctx, config, http.MethodDelete, state.Key, query, nil, nil); err != nil {
+		log.Printf("Error aborting the upload of %s, the bucket may keep its parts: %v", state.Key, err)
+	}
+	os.Remove(statePath)
+}
+
+// createMultipartUpload starts a multipart upload and returns its ID
+// Çok parçalı bir yükleme başlatır ve kimliğini döndürür
+func createMultipartUpload(ctx context.Context, config UploadConfig, key string) (string, error) {
+	body, err := s3Request(ctx, config, http.MethodPost, key, url.Values{"uploads": {""}}, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	var result struct {
+		UploadID string `xml:"UploadId"`
+	}
+	if err := xml.Unmarshal(body, &result); err != nil || result.UploadID == "" {
+		return "", fmt.Errorf("unexpected response to upload creation")
+	}
+	return result.UploadID, nil
+}
+
+// uploadPartWithRetries sends one part, retrying it with a growing delay
+// Bir parçayı gönderir, artan bir beklemeyle yeniden dener
+func uploadPartWithRetries(ctx context.Context, config UploadConfig, key, uploadID string, part int, data []byte) (string, error) {
+	for attempt := 1; ; attempt++ {
+		etag, err := uploadPart(ctx, config, key, uploadID, part, data)
+		if err == nil || attempt > uploadPartRetries || ctx.Err() != nil {
+			return etag, err
+		}
+		log.Printf("Retrying part %d of %s after: %v", part, key, err)
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(time.Duration(attempt) * uploadRetryDelay):
+		}
+	}
+}
+
+// uploadPart sends one part and returns its ETag
+// Bir parçayı gönderir ve ETag değerini döndürür
+func uploadPart(ctx context.Context, config UploadConfig, key, uploadID string, part int, data []byte) (string, error) {
+	query := url.Values{"partNumber": {strconv.Itoa(part)}, "uploadId": {uploadID}}
+	var etag string
+	_, err := s3Request(ctx, config, http.MethodPut, key, query, data, func(resp *http.Response) {
+		etag = resp.Header.Get("ETag")
+	})
+	return etag, err
+}
+
+// completeMultipartUpload joins the uploaded parts into the object
+// Yüklenen parçaları nesnede birleştirir
+func completeMultipartUpload(ctx context.Context, config UploadConfig, key string, state uploadState) error {
+	type completedPart struct {
+		PartNumber int    `xml:"PartNumber"`
+		ETag       string `xml:"ETag"`
+	}
+	request := struct {
+		XMLName xml.Name        `xml:"CompleteMultipartUpload"`
+		Parts   []completedPart `xml:"Part"`
+	}{}
+	for part := 1; part <= len(state.ETags); part++ {
+		request.Parts = append(request.Parts, completedPart{PartNumber: part, ETag: state.ETags[part]})
+	}
+	data, err := xml.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	// S3 can report a failed completion with a 200 status and an error body
+	// S3 başarısız bir birleştirmeyi 200 durumu ve bir hata gövdesiyle bildirebilir
+	body, err := s3Request(ctx, config, http.MethodPost, key, url.Values{"uploadId": {state.UploadID}}, data, nil)
+	if err != nil {
+		return err
+	}
+	if bytes.Contains(body, []byte("<Error>")) {
+		return fmt.Errorf("upload completion failed: %s", firstLine(string(body)))
+	}
+	return nil
+}
+
+// s3Request sends a signed request for an object and returns the response body
+// Bir nesne için imzalı bir istek gönderir ve yanıt gövdesini döndürür
+func s3Request(ctx context.Context, config UploadConfig, method, key string, query url.Values, data []byte, onResponse func(*http.Response)) ([]byte, error) {
+	endpoint, err := url.Parse(config.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	target := *endpoint
+	if config.PathStyle {
+		target.Path = "/" + config.Bucket + "/" + key
+	} else {
+		target.Host = config.Bucket + "." + endpoint.Host
+		target.Path = "/" + key
+	}
+	target.RawPath = s3EscapePath(target.Path)
+	target.RawQuery = canonicalQuery(query)
+
+	req, err := http.NewRequestWithContext(ctx, method, target.String(), bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	payloadHash := emptyPayloadHash
+	if len(data) > 0 {
+		payloadHash = sha256Hex(data)
+	}
+	signS3Request(req, payloadHash, config, time.Now())
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode/100 != 2 {
+		return nil, fmt.Errorf("%s %s: %s: %s", method, key, resp.Status, s3ErrorMessage(body))
+	}
+	if onResponse != nil {
+		onResponse(resp)
+	}
+	return body, nil
+}
+
+// s3ErrorMessage extracts the message of an S3 error document
+// Bir S3 hata belgesinin mesajını çıkarır
+func s3ErrorMessage(body []byte) string {
+	var result struct {
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	}
+	if err := xml.Unmarshal(body, &result); err != nil || result.Code == "" {
+		return firstLine(string(body))
+	}
+	return result.Code + ": " + result.Message
+}
+
+// loadUploadState reads the saved progress of an upload
+// Bir yüklemenin kayıtlı ilerlemesini okur
+func loadUploadState(statePath string) (uploadState, bool) {
+	data, err := os.ReadFile(statePath)
+	if err != nil {
+		return uploadState{}, false
+	}
+	var state uploadState
+	if err := json.Unmarshal(data, &state); err != nil || state.ETags == nil {
+		return uploadState{}, false
+	}
+	return state, true
+}
+
+// saveUploadState writes the progress of an upload
+// Bir yüklemenin ilerlemesini yazar
+func saveUploadState(statePath string, state uploadState) {
+	if err := os.MkdirAll(filepath.Dir(statePath), 0755); err != nil {
+		log.Printf("Error creating upload state folder: %v", err)
+		return
+	}
+	data, err := json.Marshal(state)
+	if err != nil {
+		log.Printf("Error marshalling upload state: %v", err)
+		return
+	}
+	if err := os.WriteFile(statePath, data, 0644); err != nil {
+		log.Printf("Error writing upload state %s: %v", statePath, err)
+	}
+}
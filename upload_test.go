@@ -0,0 +1,138 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"AV1-video-converter/internal/events"
+)
+
+// fakeBucket is an S3 endpoint that fails the parts it is told to
+// Kendisine söylenen parçaları başarısız kılan bir S3 uç noktasıdır
+type fakeBucket struct {
+	mu        sync.Mutex
+	failures  map[string]int // Failures left per part number, -1 fails forever / Parça başına kalan hata, -1 hep başarısız
+	parts     map[string]int // Attempts per part number / Parça başına deneme sayısı
+	completed string         // Path of the completed upload / Tamamlanan yüklemenin yolu
+	aborted   bool
+}
+
+// ServeHTTP answers the multipart upload calls
+// Çok parçalı yükleme çağrılarını yanıtlar
+func (b *fakeBucket) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	query := r.URL.Query()
+	switch {
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		w.Write([]byte("<InitiateMultipartUploadResult><UploadId>upload-1</UploadId></InitiateMultipartUploadResult>"))
+	case r.Method == http.MethodPut:
+		part := query.Get("partNumber")
+		b.parts[part]++
+		if left := b.failures[part]; left != 0 {
+			b.failures[part] = left - 1
+			http.Error(w, "<Error><Code>InternalError</Code><Message>try again</Message></Error>", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("ETag", `"etag-`+part+`"`)
+	case r.Method == http.MethodPost:
+		b.completed = r.URL.Path
+		w.Write([]byte("<CompleteMultipartUploadResult/>"))
+	case r.Method == http.MethodDelete:
+		b.aborted = true
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// newUploadTest returns an app uploading to a fake bucket and an output to upload
+// Sahte bir depoya yükleyen bir uygulama ve yüklenecek bir çıktı döndürür
+func newUploadTest(t *testing.T, failures map[string]int) (*App, *fakeBucket, string) {
+	t.Helper()
+	bucket := &fakeBucket{failures: failures, parts: map[string]int{}}
+	server := httptest.NewServer(bucket)
+	t.Cleanup(server.Close)
+	delay := uploadRetryDelay
+	uploadRetryDelay = 0
+	t.Cleanup(func() { uploadRetryDelay = delay })
+
+	a := NewApp()
+	a.appDir = t.TempDir()
+	a.events = &eventRecorder{}
+	a.upload = UploadConfig{
+		Enabled: true, Endpoint: server.URL, Bucket: "videos", PathStyle: true,
+		AccessKeyID: "access", SecretAccessKey: "secret",
+	}
+	output := filepath.Join(t.TempDir(), "movie.mkv")
+	if err := os.WriteFile(output, []byte("av1"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return a, bucket, output
+}
+
+// TestUploadRetriesPart sends a failing part again and completes the upload
+// Başarısız bir parçayı yeniden gönderir ve yüklemeyi tamamlar
+func TestUploadRetriesPart(t *testing.T) {
+	a, bucket, output := newUploadTest(t, map[string]int{"1": 1})
+
+	if err := a.uploadOutput(context.Background(), Job{ID: "job"}, output); err != nil {
+		t.Fatalf("uploadOutput: %v", err)
+	}
+	if bucket.parts["1"] != 2 || bucket.completed == "" || bucket.aborted {
+		t.Errorf("attempts = %v, completed = %q, aborted = %v, want 2 attempts and a completed upload", bucket.parts, bucket.completed, bucket.aborted)
+	}
+	if completed := a.events.(*eventRecorder).of(events.UploadCompleted); len(completed) != 1 || completed[0].Data.(UploadCompletedEvent).Path != output {
+		t.Errorf("upload.completed events = %+v, want one for %s", completed, output)
+	}
+	if _, err := os.Stat(a.uploadStatePath(output)); !os.IsNotExist(err) {
+		t.Errorf("upload state was kept: %v", err)
+	}
+}
+
+// TestUploadAbortsAfterRetries aborts the multipart upload once the retries of a part run out
+// Bir parçanın denemeleri bitince çok parçalı yüklemeyi iptal eder
+func TestUploadAbortsAfterRetries(t *testing.T) {
+	a, bucket, output := newUploadTest(t, map[string]int{"1": -1})
+
+	if err := a.uploadOutput(context.Background(), Job{ID: "job"}, output); err == nil {
+		t.Fatal("uploadOutput succeeded although every attempt failed")
+	}
+	if bucket.parts["1"] != uploadPartRetries+1 || !bucket.aborted || bucket.completed != "" {
+		t.Errorf("attempts = %v, completed = %q, aborted = %v, want %d attempts and an aborted upload", bucket.parts, bucket.completed, bucket.aborted, uploadPartRetries+1)
+	}
+	if _, err := os.Stat(a.uploadStatePath(output)); !os.IsNotExist(err) {
+		t.Errorf("upload state was kept: %v", err)
+	}
+	if _, err := os.Stat(output + ".upload.json"); !os.IsNotExist(err) {
+		t.Errorf("upload state was written next to the output: %v", err)
+	}
+}
+
+// TestUploadResumesWithSavedKey keeps the key and the finished parts of an interrupted upload
+// Yarıda kalan bir yüklemenin anahtarını ve bitmiş parçalarını korur
+func TestUploadResumesWithSavedKey(t *testing.T) {
+	a, bucket, output := newUploadTest(t, map[string]int{})
+	stat, err := os.Stat(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	saveUploadState(a.uploadStatePath(output), uploadState{
+		JobID: "job", OutputPath: output, Key: "2025/01/01/movie.mkv", UploadID: "upload-1",
+		Size: stat.Size(), ModTime: stat.ModTime(), PartSize: uploadPartSize, ETags: map[int]string{1: `"etag-1"`},
+	})
+
+	if err := a.RetryUpload("job"); err == nil {
+		t.Error("RetryUpload of an unknown job succeeded")
+	}
+	a.resumeUploads()
+	if bucket.parts["1"] != 0 || bucket.completed != "/videos/2025/01/01/movie.mkv" {
+		t.Errorf("attempts = %v, completed = %q, want the saved part skipped and the saved key completed", bucket.parts, bucket.completed)
+	}
+	if _, err := os.Stat(a.uploadStatePath(output)); !os.IsNotExist(err) {
+		t.Errorf("upload state was kept: %v", err)
+	}
+}
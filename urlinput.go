@@ -0,0 +1,208 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"AV1-video-converter/internal/probe"
+)
+
+// urlCheckTimeout bounds the reachability check of a URL input
+// Bir URL girişinin erişilebilirlik kontrolünü sınırlar
+const urlCheckTimeout = 15 * time.Second
+
+// isURLInput reports whether an input is an http or https URL
+// Bir girişin http veya https adresi olup olmadığını bildirir
+func isURLInput(input string) bool {
+	lower := strings.ToLower(input)
+	return strings.HasPrefix(lower, "http://") || strings.HasPrefix(lower, "https://")
+}
+
+// AddURL checks and probes a direct video link so it can be queued
+// Unreachable sources are reported with the HTTP status or network error
+// Erişilemeyen kaynaklar HTTP durumu veya ağ hatasıyla bildirilir
+func (a *App) AddURL(rawURL string) (VideoInfo, error) {
+	rawURL = strings.TrimSpace(rawURL)
+	if !isURLInput(rawURL) {
+		return VideoInfo{}, fmt.Errorf("only http and https links are supported")
+	}
+	if _, err := url.Parse(rawURL); err != nil {
+		return VideoInfo{}, fmt.Errorf("invalid link: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(a.baseContext(), urlCheckTimeout)
+	defer cancel()
+	if err := checkURLReachable(ctx, rawURL); err != nil {
+		log.Printf("Source %s is unreachable: %v", rawURL, err)
+		return VideoInfo{}, fmt.Errorf("source unreachable: %v", err)
+	}
+
+	info, err := a.getVideoInfo(rawURL)
+	if err != nil {
+		log.Printf("Error probing %s: %v", rawURL, err)
+		return VideoInfo{}, fmt.Errorf("failed to probe %s: %v", rawURL, err)
+	}
+	return info, nil
+}
+
+// checkURLReachable asks the server for the first byte of the source
+// A ranged GET works on servers that reject HEAD
+// Aralıklı bir GET, HEAD isteğini reddeden sunucularda da çalışır
+func checkURLReachable(ctx context.Context, rawURL string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Range", "bytes=0-0")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("server returned %s", resp.Status)
+	}
+	return nil
+}
+
+// inputBaseName returns the file name of a local path or the path of a URL
+// Yerel bir yolun dosya adını veya bir adresin yolunu döndürür
+func inputBaseName(input string) string {
+	if isURLInput(input) {
+		if parsed, err := url.Parse(input); err == nil {
+			if name := path.Base(parsed.Path); name != "/" && name != "." {
+				return name
+			}
+			return parsed.Hostname()
+		}
+	}
+	return filepath.Base(input)
+}
+
+// inputArgs returns the input options of a source
+// URL inputs reconnect when the connection drops mid-stream
+// URL girişleri bağlantı akış ortasında koptuğunda yeniden bağlanır
+func inputArgs(input string) []string {
+	if !isURLInput(input) {
+		return append(probe.InputArgs(input), "-i", input)
+	}
+	return []string{
+		"-reconnect", "1",
+		"-reconnect_streamed", "1",
+		"-reconnect_on_network_error", "1",
+		"-reconnect_delay_max", "30",
+		"-i", input,
+	}
+}
+
+// downloadInput fetches a URL input into a folder, resuming after a dropped connection
+// Bir URL girişini bir klasöre indirir, kopan bağlantıdan sonra devam eder
+func (a *App) downloadInput(ctx context.Context, jobID, rawURL, dir string) (string, error) {
+	target := filepath.Join(dir, sanitizeFileName(inputBaseName(rawURL)))
+	var err error
+	for attempt := 0; attempt <= a.currentPreferences().CopyRetries; attempt++ {
+		if attempt > 0 {
+			log.Printf("Retrying download of %s (attempt %d of %d): %v", rawURL, attempt, a.currentPreferences().CopyRetries, err)
+			select {
+			case <-ctx.Done():
+				return "", context.Cause(ctx)
+			case <-time.After(time.Duration(attempt) * 2 * time.Second):
+			}
+		}
+		if err = a.resumeDownload(ctx, jobID, rawURL, target); err == nil {
+			return target, nil
+		}
+		if ctx.Err() != nil {
+			return "", err
+		}
+	}
+	return "", err
+}
+
+// resumeDownload appends the missing part of a URL to a file
+// Bir adresin eksik kısmını bir dosyaya ekler
+func (a *App) resumeDownload(ctx context.Context, jobID, rawURL, target string) error {
+	out, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	offset, err := out.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return err
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusRequestedRangeNotSatisfiable && offset > 0:
+		// The file is already complete
+		// Dosya zaten tamamlanmış
+		return nil
+	case resp.StatusCode == http.StatusOK && offset > 0:
+		// The server ignored the range, start over
+		// Sunucu aralığı yok saydı, baştan başla
+		if err := out.Truncate(0); err != nil {
+			return err
+		}
+		if _, err := out.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		offset = 0
+	case resp.StatusCode >= 400:
+		return fmt.Errorf("server returned %s", resp.Status)
+	}
+
+	total := int64(-1)
+	if resp.ContentLength >= 0 {
+		total = offset + resp.ContentLength
+	}
+	started, received := time.Now(), int64(0)
+	throttle := a.newProgressThrottle()
+	for {
+		n, err := io.CopyN(out, resp.Body, copyChunkSize)
+		received += n
+		if !throttle.allow(err != nil) {
+			continue
+		}
+		speed := ""
+		if elapsed := time.Since(started).Seconds(); elapsed > 0 {
+			speed = fmt.Sprintf("%.1f MB/s", float64(received)/elapsed/1024/1024)
+		}
+		progress := 0.0
+		if total > 0 {
+			progress = float64(offset+received) / float64(total) * 100
+		}
+		a.emitProgress(jobID, "download", progress, speed)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if total > 0 && offset+received != total {
+		return fmt.Errorf("download incomplete: %d of %d bytes", offset+received, total)
+	}
+	return nil
+}
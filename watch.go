@@ -0,0 +1,286 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// watchPollInterval is how often the watched folders are scanned
+// İzlenen klasörlerin ne sıklıkla tarandığı
+const watchPollInterval = 10 * time.Second
+
+// archiveProfilePrefix marks watch folder profiles that select an archival mode
+// Arşiv modu seçen izleme klasörü profillerini işaretler
+const archiveProfilePrefix = "archive-"
+
+// WatchFolder is a folder whose new files are queued automatically
+// Each folder routes its files to its own profile and destination
+// Her klasör dosyalarını kendi profiline ve hedefine yönlendirir
+type WatchFolder struct {
+	Path        string `json:"path"`        // Watched folder / İzlenen klasör
+	Recursive   bool   `json:"recursive"`   // Also watch the subfolders / Alt klasörleri de izle
+	Profile     string `json:"profile"`     // Platform profile or archive-<mode>, empty for the current settings / Platform profili veya archive-<mod>, boşsa geçerli ayarlar
+	Destination string `json:"destination"` // Output folder, empty for next to the source / Çıktı klasörü, boşsa kaynağın yanı
+	Workflow    string `json:"workflow"`    // Workflow applied instead of the profile / Profil yerine uygulanan iş akışı
+}
+
+// watchedFile is the last seen state of a file in a watched folder
+// İzlenen bir klasördeki dosyanın son görülen durumu
+type watchedFile struct {
+	size    int64
+	modTime time.Time
+	queued  bool
+}
+
+// startWatchFolders starts polling the configured watch folders
+// Files present when watching starts are left alone, only new ones are queued
+// İzleme başladığında var olan dosyalara dokunulmaz, yalnızca yenileri kuyruğa eklenir
+func (a *App) startWatchFolders() {
+	if len(a.currentPreferences().WatchFolders) == 0 {
+		return
+	}
+	folders := append([]WatchFolder(nil), a.currentPreferences().WatchFolders...)
+	for _, folder := range folders {
+		if !statWatchFolder(folder.Path) {
+			log.Printf("Watch folder %s doesn't exist yet", folder.Path)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(a.baseContext())
+	a.watchMu.Lock()
+	a.watchCancel = cancel
+	a.watchMu.Unlock()
+
+	go func() {
+		defer a.recoverCrash("watchFolders")
+		seen := make(map[string]*watchedFile)
+		a.pollWatchFolders(folders, seen, true)
+
+		ticker := time.NewTicker(watchPollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				a.pollWatchFolders(folders, seen, false)
+			}
+		}
+	}()
+	log.Printf("Watching %d folders", len(folders))
+}
+
+// stopWatchFolders stops polling the watch folders
+// İzleme klasörlerini yoklamayı durdurur
+func (a *App) stopWatchFolders() {
+	a.watchMu.Lock()
+	cancel := a.watchCancel
+	a.watchCancel = nil
+	a.watchMu.Unlock()
+
+	if cancel != nil {
+		cancel()
+	}
+}
+
+// pollWatchFolders scans the watch folders once and queues the files that settled
+// A file is queued once its size and modification time are unchanged between two polls
+// Bir dosya, boyutu ve değişiklik zamanı iki yoklama arasında değişmediğinde kuyruğa eklenir
+func (a *App) pollWatchFolders(folders []WatchFolder, seen map[string]*watchedFile, initial bool) {
+	present := make(map[string]bool)
+	for _, folder := range folders {
+		err := filepath.WalkDir(folder.Path, func(path string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				return nil
+			}
+			if entry.IsDir() {
+				if path != folder.Path && !folder.Recursive {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if present[path] || !a.isMediaFile(path) || isConverterOutput(path) {
+				return nil
+			}
+			info, err := entry.Info()
+			if err != nil {
+				return nil
+			}
+			present[path] = true
+
+			state, ok := seen[path]
+			if !ok {
+				seen[path] = &watchedFile{size: info.Size(), modTime: info.ModTime(), queued: initial}
+				return nil
+			}
+			if state.queued {
+				return nil
+			}
+			if state.size != info.Size() || !state.modTime.Equal(info.ModTime()) {
+				state.size, state.modTime = info.Size(), info.ModTime()
+				return nil
+			}
+			state.queued = true
+			a.queueWatchedFile(path, routeWatchFolder(folders, path))
+			return nil
+		})
+		if err != nil {
+			log.Printf("Error scanning watch folder %s: %v", folder.Path, err)
+		}
+	}
+
+	// Forget removed files so a file copied in again is queued again
+	// Yeniden kopyalanan bir dosya yeniden kuyruğa eklensin diye silinen dosyaları unut
+	for path := range seen {
+		if !present[path] {
+			delete(seen, path)
+		}
+	}
+}
+
+// queueWatchedFile queues a settled file with the rules of its watch folder
+// Yerleşmiş bir dosyayı izleme klasörünün kurallarıyla kuyruğa ekler
+func (a *App) queueWatchedFile(path string, folder WatchFolder) {
+	// A bound workflow brings its own rule, settings and notifications
+	// Bağlı bir iş akışı kendi kuralını, ayarlarını ve bildirimlerini getirir
+	if folder.Workflow != "" {
+		workflow, ok := a.findWorkflow(folder.Workflow)
+		if !ok {
+			log.Printf("Skipping watched file %s, unknown workflow %s", path, folder.Workflow)
+			return
+		}
+		if !workflow.Source.matches(path) {
+			return
+		}
+		destination := folder.Destination
+		if destination == "" {
+			destination = workflow.Destination
+		}
+		if _, err := a.queueWorkflowFile(path, workflow, destination); err != nil {
+			log.Printf("Skipping watched file %s: %v", path, err)
+		}
+		return
+	}
+
+	info, err := a.getVideoInfo(path)
+	if err != nil {
+		log.Printf("Error probing watched file %s: %v", path, err)
+		return
+	}
+	if info.Codec == "av1" {
+		log.Printf("Skipping watched file %s, already AV1", path)
+		return
+	}
+
+	settings, err := watchProfileSettings(a.GetSettings(), folder.Profile)
+	if err != nil {
+		log.Printf("Error applying watch folder profile for %s: %v", path, err)
+		return
+	}
+	if tag, _ := musicOutputName(settings); a.isAudioFile(path) && info.Codec == tag {
+		log.Printf("Skipping watched file %s, already %s", path, tag)
+		return
+	}
+	if _, err := a.queueFile(path, folder.Destination, info, settings); err != nil {
+		log.Printf("Error queueing watched file %s: %v", path, err)
+	}
+}
+
+// routeWatchFolder picks the rule of the deepest watch folder containing the path
+// Nested folders such as Incoming and Incoming/4K each keep their own rules
+// Incoming ve Incoming/4K gibi iç içe klasörlerin her biri kendi kurallarını korur
+func routeWatchFolder(folders []WatchFolder, path string) WatchFolder {
+	var best WatchFolder
+	for _, folder := range folders {
+		rel, err := filepath.Rel(folder.Path, filepath.Dir(path))
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			continue
+		}
+		if rel != "." && !folder.Recursive {
+			continue
+		}
+		if len(folder.Path) > len(best.Path) {
+			best = folder
+		}
+	}
+	return best
+}
+
+// watchProfileSettings applies a watch folder profile to the base settings
+// Bir izleme klasörü profilini temel ayarlara uygular
+func watchProfileSettings(settings ConversionSettings, profile string) (ConversionSettings, error) {
+	if profile == "" {
+		return settings, nil
+	}
+	if mode := strings.TrimPrefix(profile, archiveProfilePrefix); mode != profile {
+		if !containsString(archivalModes, mode) {
+			return settings, fmt.Errorf("unknown archival mode: %s", mode)
+		}
+		return archivalSettings(settings, mode), nil
+	}
+	platform, ok := findPlatformProfile(profile)
+	if !ok {
+		return settings, fmt.Errorf("unknown profile: %s", profile)
+	}
+	return applyPlatformProfile(settings, platform), nil
+}
+
+// isConverterOutput reports whether a file name carries an output tag of this app
+// Keeps outputs written into a watched folder from being queued again
+// İzlenen bir klasöre yazılan çıktıların yeniden kuyruğa eklenmesini önler
+func isConverterOutput(path string) bool {
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	tags := []string{"av1", "clip"}
+	for _, format := range musicFormats {
+		tags = append(tags, format.codec)
+	}
+	for _, spec := range encoderSpecs {
+		if spec.intermediate != "" {
+			tags = append(tags, spec.intermediate)
+		}
+	}
+	for _, tag := range tags {
+		if strings.HasSuffix(name, "_"+tag) || strings.Contains(name, "_"+tag+"_") {
+			return true
+		}
+	}
+	return false
+}
+
+// validateWatchFolders checks the watch folder rules
+// İzleme klasörü kurallarını kontrol eder
+func validateWatchFolders(folders []WatchFolder) error {
+	paths := make(map[string]bool)
+	for _, folder := range folders {
+		if !filepath.IsAbs(folder.Path) {
+			return fmt.Errorf("watch folder must be an absolute path: %q", folder.Path)
+		}
+		if paths[filepath.Clean(folder.Path)] {
+			return fmt.Errorf("watch folder listed twice: %s", folder.Path)
+		}
+		paths[filepath.Clean(folder.Path)] = true
+		if folder.Destination != "" && !filepath.IsAbs(folder.Destination) {
+			return fmt.Errorf("destination of %s must be an absolute path", folder.Path)
+		}
+		if _, err := watchProfileSettings(ConversionSettings{}, folder.Profile); err != nil {
+			return fmt.Errorf("watch folder %s: %v", folder.Path, err)
+		}
+		if folder.Workflow != "" && folder.Profile != "" {
+			return fmt.Errorf("watch folder %s: use either a profile or a workflow", folder.Path)
+		}
+	}
+	return nil
+}
+
+// statWatchFolder reports whether a watch folder exists, used to warn on startup
+// Bir izleme klasörünün var olup olmadığını bildirir, başlangıçta uyarmak için kullanılır
+func statWatchFolder(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+// defaultMinWorkingSpaceGB is the free space kept on the working folder by default
+// Çalışma klasöründe varsayılan olarak boş bırakılan alan
+const defaultMinWorkingSpaceGB = 5
+
+// workingDir returns the folder temporary encodes, chunks and samples are written to
+// Falls back to the system temp folder when no working folder is set
+// Çalışma klasörü ayarlanmamışsa sistemin geçici klasörüne döner
+func (a *App) workingDir() string {
+	if folder := a.currentPreferences().WorkingFolder; folder != "" {
+		return folder
+	}
+	return os.TempDir()
+}
+
+// makeWorkDir creates a uniquely named folder inside the working folder
+// Çalışma klasörünün içinde benzersiz adlı bir klasör oluşturur
+func (a *App) makeWorkDir(prefix string) (string, error) {
+	root := a.workingDir()
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return "", err
+	}
+	return os.MkdirTemp(root, prefix)
+}
+
+// workCacheDir returns a named cache folder inside the working folder
+// Çalışma klasörünün içindeki adlandırılmış bir önbellek klasörünü döndürür
+func (a *App) workCacheDir(name string) string {
+	return filepath.Join(a.workingDir(), name)
+}
+
+// checkWorkingSpace fails when the working folder can't hold a job's temporary files
+// The encode may grow as large as the source, so that much plus the reserve must be free
+// Kodlama kaynak kadar büyüyebilir, bu yüzden o kadarı artı yedek alan boş olmalıdır
+func (a *App) checkWorkingSpace(dir string, sourceSize int64) error {
+	reserve := int64(a.currentPreferences().MinWorkingSpaceGB) * 1024 * 1024 * 1024
+	if reserve == 0 {
+		return nil
+	}
+	free, err := freeDiskSpace(dir)
+	if err != nil {
+		log.Printf("Error reading free space of %s: %v", dir, err)
+		return nil
+	}
+	if need := sourceSize + reserve; free < need {
+		return fmt.Errorf("not enough free space in %s: %.1f GB free, %.1f GB needed", dir, float64(free)/(1<<30), float64(need)/(1<<30))
+	}
+	return nil
+}
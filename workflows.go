@@ -0,0 +1,318 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"log"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// workflowNotifyTimeout limits how long a workflow notification may take
+// Bir iş akışı bildiriminin ne kadar sürebileceğini sınırlar
+const workflowNotifyTimeout = 15 * time.Second
+
+// workflowPostActions lists the steps a workflow can turn on after each encode
+// Bir iş akışının her kodlamadan sonra açabileceği adımları listeler
+var workflowPostActions = []string{"replace-original", "checksum", "copy-sidecars", "preserve-timestamps"}
+
+// WorkflowSource struct
+// Represents the rule picking the files of a workflow
+// Bir iş akışının dosyalarını seçen kuralı temsil eder
+type WorkflowSource struct {
+	Folder    string `json:"folder"`    // Folder scanned on a run, unused when bound to a watch folder / Çalıştırmada taranan klasör, izleme klasörüne bağlıyken kullanılmaz
+	Recursive bool   `json:"recursive"` // Also scan the subfolders / Alt klasörleri de tara
+	Pattern   string `json:"pattern"`   // File name pattern such as *.mkv, empty for every media file / *.mkv gibi dosya adı deseni, boşsa her medya dosyası
+	MinSizeMB int    `json:"minSizeMB"` // Smallest file picked in MB, 0 for any / MB cinsinden seçilen en küçük dosya, 0 ise hepsi
+}
+
+// Workflow struct
+// Represents a named batch job that can be re-run or bound to a watch folder
+// Yeniden çalıştırılabilen veya bir izleme klasörüne bağlanabilen adlandırılmış bir toplu işi temsil eder
+type Workflow struct {
+	Name        string         `json:"name"`        // Unique name / Benzersiz ad
+	Source      WorkflowSource `json:"source"`      // Files the workflow picks / İş akışının seçtiği dosyalar
+	Profile     string         `json:"profile"`     // Platform profile or archive-<mode>, empty for the current settings / Platform profili veya archive-<mode>, boşsa geçerli ayarlar
+	Destination string         `json:"destination"` // Output folder, empty for next to the source / Çıktı klasörü, boşsa kaynağın yanı
+	PostActions []string       `json:"postActions"` // Steps from workflowPostActions / workflowPostActions içindeki adımlar
+	NotifyURL   string         `json:"notifyUrl"`   // Webhook told about every finished job / Biten her iş hakkında bilgilendirilen webhook
+}
+
+// WorkflowRun struct
+// Represents the files a workflow run queued and left out
+// Bir iş akışı çalıştırmasının kuyruğa eklediği ve dışarıda bıraktığı dosyaları temsil eder
+type WorkflowRun struct {
+	Queued  []string `json:"queued"`  // Queued job IDs / Kuyruğa eklenen iş kimlikleri
+	Skipped []string `json:"skipped"` // Files left out and why / Dışarıda bırakılan dosyalar ve nedeni
+}
+
+// workflowNotification is the body posted to the notify URL of a workflow
+// Bir iş akışının bildirim adresine gönderilen gövdedir
+type workflowNotification struct {
+	Workflow   string    `json:"workflow"`
+	JobID      string    `json:"jobId"`
+	InputPath  string    `json:"inputPath"`
+	OutputPath string    `json:"outputPath"`
+	Status     string    `json:"status"`
+	Error      string    `json:"error,omitempty"`
+	FinishedAt time.Time `json:"finishedAt"`
+}
+
+// GetWorkflows returns the saved workflows
+// Kayıtlı iş akışlarını döndürür
+func (a *App) GetWorkflows() []Workflow {
+	return a.currentWorkflows()
+}
+
+// currentWorkflows returns a copy of the workflows safe to use outside the lock
+// Kilit dışında kullanılabilecek iş akışlarının bir kopyasını döndürür
+func (a *App) currentWorkflows() []Workflow {
+	a.workflowsMu.Lock()
+	defer a.workflowsMu.Unlock()
+	workflows := make([]Workflow, 0, len(a.workflows))
+	for _, workflow := range a.workflows {
+		workflows = append(workflows, workflow.clone())
+	}
+	return workflows
+}
+
+// clone returns a copy of the workflow that shares no slices with it
+// İş akışının onunla dilim paylaşmayan bir kopyasını döndürür
+func (w Workflow) clone() Workflow {
+	w.PostActions = append([]string(nil), w.PostActions...)
+	return w
+}
+
+// SaveWorkflows validates and stores the workflows
+// İş akışlarını doğrular ve kaydeder
+func (a *App) SaveWorkflows(workflows []Workflow) error {
+	seen := make(map[string]bool)
+	for _, workflow := range workflows {
+		if err := validateWorkflow(workflow); err != nil {
+			log.Printf("Rejected workflow %s: %v", workflow.Name, err)
+			return err
+		}
+		if seen[workflow.Name] {
+			return fmt.Errorf("duplicate workflow name: %s", workflow.Name)
+		}
+		seen[workflow.Name] = true
+	}
+	saved := make([]Workflow, 0, len(workflows))
+	for _, workflow := range workflows {
+		saved = append(saved, workflow.clone())
+	}
+	a.workflowsMu.Lock()
+	a.workflows = saved
+	a.workflowsMu.Unlock()
+	a.saveConfig()
+	return nil
+}
+
+// RunWorkflow queues every file of the workflow's source folder that matches its rule
+// Files that are already AV1 or already waiting in the queue are skipped
+// Zaten AV1 olan veya kuyrukta bekleyen dosyalar atlanır
+func (a *App) RunWorkflow(name string) (WorkflowRun, error) {
+	workflow, ok := a.findWorkflow(name)
+	if !ok {
+		return WorkflowRun{}, fmt.Errorf("unknown workflow: %s", name)
+	}
+	if workflow.Source.Folder == "" {
+		return WorkflowRun{}, fmt.Errorf("workflow %s has no source folder, bind it to a watch folder instead", name)
+	}
+
+	waiting := make(map[string]bool)
+	for _, job := range a.GetJobs() {
+		if job.Status == "queued" || job.Status == "running" {
+			waiting[job.InputPath] = true
+		}
+	}
+
+	run := WorkflowRun{Queued: []string{}, Skipped: []string{}}
+	err := filepath.WalkDir(workflow.Source.Folder, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if entry.IsDir() {
+			if path != workflow.Source.Folder && !workflow.Source.Recursive {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !a.isMediaFile(path) || isConverterOutput(path) || !workflow.Source.matches(path) {
+			return nil
+		}
+		if waiting[path] {
+			run.Skipped = append(run.Skipped, fmt.Sprintf("%s: already queued", path))
+			return nil
+		}
+		jobID, err := a.queueWorkflowFile(path, workflow, workflow.Destination)
+		if err != nil {
+			run.Skipped = append(run.Skipped, fmt.Sprintf("%s: %v", path, err))
+			return nil
+		}
+		run.Queued = append(run.Queued, jobID)
+		return nil
+	})
+	if err != nil {
+		return run, fmt.Errorf("failed to scan %s: %v", workflow.Source.Folder, err)
+	}
+	log.Printf("Workflow %s queued %d files, skipped %d", name, len(run.Queued), len(run.Skipped))
+	return run, nil
+}
+
+// validateWorkflow checks the fields of a workflow
+// Bir iş akışının alanlarını kontrol eder
+func validateWorkflow(workflow Workflow) error {
+	if strings.TrimSpace(workflow.Name) == "" {
+		return fmt.Errorf("workflow name is required")
+	}
+	if _, err := filepath.Match(workflow.Source.Pattern, ""); err != nil {
+		return fmt.Errorf("invalid file pattern %q", workflow.Source.Pattern)
+	}
+	if workflow.Source.MinSizeMB < 0 {
+		return fmt.Errorf("minimum size must not be negative")
+	}
+	if _, err := watchProfileSettings(defaultSettings(), workflow.Profile); err != nil {
+		return err
+	}
+	for _, action := range workflow.PostActions {
+		if !containsString(workflowPostActions, action) {
+			return fmt.Errorf("post action must be one of %s", strings.Join(workflowPostActions, ", "))
+		}
+	}
+	if containsString(workflow.PostActions, "replace-original") && workflow.Destination != "" {
+		return fmt.Errorf("replace-original writes next to the source, leave the destination empty")
+	}
+	if workflow.NotifyURL != "" {
+		target, err := url.Parse(workflow.NotifyURL)
+		if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+			return fmt.Errorf("notify URL must be an http or https address")
+		}
+	}
+	return nil
+}
+
+// findWorkflow returns the workflow with the given name
+// Verilen ada sahip iş akışını döndürür
+func (a *App) findWorkflow(name string) (Workflow, bool) {
+	a.workflowsMu.Lock()
+	defer a.workflowsMu.Unlock()
+	for _, workflow := range a.workflows {
+		if workflow.Name == name {
+			return workflow.clone(), true
+		}
+	}
+	return Workflow{}, false
+}
+
+// matches reports whether a file passes the pattern and size rule
+// Bir dosyanın desen ve boyut kuralından geçip geçmediğini bildirir
+func (s WorkflowSource) matches(path string) bool {
+	if s.Pattern != "" {
+		if ok, _ := filepath.Match(strings.ToLower(s.Pattern), strings.ToLower(filepath.Base(path))); !ok {
+			return false
+		}
+	}
+	return fileSize(path) >= int64(s.MinSizeMB)*1024*1024
+}
+
+// workflowSettings applies the profile and post actions of a workflow to the current settings
+// Bir iş akışının profilini ve sonraki adımlarını geçerli ayarlara uygular
+func (a *App) workflowSettings(workflow Workflow) (ConversionSettings, error) {
+	settings, err := watchProfileSettings(a.GetSettings(), workflow.Profile)
+	if err != nil {
+		return settings, err
+	}
+	for _, action := range workflow.PostActions {
+		switch action {
+		case "replace-original":
+			settings.ReplaceOriginal = true
+		case "checksum":
+			if settings.ChecksumManifest == "" {
+				settings.ChecksumManifest = "json"
+			}
+		case "copy-sidecars":
+			settings.CopySidecars = true
+		case "preserve-timestamps":
+			settings.PreserveTimestamps = true
+		}
+	}
+	return settings, nil
+}
+
+// queueWorkflowFile probes a file and queues it with the settings of a workflow
+// Bir dosyayı inceler ve bir iş akışının ayarlarıyla kuyruğa ekler
+func (a *App) queueWorkflowFile(path string, workflow Workflow, destination string) (string, error) {
+	info, err := a.getVideoInfo(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to probe: %v", err)
+	}
+	if info.Codec == "av1" {
+		return "", fmt.Errorf("already AV1")
+	}
+	settings, err := a.workflowSettings(workflow)
+	if err != nil {
+		return "", err
+	}
+	if tag, _ := musicOutputName(settings); a.isAudioFile(path) && info.Codec == tag {
+		return "", fmt.Errorf("already %s", tag)
+	}
+	if errs := validateSettings(settings); len(errs) > 0 {
+		return "", errs
+	}
+
+	job, err := a.queueFile(path, destination, info, settings)
+	if err != nil {
+		return "", err
+	}
+	a.updateJob(job.ID, func(job *Job) {
+		job.Workflow = workflow.Name
+	})
+	return job.ID, nil
+}
+
+// notifyWorkflow posts the outcome of a finished workflow job to the workflow's notify URL
+// Biten bir iş akışı işinin sonucunu iş akışının bildirim adresine gönderir
+func (a *App) notifyWorkflow(job Job) {
+	workflow, ok := a.findWorkflow(job.Workflow)
+	if !ok || workflow.NotifyURL == "" {
+		return
+	}
+	data, err := json.Marshal(workflowNotification{
+		Workflow:   workflow.Name,
+		JobID:      job.ID,
+		InputPath:  job.InputPath,
+		OutputPath: job.OutputPath,
+		Status:     job.Status,
+		Error:      job.Error,
+		FinishedAt: time.Now(),
+	})
+	if err != nil {
+		log.Printf("Error marshalling workflow notification: %v", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(a.baseContext(), workflowNotifyTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, workflow.NotifyURL, bytes.NewReader(data))
+	if err != nil {
+		log.Printf("Error creating workflow notification: %v", err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		log.Printf("Error notifying workflow %s: %v", workflow.Name, err)
+		return
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		log.Printf("Workflow %s notify URL answered %s", workflow.Name, resp.Status)
+	}
+}
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"AV1-video-converter/internal/progress"
+)
+
+// Zone struct
+// Represents a time range encoded with its own quality, e.g. the end credits
+// Kendi kalitesiyle kodlanan bir zaman aralığını temsil eder, örn. jenerik
+type Zone struct {
+	Label  string  `json:"label"`  // Free text shown in the UI / Arayüzde gösterilen serbest metin
+	Start  float64 `json:"start"`  // Start in seconds / Saniye cinsinden başlangıç
+	End    float64 `json:"end"`    // End in seconds / Saniye cinsinden bitiş
+	CRF    int     `json:"crf"`    // Quality value of the range / Aralığın kalite değeri
+	Preset string  `json:"preset"` // Preset of the range, empty keeps the base preset / Aralığın ön ayarı, boşsa temel ön ayar
+}
+
+// zoneSegment struct
+// Represents a piece of the timeline encoded by a separate FFmpeg run
+// Ayrı bir FFmpeg çalıştırmasıyla kodlanan zaman çizelgesi parçasını temsil eder
+type zoneSegment struct {
+	Start    float64            // Start in seconds / Saniye cinsinden başlangıç
+	End      float64            // End in seconds, 0 for the end of the file / Saniye cinsinden bitiş, 0 dosya sonu
+	Settings ConversionSettings // Settings of the segment / Parçanın ayarları
+}
+
+// validateZones checks the zones against the encoder limits and each other
+// Bölgeleri kodlayıcı sınırlarına ve birbirlerine göre kontrol eder
+func validateZones(settings ConversionSettings, spec encoderSpec) ValidationErrors {
+	var errs ValidationErrors
+	zones := sortedZones(settings.Zones)
+	for i, zone := range zones {
+		value := fmt.Sprintf("%s %.3f-%.3f", zone.Label, zone.Start, zone.End)
+		switch {
+		case zone.Start < 0 || zone.End <= zone.Start:
+			errs = append(errs, ValidationError{Field: "zones", Value: value, Message: "end must be after start"})
+		case i > 0 && zone.Start < zones[i-1].End:
+			errs = append(errs, ValidationError{Field: "zones", Value: value, Message: "zones must not overlap"})
+		}
+		if zone.CRF < spec.qualityMin || zone.CRF > spec.qualityMax {
+			errs = append(errs, ValidationError{
+				Field:   "zones",
+				Value:   value,
+				Message: fmt.Sprintf("quality must be between %d and %d for %s", spec.qualityMin, spec.qualityMax, settings.Encoder),
+			})
+		}
+		if zone.Preset != "" && !containsString(spec.presets, zone.Preset) {
+			errs = append(errs, ValidationError{
+				Field:   "zones",
+				Value:   value,
+				Message: fmt.Sprintf("preset must be one of %s for %s", strings.Join(spec.presets, ", "), settings.Encoder),
+			})
+		}
+	}
+	return errs
+}
+
+// sortedZones returns a copy of the zones ordered by start time
+// Bölgelerin başlangıç zamanına göre sıralanmış bir kopyasını döndürür
+func sortedZones(zones []Zone) []Zone {
+	sorted := append([]Zone(nil), zones...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Start < sorted[j].Start
+	})
+	return sorted
+}
+
+// zoneSegments splits the timeline into zones and the base quality gaps between them
+// Zaman çizelgesini bölgelere ve aralarındaki temel kalite boşluklarına böler
+func zoneSegments(settings ConversionSettings, duration float64) []zoneSegment {
+	base := settings
+	base.Zones = nil
+
+	var segments []zoneSegment
+	cursor := 0.0
+	for _, zone := range sortedZones(settings.Zones) {
+		if duration > 0 && zone.Start >= duration {
+			break
+		}
+		end := zone.End
+		if duration > 0 && end > duration {
+			end = duration
+		}
+		if zone.Start > cursor {
+			segments = append(segments, zoneSegment{Start: cursor, End: zone.Start, Settings: base})
+		}
+		zoned := base
+		zoned.CRF = zone.CRF
+		if zone.Preset != "" {
+			zoned.Preset = zone.Preset
+		}
+		segments = append(segments, zoneSegment{Start: zone.Start, End: end, Settings: zoned})
+		cursor = end
+	}
+	if duration <= 0 || cursor < duration {
+		segments = append(segments, zoneSegment{Start: cursor, Settings: base})
+	}
+	return segments
+}
+
+// encodeZones encodes the video of the output segment by segment
+// Returns a concat list the final pass muxes with the audio and subtitles of the source
+// Son geçişin kaynağın ses ve altyazılarıyla birleştirdiği bir concat listesi döndürür
+func (a *App) encodeZones(ctx context.Context, jobID string, plan conversionPlan, workDir string, logFile *os.File, totalFrames int, onStall func()) (string, error) {
+	output := plan.Outputs[0]
+	segments := zoneSegments(output.Settings, plan.Video.DurationSeconds)
+	log.Printf("Encoding %s in %d zone segments", plan.InputPath, len(segments))
+
+	videoMap := "0:v:0"
+	if video, _, _ := selectStreams(output.Settings, plan.Streams); video != nil {
+		videoMap = fmt.Sprintf("0:%d", video.Index)
+	}
+
+	var list strings.Builder
+	for i, segment := range segments {
+		segmentPath := filepath.Join(workDir, fmt.Sprintf("segment_%03d.mkv", i))
+		args := []string{"-y", "-ss", formatSeconds(segment.Start), "-i", plan.InputPath}
+		if segment.End > 0 {
+			args = append(args, "-t", formatSeconds(segment.End-segment.Start))
+		}
+		args = append(args, "-map", videoMap, "-an", "-sn", "-dn")
+		args = append(args, encoderArgs(segment.Settings)...)
+		if filters := videoFilters(segment.Settings); len(filters) > 0 {
+			args = append(args, "-vf", strings.Join(filters, ","))
+		}
+		if maxRate := videoBitrateCap(segment.Settings, plan.Video.DurationSeconds); maxRate > 0 {
+			args = append(args,
+				"-maxrate", strconv.Itoa(maxRate)+"k",
+				"-bufsize", strconv.Itoa(maxRate*2)+"k")
+		}
+		args = append(args, "-f", "matroska", segmentPath)
+
+		if err := a.waitForCooling(ctx, jobID); err != nil {
+			return "", err
+		}
+		ffmpeg := a.newFFmpeg(logFile)
+		if err := ffmpeg.Start(ctx, args); err != nil {
+			log.Printf("Failed to start FFmpeg: %v", err)
+			return "", fmt.Errorf("failed to start FFmpeg: %v", err)
+		}
+
+		// Progress continues from the frames of the previous segments
+		// İlerleme önceki parçaların karelerinden devam eder
+		frameOffset := int(segment.Start * plan.Video.FrameRate)
+		if output.Settings.InverseTelecine {
+			frameOffset = frameOffset * 4 / 5
+		}
+		progressCtx, stopProgress := context.WithCancel(ctx)
+		monitorDone := make(chan struct{})
+		go func() {
+			defer close(monitorDone)
+			defer a.recoverCrash("monitorProgress")
+			a.monitorProgress(progressCtx, jobID, "encode", ffmpeg, progress.Target{Frames: totalFrames, FrameOffset: frameOffset}, onStall)
+		}()
+		err := ffmpeg.Wait()
+		stopProgress()
+		<-monitorDone
+		a.addCPUTime(jobID, ffmpeg.CPUTime())
+		if err != nil {
+			return "", fmt.Errorf("zone segment %d: %w", i+1, err)
+		}
+
+		// Single quotes are escaped the way the concat demuxer expects
+		// Tek tırnaklar concat ayrıştırıcısının beklediği şekilde kaçırılır
+		fmt.Fprintf(&list, "file '%s'\n", strings.ReplaceAll(segmentPath, "'", `'\''`))
+	}
+
+	listPath := filepath.Join(workDir, "segments.txt")
+	if err := os.WriteFile(listPath, []byte(list.String()), 0644); err != nil {
+		return "", fmt.Errorf("failed to write segment list: %v", err)
+	}
+	return listPath, nil
+}
+
+// concatListSize sums the sizes of the segments named in a concat list, the expected size of the mux pass
+// Bir birleştirme listesinde adı geçen bölümlerin boyutlarını toplar, birleştirme geçişinin beklenen boyutudur
+func concatListSize(listPath string) int64 {
+	data, err := os.ReadFile(listPath)
+	if err != nil {
+		return 0
+	}
+	var total int64
+	for _, line := range strings.Split(string(data), "\n") {
+		if strings.HasPrefix(line, "file '") && strings.HasSuffix(line, "'") {
+			name := strings.TrimSuffix(strings.TrimPrefix(line, "file '"), "'")
+			total += fileSize(strings.ReplaceAll(name, `'\''`, "'"))
+		}
+	}
+	return total
+}
+
+// formatSeconds formats seconds for -ss and -t
+// -ss ve -t için saniyeleri biçimlendirir
+func formatSeconds(seconds float64) string {
+	return strconv.FormatFloat(seconds, 'f', 3, 64)
+}